@@ -72,6 +72,11 @@ func cleanupCommandImpl() error {
 		return err
 	}
 
+	err = client.DeleteExpiredChallengeRedemptions()
+	if err != nil {
+		return err
+	}
+
 	log.Info("end cleanup")
 	return nil
 }
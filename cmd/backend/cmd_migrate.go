@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	migrationpkg "github.com/stormhead-org/backend/migration"
+	"go.uber.org/zap"
+)
+
+var migrateCommand = &cobra.Command{
+	Use:   "migrate",
+	Short: "apply, revert and verify database migrations",
+	Long:  "",
+}
+
+var migrateUpCommand = &cobra.Command{
+	Use:   "up",
+	Short: "apply every pending migration",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return migrateCommandImpl(migrateUp)
+	},
+}
+
+var migrateDownCommand = &cobra.Command{
+	Use:   "down [steps]",
+	Short: "revert the latest migrations, one by default",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		steps := 1
+		if len(args) == 1 {
+			var err error
+			steps, err = strconv.Atoi(args[0])
+			if err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps %q", args[0])
+			}
+		}
+
+		return migrateCommandImpl(func(log *zap.Logger, client *ormpkg.PostgresClient, migrations []ormpkg.Migration) error {
+			return migrateDown(log, client, migrations, steps)
+		})
+	},
+}
+
+var migrateStatusCommand = &cobra.Command{
+	Use:   "status",
+	Short: "show applied and pending migrations",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return migrateCommandImpl(migrateStatus)
+	},
+}
+
+var migrateVerifyCommand = &cobra.Command{
+	Use:   "verify",
+	Short: "compare the database schema with the models, fail on drift",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return migrateCommandImpl(migrateVerify)
+	},
+}
+
+var migrateForceCommand = &cobra.Command{
+	Use:   "force version",
+	Short: "record a version as applied without running it, to recover a dirty database",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		version, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[0])
+		}
+
+		return migrateCommandImpl(func(log *zap.Logger, client *ormpkg.PostgresClient, migrations []ormpkg.Migration) error {
+			err := client.ForceMigrationVersion(context.Background(), migrations, version)
+			if err != nil {
+				return err
+			}
+
+			log.Info("migration version forced", zap.Uint64("version", version))
+			return nil
+		})
+	},
+}
+
+func migrateCommandImpl(run func(*zap.Logger, *ormpkg.PostgresClient, []ormpkg.Migration) error) error {
+	var log *zap.Logger
+	var err error
+	if os.Getenv("DEBUG") == "1" {
+		log, err = zap.NewDevelopment()
+	} else {
+		log, err = zap.NewProduction()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if os.Getenv("DEBUG") == "1" {
+		godotenv.Load()
+	}
+
+	migrations, err := ormpkg.LoadMigrations(migrationpkg.FS)
+	if err != nil {
+		return err
+	}
+
+	postgresHost := os.Getenv("POSTGRES_HOST")
+	if postgresHost == "" {
+		postgresHost = "127.0.0.1"
+	}
+
+	postgresPort := os.Getenv("POSTGRES_PORT")
+	if postgresPort == "" {
+		postgresPort = "5432"
+	}
+
+	postgresUser := os.Getenv("POSTGRES_USER")
+	if postgresUser == "" {
+		postgresUser = "postgres"
+	}
+
+	postgresPassword := os.Getenv("POSTGRES_PASSWORD")
+	if postgresPassword == "" {
+		postgresPassword = "postgres"
+	}
+
+	client, err := ormpkg.NewPostgresClient(
+		postgresHost,
+		postgresPort,
+		postgresUser,
+		postgresPassword,
+	)
+	if err != nil {
+		return err
+	}
+
+	return run(log, client, migrations)
+}
+
+func migrateUp(log *zap.Logger, client *ormpkg.PostgresClient, migrations []ormpkg.Migration) error {
+	applied, err := client.MigrateUp(context.Background(), migrations)
+	for _, migration := range applied {
+		log.Info("migration applied", zap.Uint64("version", migration.Version), zap.String("name", migration.Name))
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(applied) == 0 {
+		log.Info("no pending migrations")
+	}
+	return nil
+}
+
+func migrateDown(log *zap.Logger, client *ormpkg.PostgresClient, migrations []ormpkg.Migration, steps int) error {
+	reverted, err := client.MigrateDown(context.Background(), migrations, steps)
+	for _, migration := range reverted {
+		log.Info("migration reverted", zap.Uint64("version", migration.Version), zap.String("name", migration.Name))
+	}
+	return err
+}
+
+func migrateStatus(log *zap.Logger, client *ormpkg.PostgresClient, migrations []ormpkg.Migration) error {
+	state, err := client.SelectMigrationState(context.Background())
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range migrations {
+		status := "pending"
+		if migration.Version <= state.Version {
+			status = "applied"
+		}
+		if migration.Version == state.Version && state.Dirty {
+			status = "dirty"
+		}
+		fmt.Printf("%06d %-8s %s\n", migration.Version, status, migration.Name)
+	}
+
+	fmt.Printf("version %d, dirty %t\n", state.Version, state.Dirty)
+	return nil
+}
+
+func migrateVerify(log *zap.Logger, client *ormpkg.PostgresClient, migrations []ormpkg.Migration) error {
+	drifts, err := client.VerifySchema(context.Background())
+	if err != nil {
+		return err
+	}
+
+	for _, drift := range drifts {
+		log.Error("schema drift", zap.String("drift", drift.String()))
+	}
+	if len(drifts) > 0 {
+		return fmt.Errorf("schema differs from the models in %d places", len(drifts))
+	}
+
+	log.Info("schema matches the models")
+	return nil
+}
+
+func init() {
+	migrateCommand.AddCommand(migrateUpCommand)
+	migrateCommand.AddCommand(migrateDownCommand)
+	migrateCommand.AddCommand(migrateStatusCommand)
+	migrateCommand.AddCommand(migrateVerifyCommand)
+	migrateCommand.AddCommand(migrateForceCommand)
+	rootCommand.AddCommand(migrateCommand)
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	"go.uber.org/zap"
+)
+
+var reconcileCountersCommand = &cobra.Command{
+	Use:   "reconcile-counters",
+	Short: "recompute like, comment, member and post counters",
+	Long:  "",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return reconcileCountersCommandImpl()
+	},
+}
+
+func reconcileCountersCommandImpl() error {
+	var log *zap.Logger
+	var err error
+	if os.Getenv("DEBUG") == "1" {
+		log, err = zap.NewDevelopment()
+	} else {
+		log, err = zap.NewProduction()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if os.Getenv("DEBUG") == "1" {
+		godotenv.Load()
+	}
+
+	log.Info("begin counter reconciliation")
+
+	postgresHost := os.Getenv("POSTGRES_HOST")
+	if postgresHost == "" {
+		postgresHost = "127.0.0.1"
+	}
+
+	postgresPort := os.Getenv("POSTGRES_PORT")
+	if postgresPort == "" {
+		postgresPort = "5432"
+	}
+
+	postgresUser := os.Getenv("POSTGRES_USER")
+	if postgresUser == "" {
+		postgresUser = "postgres"
+	}
+
+	postgresPassword := os.Getenv("POSTGRES_PASSWORD")
+	if postgresPassword == "" {
+		postgresPassword = "postgres"
+	}
+
+	client, err := ormpkg.NewPostgresClient(
+		postgresHost,
+		postgresPort,
+		postgresUser,
+		postgresPassword,
+	)
+	if err != nil {
+		return err
+	}
+
+	drifts, err := client.ReconcileCounters(context.Background())
+	if err != nil {
+		return err
+	}
+
+	for _, drift := range drifts {
+		log.Info(
+			"counter reconciled",
+			zap.String("counter", drift.Counter),
+			zap.Int64("rows", drift.Rows),
+			zap.Int64("delta", drift.Delta),
+		)
+	}
+
+	log.Info("end counter reconciliation")
+	return nil
+}
+
+func init() {
+	rootCommand.AddCommand(reconcileCountersCommand)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	"go.uber.org/zap"
+)
+
+var rollupStatisticsCommand = &cobra.Command{
+	Use:   "rollup-statistics [days]",
+	Short: "recompute the statistics rollups of the last days, 30 by default",
+	Long:  "",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		days := 30
+		if len(args) == 1 {
+			var err error
+			days, err = strconv.Atoi(args[0])
+			if err != nil || days < 1 {
+				return fmt.Errorf("invalid number of days %q", args[0])
+			}
+		}
+
+		return rollupStatisticsCommandImpl(days)
+	},
+}
+
+func rollupStatisticsCommandImpl(days int) error {
+	var log *zap.Logger
+	var err error
+	if os.Getenv("DEBUG") == "1" {
+		log, err = zap.NewDevelopment()
+	} else {
+		log, err = zap.NewProduction()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if os.Getenv("DEBUG") == "1" {
+		godotenv.Load()
+	}
+
+	log.Info("begin statistics rollup", zap.Int("days", days))
+
+	postgresHost := os.Getenv("POSTGRES_HOST")
+	if postgresHost == "" {
+		postgresHost = "127.0.0.1"
+	}
+
+	postgresPort := os.Getenv("POSTGRES_PORT")
+	if postgresPort == "" {
+		postgresPort = "5432"
+	}
+
+	postgresUser := os.Getenv("POSTGRES_USER")
+	if postgresUser == "" {
+		postgresUser = "postgres"
+	}
+
+	postgresPassword := os.Getenv("POSTGRES_PASSWORD")
+	if postgresPassword == "" {
+		postgresPassword = "postgres"
+	}
+
+	client, err := ormpkg.NewPostgresClient(
+		postgresHost,
+		postgresPort,
+		postgresUser,
+		postgresPassword,
+	)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	from := now.Add(-time.Duration(days) * 24 * time.Hour)
+	for _, granularity := range []string{ormpkg.STATISTICS_HOUR, ormpkg.STATISTICS_DAY} {
+		buckets := ormpkg.StatisticsBuckets(granularity, from, now)
+		for _, bucket := range buckets {
+			err = client.RollupStatistics(context.Background(), granularity, bucket)
+			if err != nil {
+				return err
+			}
+		}
+
+		log.Info("statistics rolled up", zap.String("granularity", granularity), zap.Int("buckets", len(buckets)))
+	}
+
+	log.Info("end statistics rollup")
+	return nil
+}
+
+func init() {
+	rootCommand.AddCommand(rollupStatisticsCommand)
+}
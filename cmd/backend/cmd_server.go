@@ -2,7 +2,12 @@ package main
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/cobra"
@@ -14,9 +19,13 @@ import (
 	grpcpkg "github.com/stormhead-org/backend/internal/grpc"
 	authorizationgrpcpkg "github.com/stormhead-org/backend/internal/grpc/authorization"
 	communitygrpcpkg "github.com/stormhead-org/backend/internal/grpc/community"
+	platformgrpcpkg "github.com/stormhead-org/backend/internal/grpc/platform"
 	postgrpcpkg "github.com/stormhead-org/backend/internal/grpc/post"
 	jwtpkg "github.com/stormhead-org/backend/internal/jwt"
+	libpkg "github.com/stormhead-org/backend/internal/lib"
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
 	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
 )
 
 var serverCommand = &cobra.Command{
@@ -33,6 +42,11 @@ func serverCommandImpl() error {
 		godotenv.Load()
 	}
 
+	err := libpkg.SetCursorSecret(cursorSecretFromEnv())
+	if err != nil {
+		return err
+	}
+
 	// Application
 	application := fx.New(
 		// fx.NopLogger,
@@ -49,21 +63,35 @@ func serverCommandImpl() error {
 
 			// Config/Secrets from .env
 			func(logger *zap.Logger) (*jwtpkg.JWT, error) {
-				jwtSecret := os.Getenv("JWT_SECRET")
-				if jwtSecret == "" {
-					jwtSecret = "123456"
-				}
-				return jwtpkg.NewJWT(jwtSecret), nil
+				return jwtpkg.NewJWT(jwtSecretFromEnv()), nil
 			},
 
 			// Clients
-			func(logger *zap.Logger) (*ormpkg.PostgresClient, error) {
-				return ormpkg.NewPostgresClient(
-					os.Getenv("POSTGRES_HOST"),
-					os.Getenv("POSTGRES_PORT"),
-					os.Getenv("POSTGRES_USER"),
-					os.Getenv("POSTGRES_PASSWORD"),
+			func(lc fx.Lifecycle, logger *zap.Logger) (*ormpkg.PostgresClient, error) {
+				config, err := postgresConfigFromEnv(
+					ormpkg.PostgresDSN(
+						os.Getenv("POSTGRES_HOST"),
+						os.Getenv("POSTGRES_PORT"),
+						os.Getenv("POSTGRES_USER"),
+						os.Getenv("POSTGRES_PASSWORD"),
+					),
+					true,
 				)
+				if err != nil {
+					return nil, err
+				}
+
+				client, err := ormpkg.NewPostgresClientWithConfig(config)
+				if err != nil {
+					return nil, err
+				}
+
+				lc.Append(fx.Hook{
+					OnStop: func(ctx context.Context) error {
+						return client.Close()
+					},
+				})
+				return client, nil
 			},
 			func(logger *zap.Logger) (*eventpkg.KafkaClient, error) {
 				return eventpkg.NewKafkaClient(
@@ -73,14 +101,126 @@ func serverCommandImpl() error {
 					os.Getenv("KAFKA_GROUP"),
 				)
 			},
-			clientpkg.NewHIBPClient,
+			func(logger *zap.Logger) (clientpkg.PwnedPasswordChecker, error) {
+				var checker clientpkg.PwnedPasswordChecker
+				switch os.Getenv("HIBP_MODE") {
+				case "", "api":
+					checker = clientpkg.NewHIBPClient()
+				case "file":
+					rangeFile, err := clientpkg.NewHIBPRangeFile(os.Getenv("HIBP_RANGE_FILE"))
+					if err != nil {
+						return nil, err
+					}
+					checker = rangeFile
+				default:
+					return nil, fmt.Errorf("unknown HIBP_MODE %q", os.Getenv("HIBP_MODE"))
+				}
+
+				return clientpkg.NewPwnedPasswordPolicy(
+					logger,
+					checker,
+					os.Getenv("HIBP_FAIL_OPEN") == "1",
+				), nil
+			},
+			func(lc fx.Lifecycle, logger *zap.Logger) (*middlewarepkg.SessionCache, error) {
+				sessionTopic := os.Getenv("KAFKA_SESSION_TOPIC")
+				if sessionTopic == "" {
+					sessionTopic = "session_revocations"
+				}
+
+				broker, err := eventpkg.NewKafkaBroadcastClient(
+					os.Getenv("KAFKA_HOST"),
+					os.Getenv("KAFKA_PORT"),
+					sessionTopic,
+					os.Getenv("KAFKA_GROUP"),
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				sessions := middlewarepkg.NewSessionCache(logger, broker)
+				lc.Append(fx.Hook{
+					OnStart: func(ctx context.Context) error {
+						return sessions.Start()
+					},
+					OnStop: func(ctx context.Context) error {
+						return sessions.Stop()
+					},
+				})
+				return sessions, nil
+			},
+			func(logger *zap.Logger, db *ormpkg.PostgresClient) (*middlewarepkg.ChallengeGuard, error) {
+				var verifier securitypkg.ChallengeVerifier
+				switch os.Getenv("CHALLENGE_MODE") {
+				case "", "off":
+				case "pow":
+					secret := os.Getenv("CHALLENGE_SECRET")
+					if secret == "" {
+						return nil, fmt.Errorf("CHALLENGE_SECRET is required for CHALLENGE_MODE=pow")
+					}
+					verifier = securitypkg.NewProofOfWork(secret)
+				case "captcha":
+					verifier = clientpkg.NewCaptchaClient(
+						os.Getenv("CAPTCHA_VERIFY_URL"),
+						os.Getenv("CAPTCHA_SECRET"),
+						os.Getenv("CAPTCHA_SITE_KEY"),
+					)
+				default:
+					return nil, fmt.Errorf("unknown CHALLENGE_MODE %q", os.Getenv("CHALLENGE_MODE"))
+				}
+
+				methods := middlewarepkg.DefaultChallengeMethods
+				if os.Getenv("CHALLENGE_METHODS") != "" {
+					methods = strings.Split(os.Getenv("CHALLENGE_METHODS"), ",")
+				}
+
+				return middlewarepkg.NewChallengeGuard(logger, db, verifier, methods), nil
+			},
+			func(lc fx.Lifecycle) (*clientpkg.GeoIPDatabase, error) {
+				database, err := clientpkg.NewGeoIPDatabase(os.Getenv("GEOIP_DATABASE"))
+				if err != nil {
+					return nil, err
+				}
+				lc.Append(fx.Hook{
+					OnStop: func(ctx context.Context) error {
+						return database.Close()
+					},
+				})
+				return database, nil
+			},
+			func() *securitypkg.PasswordHasher {
+				return securitypkg.NewPasswordHasher(os.Getenv("PASSWORD_PEPPER"))
+			},
 
 			// gRPC Servers
-			authorizationgrpcpkg.NewAuthorizationServer,
-			communitygrpcpkg.NewCommunityServer,
-			postgrpcpkg.NewPostServer,
-			grpcpkg.NewCommentServer,
-			grpcpkg.NewUserServer,
+			func(
+				log *zap.Logger,
+				jwt *jwtpkg.JWT,
+				hibp clientpkg.PwnedPasswordChecker,
+				geoip *clientpkg.GeoIPDatabase,
+				hasher *securitypkg.PasswordHasher,
+				db *ormpkg.PostgresClient,
+				broker *eventpkg.KafkaClient,
+				sessions *middlewarepkg.SessionCache,
+				challenges *middlewarepkg.ChallengeGuard,
+			) *authorizationgrpcpkg.AuthorizationServer {
+				return authorizationgrpcpkg.NewAuthorizationServer(log, jwt, hibp, geoip, hasher, db, broker, sessions, challenges)
+			},
+			func(log *zap.Logger, db *ormpkg.PostgresClient) *communitygrpcpkg.CommunityServer {
+				return communitygrpcpkg.NewCommunityServer(log, db, db.Replica())
+			},
+			func(log *zap.Logger, db *ormpkg.PostgresClient) *postgrpcpkg.PostServer {
+				return postgrpcpkg.NewPostServer(log, db, db.Replica())
+			},
+			func(log *zap.Logger, db *ormpkg.PostgresClient, broker *eventpkg.KafkaClient) *grpcpkg.CommentServer {
+				return grpcpkg.NewCommentServer(log, db, broker)
+			},
+			func(log *zap.Logger, db *ormpkg.PostgresClient, broker *eventpkg.KafkaClient) *grpcpkg.UserServer {
+				return grpcpkg.NewUserServer(log, db, db.Replica(), broker)
+			},
+			func(log *zap.Logger, db *ormpkg.PostgresClient, broker *eventpkg.KafkaClient) *platformgrpcpkg.PlatformServer {
+				return platformgrpcpkg.NewPlatformServer(log, db, db.Replica(), broker)
+			},
 
 			// Main gRPC Server
 			func(
@@ -88,16 +228,21 @@ func serverCommandImpl() error {
 				log *zap.Logger,
 				jwt *jwtpkg.JWT,
 				db *ormpkg.PostgresClient,
+				sessions *middlewarepkg.SessionCache,
+				challenges *middlewarepkg.ChallengeGuard,
 				authServer *authorizationgrpcpkg.AuthorizationServer,
 				communityServer *communitygrpcpkg.CommunityServer,
 				postServer *postgrpcpkg.PostServer,
 				commentServer *grpcpkg.CommentServer,
 				userServer *grpcpkg.UserServer,
+				platformServer *platformgrpcpkg.PlatformServer,
 			) (*grpcpkg.GRPC, error) {
 				grpcServer, err := grpcpkg.NewGRPC(
 					log,
 					jwt,
 					db,
+					sessions,
+					challenges,
 					os.Getenv("GRPC_HOST"),
 					os.Getenv("GRPC_PORT"),
 					authServer,
@@ -105,6 +250,7 @@ func serverCommandImpl() error {
 					postServer,
 					commentServer,
 					userServer,
+					platformServer,
 				)
 				if err != nil {
 					return nil, err
@@ -124,7 +270,7 @@ func serverCommandImpl() error {
 	)
 	application.Run()
 
-	err := application.Err()
+	err = application.Err()
 	if err != nil {
 		os.Exit(1)
 	}
@@ -135,3 +281,25 @@ func serverCommandImpl() error {
 func init() {
 	rootCommand.AddCommand(serverCommand)
 }
+
+func jwtSecretFromEnv() string {
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		jwtSecret = "123456"
+	}
+	return jwtSecret
+}
+
+// cursorSecretFromEnv returns CURSOR_SECRET or, without it, a key derived
+// from the JWT secret, which every instance shares as well. Cursors keep
+// working across restarts and replicas either way.
+func cursorSecretFromEnv() string {
+	secret := os.Getenv("CURSOR_SECRET")
+	if secret != "" {
+		return secret
+	}
+
+	mac := hmac.New(sha256.New, []byte(jwtSecretFromEnv()))
+	mac.Write([]byte("pagination cursor"))
+	return hex.EncodeToString(mac.Sum(nil))
+}
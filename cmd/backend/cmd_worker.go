@@ -104,6 +104,14 @@ func workerCommandImpl() error {
 				return mailClient, nil
 			},
 
+			// S3 client
+			func(logger *zap.Logger) (*clientpkg.S3Client, error) {
+				return clientpkg.NewS3Client(
+					context.Background(),
+					os.Getenv("S3_USE_PATH_STYLE") == "1",
+				)
+			},
+
 			func(lifecycle fx.Lifecycle, shutdowner fx.Shutdowner, logger *zap.Logger) (*ormpkg.PostgresClient, error) {
 				postgresHost := os.Getenv("POSTGRES_HOST")
 				if postgresHost == "" {
@@ -125,16 +133,29 @@ func workerCommandImpl() error {
 					postgresPassword = "postgres"
 				}
 
-				client, err := ormpkg.NewPostgresClient(
-					postgresHost,
-					postgresPort,
-					postgresUser,
-					postgresPassword,
+				config, err := postgresConfigFromEnv(
+					ormpkg.PostgresDSN(
+						postgresHost,
+						postgresPort,
+						postgresUser,
+						postgresPassword,
+					),
+					false,
 				)
 				if err != nil {
 					return nil, err
 				}
 
+				client, err := ormpkg.NewPostgresClientWithConfig(config)
+				if err != nil {
+					return nil, err
+				}
+
+				lifecycle.Append(fx.Hook{
+					OnStop: func(ctx context.Context) error {
+						return client.Close()
+					},
+				})
 				return client, err
 			},
 
@@ -145,6 +166,7 @@ func workerCommandImpl() error {
 				logger *zap.Logger,
 				kafkaClient *eventpkg.KafkaClient,
 				mailClient *clientpkg.MailClient,
+				s3Client *clientpkg.S3Client,
 				databaseClient *ormpkg.PostgresClient,
 			) (*workerpkg.Worker, error) {
 				verificationURL := os.Getenv("VERIFICATION_URL")
@@ -155,12 +177,37 @@ func workerCommandImpl() error {
 				if passwordResetURL == "" {
 					passwordResetURL = "http://localhost:3000/reset-password"
 				}
+				unlockURL := os.Getenv("UNLOCK_URL")
+				if unlockURL == "" {
+					unlockURL = "http://localhost:3000/unlock-account"
+				}
+				magicLinkURL := os.Getenv("MAGIC_LINK_URL")
+				if magicLinkURL == "" {
+					magicLinkURL = "http://localhost:3000/magic-link"
+				}
+				emailChangeURL := os.Getenv("EMAIL_CHANGE_URL")
+				if emailChangeURL == "" {
+					emailChangeURL = "http://localhost:3000/confirm-email-change"
+				}
+				emailRevertURL := os.Getenv("EMAIL_REVERT_URL")
+				if emailRevertURL == "" {
+					emailRevertURL = "http://localhost:3000/revert-email-change"
+				}
+				dataExportBucket := os.Getenv("DATA_EXPORT_BUCKET")
+				if dataExportBucket == "" {
+					dataExportBucket = "data-export"
+				}
 				config := &workerpkg.Config{
-					VerificationURL:   verificationURL,
+					VerificationURL:  verificationURL,
 					PasswordResetURL: passwordResetURL,
+					UnlockURL:        unlockURL,
+					MagicLinkURL:     magicLinkURL,
+					EmailChangeURL:   emailChangeURL,
+					EmailRevertURL:   emailRevertURL,
+					DataExportBucket: dataExportBucket,
 				}
 
-				worker := workerpkg.NewWorker(logger, kafkaClient, mailClient, databaseClient, config)
+				worker := workerpkg.NewWorker(logger, kafkaClient, mailClient, s3Client, databaseClient, config)
 
 				lifecycle.Append(fx.Hook{
 					OnStart: func(ctx context.Context) error {
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+// postgresConfigFromEnv reads the pool settings of the POSTGRES_* variables
+// on top of ormpkg.DefaultPostgresConfig of dsn. Replicas are only read if
+// withReplicas, commands other than the server write or run once.
+func postgresConfigFromEnv(dsn string, withReplicas bool) (ormpkg.PostgresConfig, error) {
+	config := ormpkg.DefaultPostgresConfig(dsn)
+
+	integers := map[string]*int{
+		"POSTGRES_MAX_OPEN_CONNS": &config.MaxOpenConns,
+		"POSTGRES_MAX_IDLE_CONNS": &config.MaxIdleConns,
+	}
+	for name, target := range integers {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return ormpkg.PostgresConfig{}, fmt.Errorf("invalid %s: %w", name, err)
+		}
+		*target = parsed
+	}
+
+	durations := map[string]*time.Duration{
+		"POSTGRES_CONN_MAX_LIFETIME":  &config.ConnMaxLifetime,
+		"POSTGRES_CONN_MAX_IDLE_TIME": &config.ConnMaxIdleTime,
+		"POSTGRES_STATEMENT_TIMEOUT":  &config.StatementTimeout,
+		"POSTGRES_MAX_REPLICA_LAG":    &config.MaxReplicaLag,
+	}
+	for name, target := range durations {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return ormpkg.PostgresConfig{}, fmt.Errorf("invalid %s: %w", name, err)
+		}
+		*target = parsed
+	}
+
+	if withReplicas {
+		for _, dsn := range strings.Split(os.Getenv("POSTGRES_REPLICA_DSNS"), ",") {
+			dsn = strings.TrimSpace(dsn)
+			if dsn != "" {
+				config.ReplicaDSNs = append(config.ReplicaDSNs, dsn)
+			}
+		}
+	}
+
+	return config, nil
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	securitypkg "github.com/stormhead-org/backend/internal/security"
+)
+
+// CaptchaClient verifies CAPTCHA responses with a provider "siteverify"
+// endpoint. hCaptcha, reCAPTCHA and Cloudflare Turnstile share the same
+// protocol: a form POST with secret, response and remoteip answered with
+// {"success": bool}.
+type CaptchaClient struct {
+	httpClient *http.Client
+	verifyURL  string
+	secret     string
+	siteKey    string
+}
+
+func NewCaptchaClient(verifyURL string, secret string, siteKey string) *CaptchaClient {
+	return &CaptchaClient{
+		httpClient: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+		verifyURL: verifyURL,
+		secret:    secret,
+		siteKey:   siteKey,
+	}
+}
+
+// Issue returns the site key for the client side widget. The widget itself
+// talks to the provider, so there is nothing to generate here.
+func (c *CaptchaClient) Issue(ipAddress string, difficulty int) (*securitypkg.Challenge, error) {
+	return &securitypkg.Challenge{
+		Kind:    securitypkg.CHALLENGE_KIND_CAPTCHA,
+		SiteKey: c.siteKey,
+	}, nil
+}
+
+// Verify asks the provider whether the response token is valid. Providers
+// accept each token once, so no nonce is returned for replay protection.
+func (c *CaptchaClient) Verify(ctx context.Context, response string, ipAddress string, difficulty int) (*securitypkg.VerifiedChallenge, error) {
+	form := url.Values{
+		"secret":   {c.secret},
+		"response": {response},
+	}
+	if ipAddress != "" && ipAddress != "unknown" {
+		form.Set("remoteip", ipAddress)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("CAPTCHA provider returned status: %s", resp.Status)
+	}
+
+	var result struct {
+		Success bool `json:"success"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Success {
+		return nil, securitypkg.ErrChallengeInvalid
+	}
+
+	return &securitypkg.VerifiedChallenge{}, nil
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/oschwald/geoip2-golang"
+)
+
+// GeoLocation is the approximate location of an ip address. Fields are
+// empty when unknown.
+type GeoLocation struct {
+	Country string // ISO 3166-1 alpha-2 code
+	City    string // English name
+}
+
+// GeoIPDatabase resolves ip addresses with a local MaxMind-format database
+// file (GeoLite2/GeoIP2 City or Country, DB-IP lite and similar). Without a
+// file every lookup returns an empty location.
+type GeoIPDatabase struct {
+	reader *geoip2.Reader
+	isCity bool
+}
+
+// NewGeoIPDatabase opens the database at path, an empty path disables
+// lookups.
+func NewGeoIPDatabase(path string) (*GeoIPDatabase, error) {
+	if path == "" {
+		return &GeoIPDatabase{}, nil
+	}
+
+	reader, err := geoip2.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open GeoIP database: %w", err)
+	}
+
+	return &GeoIPDatabase{
+		reader: reader,
+		isCity: strings.Contains(reader.Metadata().DatabaseType, "City"),
+	}, nil
+}
+
+func (c *GeoIPDatabase) Close() error {
+	if c.reader == nil {
+		return nil
+	}
+	return c.reader.Close()
+}
+
+// Lookup returns the location of the address, or an empty location if the
+// address is invalid, private or not in the database.
+func (c *GeoIPDatabase) Lookup(ipAddress string) GeoLocation {
+	ip := net.ParseIP(ipAddress)
+	if c.reader == nil || ip == nil {
+		return GeoLocation{}
+	}
+
+	if c.isCity {
+		record, err := c.reader.City(ip)
+		if err != nil {
+			return GeoLocation{}
+		}
+		return GeoLocation{
+			Country: record.Country.IsoCode,
+			City:    record.City.Names["en"],
+		}
+	}
+
+	record, err := c.reader.Country(ip)
+	if err != nil {
+		return GeoLocation{}
+	}
+	return GeoLocation{
+		Country: record.Country.IsoCode,
+	}
+}
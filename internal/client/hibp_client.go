@@ -2,23 +2,33 @@ package client
 
 import (
 	"bufio"
+	"container/list"
 	"crypto/sha1"
 	"encoding/hex"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 )
 
+const hibpRangeCacheSize = 4096
+const hibpRangeCacheTTL = 24 * time.Hour
+
 // HIBPClient is a client for the Have I Been Pwned API.
 type HIBPClient struct {
 	httpClient *http.Client
+	cache      *hibpRangeCache
 }
 
 // NewHIBPClient creates a new HIBPClient.
 func NewHIBPClient() *HIBPClient {
 	return &HIBPClient{
-		httpClient: &http.Client{},
+		httpClient: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+		cache: newHIBPRangeCache(hibpRangeCacheSize, hibpRangeCacheTTL),
 	}
 }
 
@@ -27,45 +37,130 @@ func NewHIBPClient() *HIBPClient {
 // See: https://haveibeenpwned.com/API/v3#PwnedPasswords
 func (c *HIBPClient) IsPasswordPwned(password string) (bool, error) {
 	// 1. Hash the password using SHA-1
-	h := sha1.New()
-	if _, err := io.WriteString(h, password); err != nil {
-		return false, err
-	}
-	sha1Hash := strings.ToUpper(hex.EncodeToString(h.Sum(nil)))
+	sha1Hash := pwnedPasswordHash(password)
 	prefix := sha1Hash[:5]
 	suffix := sha1Hash[5:]
 
-	// 2. Call the HIBP API with the first 5 characters of the hash
+	// 2. Use the cached range or call the HIBP API with the first 5 characters of the hash
+	suffixes, ok := c.cache.Get(prefix)
+	if !ok {
+		var err error
+		suffixes, err = c.fetchRange(prefix)
+		if err != nil {
+			return false, err
+		}
+		c.cache.Put(prefix, suffixes)
+	}
+
+	// 3. Check if the hash suffix is in the range
+	_, found := suffixes[suffix]
+	return found, nil
+}
+
+func (c *HIBPClient) fetchRange(prefix string) (map[string]struct{}, error) {
 	url := fmt.Sprintf("https://api.pwnedpasswords.com/range/%s", prefix)
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	req.Header.Set("User-Agent", "go-backend-base") // HIBP API requires a User-Agent
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return false, fmt.Errorf("HIBP API returned status: %s", resp.Status)
+		return nil, fmt.Errorf("HIBP API returned status: %s", resp.Status)
 	}
 
-	// 3. Check if the hash suffix is in the response body
+	suffixes := make(map[string]struct{})
 	scanner := bufio.NewScanner(resp.Body)
 	for scanner.Scan() {
-		line := scanner.Text()
-		parts := strings.Split(line, ":")
-		if len(parts) == 2 && parts[0] == suffix {
-			return true, nil // Found the pwned password
+		parts := strings.Split(strings.TrimSpace(scanner.Text()), ":")
+		if len(parts) == 2 {
+			suffixes[parts[0]] = struct{}{}
 		}
 	}
 
 	if err := scanner.Err(); err != nil {
-		return false, err
+		return nil, err
+	}
+
+	return suffixes, nil
+}
+
+// pwnedPasswordHash returns the upper-case hex SHA-1 of the password, the
+// form used by the HIBP API and range files.
+func pwnedPasswordHash(password string) string {
+	h := sha1.New()
+	io.WriteString(h, password)
+	return strings.ToUpper(hex.EncodeToString(h.Sum(nil)))
+}
+
+// hibpRangeCache is a small LRU of range responses keyed by hash prefix.
+type hibpRangeCache struct {
+	mutex   sync.Mutex
+	size    int
+	ttl     time.Duration
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type hibpRangeCacheEntry struct {
+	prefix    string
+	suffixes  map[string]struct{}
+	fetchedAt time.Time
+}
+
+func newHIBPRangeCache(size int, ttl time.Duration) *hibpRangeCache {
+	return &hibpRangeCache{
+		size:    size,
+		ttl:     ttl,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (c *hibpRangeCache) Get(prefix string) (map[string]struct{}, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.entries[prefix]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*hibpRangeCacheEntry)
+	if time.Since(entry.fetchedAt) > c.ttl {
+		c.order.Remove(element)
+		delete(c.entries, prefix)
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	return entry.suffixes, true
+}
+
+func (c *hibpRangeCache) Put(prefix string, suffixes map[string]struct{}) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.entries[prefix]
+	if ok {
+		c.order.Remove(element)
 	}
 
-	return false, nil // Password not found in pwned list
+	c.entries[prefix] = c.order.PushFront(&hibpRangeCacheEntry{
+		prefix:    prefix,
+		suffixes:  suffixes,
+		fetchedAt: time.Now(),
+	})
+
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*hibpRangeCacheEntry).prefix)
+	}
 }
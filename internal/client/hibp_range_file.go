@@ -0,0 +1,139 @@
+package client
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const hibpHashLength = 40
+
+// HIBPRangeFile checks passwords against a local copy of the Pwned
+// Passwords SHA-1 list, as produced by the official downloader
+// (github.com/HaveIBeenPwned/PwnedPasswordsDownloader) in single file mode:
+// one "HASH:COUNT" line per hash, upper-case hex, sorted by hash. Lines
+// with a zero count, the padding of padded API responses, don't count.
+//
+// Lookups binary search the file directly, so it is never loaded into
+// memory and the OS page cache does the caching.
+type HIBPRangeFile struct {
+	file *os.File
+	size int64
+}
+
+// NewHIBPRangeFile opens the range file at path.
+func NewHIBPRangeFile(path string) (*HIBPRangeFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open HIBP range file: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("stat HIBP range file: %w", err)
+	}
+
+	return &HIBPRangeFile{
+		file: file,
+		size: info.Size(),
+	}, nil
+}
+
+func (c *HIBPRangeFile) Close() error {
+	return c.file.Close()
+}
+
+// IsPasswordPwned reports whether the SHA-1 of the password is in the file.
+func (c *HIBPRangeFile) IsPasswordPwned(password string) (bool, error) {
+	target := pwnedPasswordHash(password)
+
+	// Every line starting in [low, high) is still a candidate; low is
+	// always the start of a line.
+	low, high := int64(0), c.size
+	for low < high {
+		middle := low + (high-low)/2
+
+		start, line, err := c.lineAtOrAfter(middle)
+		if err != nil {
+			return false, err
+		}
+		if start >= high {
+			high = middle
+			continue
+		}
+
+		hash, count, ok := parseHIBPLine(line)
+		if !ok {
+			return false, fmt.Errorf("malformed HIBP range file line at offset %d", start)
+		}
+
+		switch {
+		case hash == target:
+			return count > 0, nil
+		case hash < target:
+			low = start + int64(len(line)) + 1
+		default:
+			high = middle
+		}
+	}
+
+	return false, nil
+}
+
+// parseHIBPLine splits a "HASH:COUNT" line, with an optional trailing "\r",
+// into the upper-case hash and the count.
+func parseHIBPLine(line string) (string, int64, bool) {
+	hash, count, ok := strings.Cut(strings.TrimSuffix(line, "\r"), ":")
+	if !ok || len(hash) != hibpHashLength {
+		return "", 0, false
+	}
+	for _, c := range hash {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return "", 0, false
+		}
+	}
+
+	parsed, err := strconv.ParseInt(count, 10, 64)
+	if err != nil || parsed < 0 {
+		return "", 0, false
+	}
+
+	return strings.ToUpper(hash), parsed, true
+}
+
+// lineAtOrAfter returns the first line that starts at or after offset,
+// without its line terminator. The returned start equals the file size when
+// there is no such line.
+func (c *HIBPRangeFile) lineAtOrAfter(offset int64) (int64, string, error) {
+	start := offset
+	if offset > 0 {
+		// Skip the rest of the line containing offset-1
+		reader := bufio.NewReader(io.NewSectionReader(c.file, offset-1, c.size-offset+1))
+		skipped, err := reader.ReadString('\n')
+		if err == io.EOF {
+			return c.size, "", nil
+		}
+		if err != nil {
+			return 0, "", err
+		}
+		start = offset - 1 + int64(len(skipped))
+	}
+
+	if start >= c.size {
+		return c.size, "", nil
+	}
+
+	reader := bufio.NewReader(io.NewSectionReader(c.file, start, c.size-start))
+	line, err := reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return 0, "", err
+	}
+
+	// A trailing "\r" is kept so that len(line) still matches the bytes on disk
+	line = strings.TrimSuffix(line, "\n")
+	return start, line, nil
+}
@@ -0,0 +1,182 @@
+package client
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// writeHIBPRangeFile writes the lines sorted by hash and opens the file.
+func writeHIBPRangeFile(t *testing.T, lines []string, newline string) *HIBPRangeFile {
+	t.Helper()
+
+	sorted := slices.Clone(lines)
+	slices.SortFunc(sorted, func(a string, b string) int {
+		return strings.Compare(strings.ToUpper(a), strings.ToUpper(b))
+	})
+
+	path := filepath.Join(t.TempDir(), "pwnedpasswords.txt")
+	content := strings.Join(sorted, newline)
+	if len(sorted) > 0 {
+		content += newline
+	}
+	err := os.WriteFile(path, []byte(content), 0o600)
+	if err != nil {
+		t.Fatalf("write range file: %v", err)
+	}
+
+	rangeFile, err := NewHIBPRangeFile(path)
+	if err != nil {
+		t.Fatalf("open range file: %v", err)
+	}
+	t.Cleanup(func() { rangeFile.Close() })
+	return rangeFile
+}
+
+// withLastDigit changes the last hex digit of hash.
+func withLastDigit(hash string) string {
+	last := "0"
+	if strings.HasSuffix(hash, "0") {
+		last = "1"
+	}
+	return hash[:len(hash)-1] + last
+}
+
+// withFirstDigit changes the first hex digit of hash.
+func withFirstDigit(hash string) string {
+	first := "0"
+	if strings.HasPrefix(hash, "0") {
+		first = "1"
+	}
+	return first + hash[1:]
+}
+
+func TestHIBPRangeFileLookup(t *testing.T) {
+	pwned := pwnedPasswordHash("password")
+
+	// Filler around the interesting lines, so the search has to narrow down
+	filler := []string{}
+	for _, password := range []string{"123456", "qwerty", "letmein", "dragon", "monkey", "abc123", "iloveyou", "sunshine"} {
+		filler = append(filler, pwnedPasswordHash(password)+":10")
+	}
+
+	tests := []struct {
+		name     string
+		lines    []string
+		newline  string
+		password string
+		want     bool
+	}{
+		{"match", append(filler, pwned+":3861493"), "\n", "password", true},
+		{"only line", []string{pwned + ":1"}, "\n", "password", true},
+		{"lower-case hash", append(filler, strings.ToLower(pwned)+":2"), "\n", "password", true},
+		{"windows line endings", append(filler, pwned+":2"), "\r\n", "password", true},
+		{"not listed", filler, "\n", "password", false},
+		{"same prefix, other suffix", append(filler, withLastDigit(pwned)+":2"), "\n", "password", false},
+		{"same suffix, other prefix", append(filler, withFirstDigit(pwned)+":2"), "\n", "password", false},
+		{"zero count padding", append(filler, pwned+":0"), "\n", "password", false},
+		{"empty file", nil, "\n", "password", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rangeFile := writeHIBPRangeFile(t, test.lines, test.newline)
+
+			got, err := rangeFile.IsPasswordPwned(test.password)
+			if err != nil {
+				t.Fatalf("lookup: %v", err)
+			}
+			if got != test.want {
+				t.Fatalf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestHIBPRangeFileEveryLine(t *testing.T) {
+	passwords := []string{"password", "123456", "qwerty", "letmein", "dragon", "monkey", "abc123"}
+	lines := []string{}
+	for _, password := range passwords {
+		lines = append(lines, pwnedPasswordHash(password)+":1")
+	}
+	rangeFile := writeHIBPRangeFile(t, lines, "\n")
+
+	for _, password := range passwords {
+		got, err := rangeFile.IsPasswordPwned(password)
+		if err != nil {
+			t.Fatalf("%s: %v", password, err)
+		}
+		if !got {
+			t.Fatalf("%s: not found", password)
+		}
+	}
+}
+
+func TestParseHIBPLine(t *testing.T) {
+	hash := pwnedPasswordHash("password")
+
+	tests := []struct {
+		name      string
+		line      string
+		wantHash  string
+		wantCount int64
+		wantOK    bool
+	}{
+		{"valid", hash + ":42", hash, 42, true},
+		{"lower-case hash", strings.ToLower(hash) + ":1", hash, 1, true},
+		{"carriage return", hash + ":7\r", hash, 7, true},
+		{"zero count", hash + ":0", hash, 0, true},
+		{"large count", hash + ":9223372036854775807", hash, 9223372036854775807, true},
+		{"empty", "", "", 0, false},
+		{"hash only", hash, "", 0, false},
+		{"empty count", hash + ":", "", 0, false},
+		{"negative count", hash + ":-1", "", 0, false},
+		{"count not a number", hash + ":many", "", 0, false},
+		{"count overflow", hash + ":9223372036854775808", "", 0, false},
+		{"short hash", hash[:39] + ":1", "", 0, false},
+		{"long hash", hash + "0:1", "", 0, false},
+		{"not hex", "Z" + hash[1:] + ":1", "", 0, false},
+		{"range api suffix", hash[5:] + ":1", "", 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hash, count, ok := parseHIBPLine(test.line)
+			if ok != test.wantOK || hash != test.wantHash || count != test.wantCount {
+				t.Fatalf("got (%q, %d, %v), want (%q, %d, %v)", hash, count, ok, test.wantHash, test.wantCount, test.wantOK)
+			}
+		})
+	}
+}
+
+func TestHIBPRangeFileMalformed(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+	}{
+		{"garbage", []string{"not a range file"}},
+		{"missing count", []string{pwnedPasswordHash("password")}},
+		{"api range format", []string{pwnedPasswordHash("password")[5:] + ":1"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rangeFile := writeHIBPRangeFile(t, test.lines, "\n")
+
+			_, err := rangeFile.IsPasswordPwned("password")
+			if err == nil {
+				t.Fatalf("malformed file accepted")
+			}
+		})
+	}
+}
+
+func TestHIBPRangeFileMissing(t *testing.T) {
+	_, err := NewHIBPRangeFile(filepath.Join(t.TempDir(), "missing.txt"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("got %v, want %v", err, os.ErrNotExist)
+	}
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"go.uber.org/zap"
+)
+
+// PwnedPasswordChecker reports whether a password appears in a known breach
+// corpus. Implemented by HIBPClient (online API) and HIBPRangeFile (local
+// copy of the corpus).
+type PwnedPasswordChecker interface {
+	IsPasswordPwned(password string) (bool, error)
+}
+
+// PwnedPasswordPolicy decides what happens when the underlying checker
+// fails. Failing open accepts the password and logs a warning, failing
+// closed returns the error so the caller rejects the request.
+type PwnedPasswordPolicy struct {
+	log      *zap.Logger
+	checker  PwnedPasswordChecker
+	failOpen bool
+}
+
+func NewPwnedPasswordPolicy(log *zap.Logger, checker PwnedPasswordChecker, failOpen bool) *PwnedPasswordPolicy {
+	return &PwnedPasswordPolicy{
+		log:      log,
+		checker:  checker,
+		failOpen: failOpen,
+	}
+}
+
+func (c *PwnedPasswordPolicy) IsPasswordPwned(password string) (bool, error) {
+	isPwned, err := c.checker.IsPasswordPwned(password)
+	if err != nil && c.failOpen {
+		c.log.Warn("breached password check failed, accepting password", zap.Error(err))
+		return false, nil
+	}
+
+	return isPwned, err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -12,11 +13,14 @@ import (
 
 // S3Client is a client for interacting with an S3-compatible object store.
 type S3Client struct {
-	s3Client *s3.Client
+	s3Client      *s3.Client
+	presignClient *s3.PresignClient
 }
 
-// NewS3Client creates a new S3Client.
-func NewS3Client(ctx context.Context) (*S3Client, error) {
+// NewS3Client creates a new S3Client. usePathStyle addresses buckets as
+// endpoint/bucket instead of bucket.endpoint, which S3-compatible stores
+// such as s3mock or MinIO need.
+func NewS3Client(ctx context.Context, usePathStyle bool) (*S3Client, error) {
 	// Load the AWS configuration from environment variables, shared config files, etc.
 	cfg, err := config.LoadDefaultConfig(ctx)
 	if err != nil {
@@ -24,10 +28,13 @@ func NewS3Client(ctx context.Context) (*S3Client, error) {
 	}
 
 	// Create an S3 client
-	s3Client := s3.NewFromConfig(cfg)
+	s3Client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.UsePathStyle = usePathStyle
+	})
 
 	return &S3Client{
-		s3Client: s3Client,
+		s3Client:      s3Client,
+		presignClient: s3.NewPresignClient(s3Client),
 	}, nil
 }
 
@@ -43,3 +50,16 @@ func (c *S3Client) UploadFile(ctx context.Context, bucket, key string, data io.R
 	}
 	return nil
 }
+
+// PresignGetURL returns a URL that downloads the object without credentials
+// until ttl passes.
+func (c *S3Client) PresignGetURL(ctx context.Context, bucket, key string, ttl time.Duration) (string, error) {
+	request, err := c.presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}, s3.WithPresignExpires(ttl))
+	if err != nil {
+		return "", fmt.Errorf("failed to presign S3 URL: %w", err)
+	}
+	return request.URL, nil
+}
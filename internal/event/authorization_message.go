@@ -6,13 +6,20 @@ const AUTHORIZATION_LOGOUT = "authorization.logout"
 const AUTHORIZATION_REFRESH_TOKEN = "authorization.refresh-token"
 const AUTHORIZATION_VALIDATE_TOKEN = "authorization.validate-token"
 const AUTHORIZATION_REQUEST_PASSWORD_RESET = "authorization.request-password-reset"
+const AUTHORIZATION_ACCOUNT_LOCKED = "authorization.account-locked"
+const AUTHORIZATION_REQUEST_MAGIC_LINK = "authorization.request-magic-link"
+const AUTHORIZATION_REQUEST_VERIFICATION = "authorization.request-verification"
+const AUTHORIZATION_REQUEST_EMAIL_CHANGE = "authorization.request-email-change"
+const AUTHORIZATION_REQUEST_ACCOUNT_DELETION = "authorization.request-account-deletion"
 
 type AuthorizationRegisterMessage struct {
-	ID string
+	ID                string
+	VerificationToken string // only its hash is stored, the worker needs it for the link
 }
 
 type AuthorizationLoginMessage struct {
-	ID string
+	ID        string
+	SessionID string
 }
 
 type AuthorizationLogoutMessage struct {
@@ -28,5 +35,32 @@ type AuthorizationValidateTokenMessage struct {
 }
 
 type AuthorizationRequestPasswordReset struct {
+	ID    string
+	Token string // only its hash is stored, the worker needs it for the link
+}
+
+type AuthorizationAccountLockedMessage struct {
+	ID    string
+	Token string // only its hash is stored, the worker needs it for the link
+}
+
+type AuthorizationRequestMagicLinkMessage struct {
+	ID    string
+	Token string // only its hash is stored, the worker needs it for the link
+}
+
+type AuthorizationRequestVerificationMessage struct {
+	ID                string
+	VerificationToken string // only its hash is stored, the worker needs it for the link
+}
+
+type AuthorizationRequestEmailChangeMessage struct {
+	ID            string
+	EmailChangeID string
+	Token         string // confirmation token for the new address
+	RevertToken   string // revert token for the old address
+}
+
+type AuthorizationRequestAccountDeletionMessage struct {
 	ID string
 }
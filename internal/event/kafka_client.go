@@ -3,11 +3,13 @@ package event
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"strconv"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/segmentio/kafka-go"
 )
 
@@ -19,6 +21,19 @@ type KafkaClient struct {
 }
 
 func NewKafkaClient(host string, port string, topicID string, groupID string) (*KafkaClient, error) {
+	return newKafkaClient(host, port, topicID, groupID, kafka.FirstOffset)
+}
+
+// NewKafkaBroadcastClient creates a client whose reader joins a consumer
+// group of its own, so every instance receives every message of the topic
+// instead of sharing them. Reading starts at messages written after the
+// instance joined.
+func NewKafkaBroadcastClient(host string, port string, topicID string, groupPrefix string) (*KafkaClient, error) {
+	groupID := fmt.Sprintf("%s-%s", groupPrefix, uuid.New().String())
+	return newKafkaClient(host, port, topicID, groupID, kafka.LastOffset)
+}
+
+func newKafkaClient(host string, port string, topicID string, groupID string, startOffset int64) (*KafkaClient, error) {
 	this := &KafkaClient{
 		host: host,
 		port: port,
@@ -36,11 +51,12 @@ func NewKafkaClient(host string, port string, topicID string, groupID string) (*
 				Brokers: []string{
 					fmt.Sprintf("%s:%s", host, port),
 				},
-				GroupID:  groupID,
-				Topic:    topicID,
-				MaxWait:  500 * time.Millisecond,
-				MinBytes: 1,
-				MaxBytes: 1024 * 1024,
+				GroupID:     groupID,
+				Topic:       topicID,
+				StartOffset: startOffset,
+				MaxWait:     500 * time.Millisecond,
+				MinBytes:    1,
+				MaxBytes:    1024 * 1024,
 			},
 		),
 	}
@@ -109,3 +125,7 @@ func (this *KafkaClient) ReadMessage(context context.Context) (string, string, e
 
 	return string(message.Key), string(message.Value), nil
 }
+
+func (this *KafkaClient) Close() error {
+	return errors.Join(this.writer.Close(), this.reader.Close())
+}
@@ -0,0 +1,14 @@
+package event
+
+const PLATFORM_REGISTRATION_APPROVED = "platform.registration-approved"
+const PLATFORM_REGISTRATION_REJECTED = "platform.registration-rejected"
+
+type PlatformRegistrationApprovedMessage struct {
+	ID string
+}
+
+// The account is deleted on rejection, so the message carries the address
+type PlatformRegistrationRejectedMessage struct {
+	Email string
+	Name  string
+}
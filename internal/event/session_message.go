@@ -0,0 +1,7 @@
+package event
+
+const SESSION_REVOKED = "session.revoked"
+
+type SessionRevokedMessage struct {
+	SessionIDs []string
+}
@@ -0,0 +1,8 @@
+package event
+
+const USER_REQUEST_DATA_EXPORT = "user.request-data-export"
+
+type UserRequestDataExportMessage struct {
+	ID       string
+	ExportID string
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	httpSwagger "github.com/swaggo/http-swagger/v2"
@@ -44,6 +45,7 @@ func (this *Gateway) Start() error {
 	// Создание gRPC-gateway mux
 	mux := runtime.NewServeMux(
 		runtime.WithHealthzEndpoint(nil),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
 	)
 
 	opts := []grpc.DialOption{
@@ -102,6 +104,13 @@ func (this *Gateway) registerServices(ctx context.Context, mux *runtime.ServeMux
 	}
 	this.logger.Info("Registered CommunityService")
 
+	// Platform Service
+	err = protopkg.RegisterPlatformServiceHandlerFromEndpoint(ctx, mux, this.grpcEndpoint, opts)
+	if err != nil {
+		return fmt.Errorf("register platform service: %w", err)
+	}
+	this.logger.Info("Registered PlatformService")
+
 	// TODO: Register remaining services:
 	// - UserService
 	// - PostService
@@ -109,7 +118,6 @@ func (this *Gateway) registerServices(ctx context.Context, mux *runtime.ServeMux
 	// - FeedService
 	// - RoleService
 	// - PermissionService
-	// - PlatformService
 	// - ModerationService
 	// - ReportService
 	// - MediaService
@@ -153,3 +161,11 @@ func (this *Gateway) setupRoutes(grpcMux *runtime.ServeMux) *http.ServeMux {
 	return mux
 }
 
+// incomingHeaderMatcher forwards the solved challenge header as is, in
+// addition to the default Grpc-Metadata- prefixed headers.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, "X-Challenge-Response") {
+		return "x-challenge-response", true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -1,36 +1,181 @@
 package grpcauthorization
 
 import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/stormhead-org/backend/internal/jwt"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	clientpkg "github.com/stormhead-org/backend/internal/client"
-	eventpkg "github.com/stormhead-org/backend/internal/event"
+	"github.com/stormhead-org/backend/internal/lib"
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
 	ormpkg "github.com/stormhead-org/backend/internal/orm"
 	protopkg "github.com/stormhead-org/backend/internal/proto"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
 )
 
+// Database is the part of the store AuthorizationServer uses.
+type Database interface {
+	ormpkg.Store
+	ormpkg.PlatformSettingStore
+	ormpkg.PersonalAccessTokenStore
+	ormpkg.EmailChangeStore
+}
+
+// Broker is where AuthorizationServer writes its events.
+type Broker interface {
+	WriteMessage(ctx context.Context, key string, value any) error
+}
+
 type AuthorizationServer struct {
 	protopkg.UnimplementedAuthorizationServiceServer
-	log      *zap.Logger
-	jwt      *jwt.JWT
-	hibp     *clientpkg.HIBPClient
-	database *ormpkg.PostgresClient
-	broker   *eventpkg.KafkaClient
+	log        *zap.Logger
+	jwt        *jwt.JWT
+	hibp       clientpkg.PwnedPasswordChecker
+	geoip      *clientpkg.GeoIPDatabase
+	hasher     *securitypkg.PasswordHasher
+	database   Database
+	broker     Broker
+	sessions   *middlewarepkg.SessionCache
+	challenges *middlewarepkg.ChallengeGuard
 }
 
 func NewAuthorizationServer(
 	log *zap.Logger,
 	jwt *jwt.JWT,
-	hibp *clientpkg.HIBPClient,
-	database *ormpkg.PostgresClient,
-	broker *eventpkg.KafkaClient,
+	hibp clientpkg.PwnedPasswordChecker,
+	geoip *clientpkg.GeoIPDatabase,
+	hasher *securitypkg.PasswordHasher,
+	database Database,
+	broker Broker,
+	sessions *middlewarepkg.SessionCache,
+	challenges *middlewarepkg.ChallengeGuard,
 ) *AuthorizationServer {
 	return &AuthorizationServer{
-		log:      log,
-		jwt:      jwt,
-		hibp:     hibp,
-		database: database,
-		broker:   broker,
+		log:        log,
+		jwt:        jwt,
+		hibp:       hibp,
+		geoip:      geoip,
+		hasher:     hasher,
+		database:   database,
+		broker:     broker,
+		sessions:   sessions,
+		challenges: challenges,
+	}
+}
+
+// clientInfo returns the user agent and ip address of the caller, or
+// "unknown" when they are not available.
+func clientInfo(ctx context.Context) (string, string) {
+	userAgent := "unknown"
+	m, ok := metadata.FromIncomingContext(ctx)
+	if ok && len(m["user-agent"]) > 0 {
+		userAgent = strings.Join(m["user-agent"], "")
+	}
+
+	ipAddress := "unknown"
+	p, ok := peer.FromContext(ctx)
+	if ok {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err == nil {
+			ipAddress = host
+		}
+	}
+
+	return userAgent, ipAddress
+}
+
+// issueOneTimeToken stores a new single use token for the user, replacing
+// unused ones of the same purpose, and returns it for the email link. Only
+// its hash is kept. database may be a transaction.
+func issueOneTimeToken(database ormpkg.OneTimeTokenStore, userID uuid.UUID, purpose string, ttl time.Duration) (string, error) {
+	token := securitypkg.GenerateURLSafeToken()
+	err := database.InsertOneTimeToken(&ormpkg.OneTimeToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: securitypkg.HashToken(token),
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// revokeSessions makes already deleted sessions unusable on every server
+// instance right away, instead of once their access tokens expire.
+func (s *AuthorizationServer) revokeSessions(ctx context.Context, sessionIDs []string) {
+	err := s.sessions.Revoke(ctx, sessionIDs)
+	if err != nil {
+		s.log.Error("failed to broadcast session revocation", zap.Error(err))
+	}
+}
+
+// checkEmail normalizes an address a user wants to register or switch to
+// and applies the email domain policy. The error is ready to be returned.
+func (s *AuthorizationServer) checkEmail(email string) (string, error) {
+	normalized, err := lib.NormalizeEmail(email)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	rules, err := s.database.SelectEmailDomainRules()
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return "", status.Errorf(codes.Internal, "internal error")
+	}
+
+	allowed := map[string]bool{}
+	denied := map[string]bool{}
+	for _, rule := range rules {
+		switch rule.Kind {
+		case ormpkg.EMAIL_DOMAIN_RULE_ALLOW:
+			allowed[rule.Domain] = true
+		case ormpkg.EMAIL_DOMAIN_RULE_DENY:
+			denied[rule.Domain] = true
+		}
+	}
+
+	err = lib.CheckEmailDomain(lib.EmailDomain(normalized), allowed, denied)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	return normalized, nil
+}
+
+// lookupEmail returns the form an entered address is stored in, to find
+// existing accounts. Addresses that do not parse are only lowercased.
+func lookupEmail(email string) string {
+	normalized, err := lib.NormalizeEmail(email)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(email))
+	}
+	return normalized
+}
+
+func sessionToProto(session *ormpkg.Session) *protopkg.Session {
+	return &protopkg.Session{
+		SessionId: session.ID.String(),
+		UserAgent: session.UserAgent,
+		IpAddress: session.IpAddress,
+		CreatedAt: timestamppb.New(session.CreatedAt),
+		UpdatedAt: timestamppb.New(session.UpdatedAt),
+		Name:      session.Name,
+		Browser:   session.Browser,
+		Os:        session.OS,
+		Device:    session.Device,
+		Country:   session.Country,
+		City:      session.City,
 	}
 }
@@ -0,0 +1,120 @@
+package grpcauthorization
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	eventpkg "github.com/stormhead-org/backend/internal/event"
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
+)
+
+func (s *AuthorizationServer) ChangeEmail(ctx context.Context, req *protopkg.ChangeEmailRequest) (*protopkg.ChangeEmailResponse, error) {
+	userID, err := middlewarepkg.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "not authenticated")
+	}
+
+	if req.Password == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "password is required")
+	}
+
+	newEmail, err := s.checkEmail(req.NewEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rate limit per user, every change sends two emails
+	throttle, err := s.database.SelectAuthThrottle(securitypkg.EMAIL_CHANGE_THROTTLE_USER, userID)
+	if err == nil && throttle.IsLocked(time.Now()) {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many email change requests, try again later")
+	}
+
+	_, _, err = s.incrementThrottle(securitypkg.EmailChangeThrottlePolicy, userID)
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	user, err := s.database.SelectUserByID(userID)
+	if err != nil {
+		s.log.Error("failed to retrieve user for email change", zap.Error(err), zap.String("userID", userID))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	// Check password
+	_, err = s.hasher.Verify(
+		user.Password,
+		req.Password,
+		user.Salt,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "password invalid")
+	}
+
+	if strings.EqualFold(newEmail, user.Email) {
+		return nil, status.Errorf(codes.InvalidArgument, "new email is the same as the current one")
+	}
+
+	// Validate email
+	_, err = s.database.SelectUserByEmail(
+		newEmail,
+	)
+	if err != gorm.ErrRecordNotFound {
+		return nil, status.Errorf(codes.AlreadyExists, "email already exist")
+	}
+
+	token := securitypkg.GenerateURLSafeToken()
+	revertToken := securitypkg.GenerateURLSafeToken()
+	now := time.Now()
+	change := &ormpkg.EmailChange{
+		UserID:   user.ID,
+		OldEmail: user.Email,
+		NewEmail: newEmail,
+	}
+	err = s.database.InsertEmailChange(
+		change,
+		&ormpkg.OneTimeToken{
+			UserID:    user.ID,
+			Purpose:   ormpkg.TOKEN_PURPOSE_EMAIL_CHANGE,
+			TokenHash: securitypkg.HashToken(token),
+			ExpiresAt: now.Add(securitypkg.EMAIL_CHANGE_TOKEN_TTL),
+		},
+		&ormpkg.OneTimeToken{
+			UserID:    user.ID,
+			Purpose:   ormpkg.TOKEN_PURPOSE_EMAIL_CHANGE_REVERT,
+			TokenHash: securitypkg.HashToken(revertToken),
+			ExpiresAt: now.Add(securitypkg.EMAIL_CHANGE_REVERT_TTL),
+		},
+	)
+	if err != nil {
+		s.log.Error("failed to insert email change", zap.Error(err), zap.String("userID", userID))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	// Write message to broker for email sending
+	err = s.broker.WriteMessage(
+		ctx,
+		eventpkg.AUTHORIZATION_REQUEST_EMAIL_CHANGE,
+		eventpkg.AuthorizationRequestEmailChangeMessage{
+			ID:            user.ID.String(),
+			EmailChangeID: change.ID.String(),
+			Token:         token,
+			RevertToken:   revertToken,
+		},
+	)
+	if err != nil {
+		s.log.Error("failed to write email change event to broker", zap.Error(err), zap.String("userID", userID))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	return &protopkg.ChangeEmailResponse{}, nil
+}
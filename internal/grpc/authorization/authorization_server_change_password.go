@@ -10,8 +10,8 @@ import (
 	"google.golang.org/grpc/status"
 
 	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
 	protopkg "github.com/stormhead-org/backend/internal/proto"
-	securitypkg "github.com/stormhead-org/backend/internal/security"
 )
 
 func (s *AuthorizationServer) ChangePassword(ctx context.Context, req *protopkg.ChangePasswordRequest) (*protopkg.ChangePasswordResponse, error) {
@@ -25,6 +25,11 @@ func (s *AuthorizationServer) ChangePassword(ctx context.Context, req *protopkg.
 		return nil, status.Errorf(codes.Internal, "invalid user ID in token")
 	}
 
+	sessionID, err := middlewarepkg.GetSessionID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "not authenticated")
+	}
+
 	if req.OldPassword == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "old password is required")
 	}
@@ -40,7 +45,7 @@ func (s *AuthorizationServer) ChangePassword(ctx context.Context, req *protopkg.
 	}
 
 	// Check old password
-	err = securitypkg.ComparePasswords(
+	_, err = s.hasher.Verify(
 		user.Password,
 		req.OldPassword,
 		user.Salt,
@@ -58,41 +63,35 @@ func (s *AuthorizationServer) ChangePassword(ctx context.Context, req *protopkg.
 	isPwned, err := s.hibp.IsPasswordPwned(req.NewPassword)
 	if err != nil {
 		s.log.Error("failed to check new password against HIBP", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "failed to validate new password")
+		return nil, status.Errorf(codes.Unavailable, "failed to validate new password, try again later")
 	}
 	if isPwned {
 		return nil, status.Errorf(codes.InvalidArgument, "new password has been pwned, please choose a different one")
 	}
 
-	// Salt new password
-	salt := securitypkg.GenerateSalt()
-
-	hash, err := securitypkg.HashPassword(
-		req.NewPassword,
-		salt,
-	)
+	hash, err := s.hasher.Hash(req.NewPassword)
 	if err != nil {
 		s.log.Error("failed to hash new password", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
 
-	// Update user in database
-	user.Password = hash
-	user.Salt = salt
-
-	err = s.database.UpdateUser(user)
+	// Update the password and revoke all other sessions together, the
+	// caller stays signed in
+	var sessionIDs []string
+	err = s.database.WithTx(ctx, func(tx ormpkg.Store) error {
+		err := tx.UpdateUserPassword(user.ID.String(), hash)
+		if err != nil {
+			return err
+		}
+
+		sessionIDs, err = tx.DeleteOtherSessionsByUserID(userID.String(), sessionID)
+		return err
+	})
 	if err != nil {
 		s.log.Error("failed to update user with new password", zap.Error(err), zap.String("userID", userID.String()))
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
-
-	// Revoke all active sessions for the user
-	err = s.database.DeleteSessionsByUserID(userID.String())
-	if err != nil {
-		s.log.Error("failed to delete user sessions after password change", zap.Error(err), zap.String("userID", userID.String()))
-		// Do not return an error here, as the password change was successful.
-		// Logging the error is sufficient.
-	}
+	s.revokeSessions(ctx, sessionIDs)
 
 	return &protopkg.ChangePasswordResponse{}, nil
 }
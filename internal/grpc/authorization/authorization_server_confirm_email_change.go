@@ -0,0 +1,37 @@
+package grpcauthorization
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
+)
+
+func (s *AuthorizationServer) ConfirmEmailChange(ctx context.Context, req *protopkg.ConfirmEmailChangeRequest) (*protopkg.ConfirmEmailChangeResponse, error) {
+	if req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email change token is required")
+	}
+
+	change, err := s.database.ConfirmEmailChange(securitypkg.HashToken(req.Token))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid or expired token")
+	}
+	if errors.Is(err, ormpkg.ErrEmailTaken) {
+		return nil, status.Errorf(codes.FailedPrecondition, "email already exist")
+	}
+	if err != nil {
+		s.log.Error("failed to confirm email change", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	return &protopkg.ConfirmEmailChangeResponse{
+		Email: change.NewEmail,
+	}, nil
+}
@@ -2,12 +2,14 @@ package grpcauthorization
 
 import (
 	"context"
-	"time"
+	"errors"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
 	protopkg "github.com/stormhead-org/backend/internal/proto"
 	securitypkg "github.com/stormhead-org/backend/internal/security"
 )
@@ -21,15 +23,7 @@ func (s *AuthorizationServer) ConfirmPasswordReset(ctx context.Context, req *pro
 		return nil, status.Errorf(codes.InvalidArgument, "new password is required")
 	}
 
-	user, err := s.database.SelectUserByResetToken(req.Token)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "user not exist or token is invalid")
-	}
-
-	if user.ResetTokenExpiresAt == nil || time.Now().After(*user.ResetTokenExpiresAt) {
-		return nil, status.Errorf(codes.InvalidArgument, "reset token expired or invalid")
-	}
-
+	// Validate the new password before the token is consumed
 	if len(req.Password) < 12 {
 		return nil, status.Errorf(codes.InvalidArgument, "password must be at least 12 characters long")
 	}
@@ -37,32 +31,43 @@ func (s *AuthorizationServer) ConfirmPasswordReset(ctx context.Context, req *pro
 	isPwned, err := s.hibp.IsPasswordPwned(req.Password)
 	if err != nil {
 		s.log.Error("failed to check password against HIBP", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "failed to validate password")
+		return nil, status.Errorf(codes.Unavailable, "failed to validate password, try again later")
 	}
 	if isPwned {
 		return nil, status.Errorf(codes.InvalidArgument, "password has been pwned, please choose a different one")
 	}
 
-	salt := securitypkg.GenerateSalt()
-	hash, err := securitypkg.HashPassword(req.Password, salt)
+	hash, err := s.hasher.Hash(req.Password)
 	if err != nil {
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
 
-	user.Password = hash
-	user.Salt = salt
-	user.ResetToken = ""
-	user.ResetTokenExpiresAt = nil
+	// The token is only spent if the password is changed and every session
+	// signed out
+	var sessionIDs []string
+	err = s.database.WithTx(ctx, func(tx ormpkg.Store) error {
+		token, err := tx.ConsumeOneTimeToken(ormpkg.TOKEN_PURPOSE_PASSWORD_RESET, securitypkg.HashToken(req.Token))
+		if err != nil {
+			return err
+		}
 
-	if err := s.database.UpdateUser(user); err != nil {
+		err = tx.UpdateUserPassword(token.UserID.String(), hash)
+		if err != nil {
+			return err
+		}
+
+		sessionIDs, err = tx.DeleteSessionsByUserID(token.UserID.String())
+		return err
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.InvalidArgument, "reset token expired or invalid")
+	}
+	if err != nil {
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
-
-	if err := s.database.DeleteSessionsByUserID(user.ID.String()); err != nil {
-		s.log.Error("failed to delete user sessions after password reset", zap.Error(err))
-	}
+	s.revokeSessions(ctx, sessionIDs)
 
 	return &protopkg.ConfirmResetPasswordResponse{}, nil
 }
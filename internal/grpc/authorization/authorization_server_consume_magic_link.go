@@ -0,0 +1,48 @@
+package grpcauthorization
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
+)
+
+func (s *AuthorizationServer) ConsumeMagicLink(ctx context.Context, req *protopkg.ConsumeMagicLinkRequest) (*protopkg.ConsumeMagicLinkResponse, error) {
+	if req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "magic link token is required")
+	}
+
+	// Obtain user agent and ip address
+	userAgent, ipAddress := clientInfo(ctx)
+	if userAgent == "unknown" || ipAddress == "unknown" {
+		s.log.Error("internal error", zap.String("user_agent", userAgent), zap.String("ip_address", ipAddress))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	token, err := s.database.ConsumeOneTimeToken(ormpkg.TOKEN_PURPOSE_MAGIC_LINK, securitypkg.HashToken(req.Token))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid or expired token")
+	}
+
+	user, err := s.database.SelectUserByID(token.UserID.String())
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	response, err := s.startSession(ctx, user, userAgent, ipAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopkg.ConsumeMagicLinkResponse{
+		User:         response.User,
+		AccessToken:  response.AccessToken,
+		RefreshToken: response.RefreshToken,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package grpcauthorization
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
+)
+
+func (s *AuthorizationServer) CreatePersonalAccessToken(ctx context.Context, req *protopkg.CreatePersonalAccessTokenRequest) (*protopkg.CreatePersonalAccessTokenResponse, error) {
+	userID, err := middlewarepkg.GetUserUUID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "not authenticated")
+	}
+
+	if req.Name == "" || len(req.Name) > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be between 1 and 100 characters")
+	}
+
+	if len(req.Scopes) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one scope is required")
+	}
+	scopes := []string{}
+	seen := map[string]bool{}
+	for _, scope := range req.Scopes {
+		if !middlewarepkg.IsPersonalAccessTokenScope(scope) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown scope %q", scope)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+	scopesJSON, err := json.Marshal(scopes)
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	var communityID *uuid.UUID
+	if req.CommunityId != "" {
+		community, err := s.database.SelectCommunityByID(req.CommunityId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "community not found")
+		}
+		communityID = &community.ID
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		if !t.After(time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "expiration must be in the future")
+		}
+		expiresAt = &t
+	}
+
+	token := middlewarepkg.PERSONAL_ACCESS_TOKEN_PREFIX + securitypkg.GenerateURLSafeToken()
+
+	pat := ormpkg.PersonalAccessToken{
+		UserID:      userID,
+		Name:        req.Name,
+		TokenHash:   securitypkg.HashToken(token),
+		TokenPrefix: token[:12],
+		Scopes:      scopesJSON,
+		CommunityID: communityID,
+		ExpiresAt:   expiresAt,
+	}
+	err = s.database.InsertPersonalAccessToken(&pat)
+	if err != nil {
+		s.log.Error("failed to insert personal access token", zap.Error(err), zap.String("userID", userID.String()))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	return &protopkg.CreatePersonalAccessTokenResponse{
+		PersonalAccessToken: personalAccessTokenToProto(&pat),
+		Token:               token,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package grpcauthorization
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+func (s *AuthorizationServer) GetChallenge(ctx context.Context, req *protopkg.GetChallengeRequest) (*protopkg.GetChallengeResponse, error) {
+	challenge, err := s.challenges.Issue(ctx)
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	response := &protopkg.GetChallengeResponse{
+		Kind:       challenge.Kind,
+		Challenge:  challenge.Token,
+		Difficulty: int32(challenge.Difficulty),
+		SiteKey:    challenge.SiteKey,
+		Methods:    s.challenges.Methods(),
+	}
+	if !challenge.ExpiresAt.IsZero() {
+		response.ExpiresAt = timestamppb.New(challenge.ExpiresAt)
+	}
+
+	return response, nil
+}
@@ -7,7 +7,6 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 
 	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
@@ -34,13 +33,7 @@ func (s *AuthorizationServer) GetCurrentSession(ctx context.Context, req *protop
 	}
 
 	return &protopkg.GetCurrentSessionResponse{
-			Session: &protopkg.Session{
-				SessionId: session.ID.String(),
-				UserAgent: session.UserAgent,
-				IpAddress: session.IpAddress,
-				CreatedAt: timestamppb.New(session.CreatedAt),
-				UpdatedAt: timestamppb.New(session.UpdatedAt),
-			},
+			Session: sessionToProto(session),
 		},
 		nil
 }
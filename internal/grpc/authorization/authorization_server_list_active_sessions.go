@@ -7,8 +7,8 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/stormhead-org/backend/internal/lib"
 	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
 	protopkg "github.com/stormhead-org/backend/internal/proto"
 )
@@ -27,33 +27,25 @@ func (s *AuthorizationServer) ListActiveSessions(ctx context.Context, req *proto
 	if req.Limit <= 0 || req.Limit > 50 {
 		req.Limit = SESSIONS_PER_PAGE
 	}
-	sessions, err := s.database.SelectSessionsByUserID(userID.String(), req.Cursor, int(req.Limit)+1)
+	sessions, page, err := s.database.SelectSessionsByUserID(userID.String(), req.Cursor, int(req.Limit))
 	if err != nil {
+		if err == lib.ErrInvalidCursor {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
 
-	var nextCursor string
-	if len(sessions) > int(req.Limit) {
-		nextCursor = sessions[req.Limit].ID.String()
-		sessions = sessions[:req.Limit]
-	}
-
 	pbSessions := make([]*protopkg.Session, len(sessions))
 	for i, session := range sessions {
-		pbSessions[i] = &protopkg.Session{
-			SessionId: session.ID.String(),
-			UserAgent: session.UserAgent,
-			IpAddress: session.IpAddress,
-			CreatedAt: timestamppb.New(session.CreatedAt),
-			UpdatedAt: timestamppb.New(session.UpdatedAt),
-		}
+		pbSessions[i] = sessionToProto(session)
 	}
 
 	return &protopkg.ListActiveSessionsResponse{
 			Sessions:   pbSessions,
-			NextCursor: nextCursor,
-			HasMore:    nextCursor != "",
+			NextCursor: page.NextCursor,
+			PrevCursor: page.PrevCursor,
+			HasMore:    page.HasMore,
 		},
 		nil
 }
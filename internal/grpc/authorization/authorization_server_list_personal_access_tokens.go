@@ -0,0 +1,57 @@
+package grpcauthorization
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+func (s *AuthorizationServer) ListPersonalAccessTokens(ctx context.Context, req *protopkg.ListPersonalAccessTokensRequest) (*protopkg.ListPersonalAccessTokensResponse, error) {
+	userID, err := middlewarepkg.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "not authenticated")
+	}
+
+	tokens, err := s.database.SelectPersonalAccessTokensByUserID(userID)
+	if err != nil {
+		s.log.Error("failed to list personal access tokens", zap.Error(err), zap.String("userID", userID))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	protoTokens := make([]*protopkg.PersonalAccessToken, len(tokens))
+	for i, token := range tokens {
+		protoTokens[i] = personalAccessTokenToProto(token)
+	}
+
+	return &protopkg.ListPersonalAccessTokensResponse{
+		PersonalAccessTokens: protoTokens,
+	}, nil
+}
+
+func personalAccessTokenToProto(token *ormpkg.PersonalAccessToken) *protopkg.PersonalAccessToken {
+	result := &protopkg.PersonalAccessToken{
+		Id:          token.ID.String(),
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      token.ScopeList(),
+		CreatedAt:   timestamppb.New(token.CreatedAt),
+	}
+	if token.CommunityID != nil {
+		result.CommunityId = token.CommunityID.String()
+	}
+	if token.ExpiresAt != nil {
+		result.ExpiresAt = timestamppb.New(*token.ExpiresAt)
+	}
+	if token.LastUsedAt != nil {
+		result.LastUsedAt = timestamppb.New(*token.LastUsedAt)
+	}
+
+	return result
+}
@@ -2,15 +2,16 @@ package grpcauthorization
 
 import (
 	"context"
-	"strings"
+	"errors"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 
 	eventpkg "github.com/stormhead-org/backend/internal/event"
+	"github.com/stormhead-org/backend/internal/lib"
 	ormpkg "github.com/stormhead-org/backend/internal/orm"
 	protopkg "github.com/stormhead-org/backend/internal/proto"
 	securitypkg "github.com/stormhead-org/backend/internal/security"
@@ -24,49 +25,102 @@ func (s *AuthorizationServer) Login(ctx context.Context, req *protopkg.LoginRequ
 		return nil, status.Errorf(codes.InvalidArgument, "password is required")
 	}
 
+	// Obtain user agent and ip address
+	userAgent, ipAddress := clientInfo(ctx)
+	if userAgent == "unknown" || ipAddress == "unknown" {
+		s.log.Error("internal error", zap.String("user_agent", userAgent), zap.String("ip_address", ipAddress))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	email := lookupEmail(req.Email)
+
+	// Refuse attempts while the account or the ip address is throttled
+	locked, err := s.isLoginThrottled(email, ipAddress)
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+	if locked {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many login attempts, try again later")
+	}
+
 	// Get user from database
 	user, err := s.database.SelectUserByEmail(
-		req.Email,
+		email,
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "user not found")
-	}
-	if !user.IsVerified {
-		return nil, status.Errorf(codes.InvalidArgument, "user not verified")
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.log.Error("internal error", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "internal error")
+		}
+
+		// Spend the same time as for an existing account
+		s.hasher.VerifyDummy(req.Password)
+
+		err = s.recordLoginFailure(ctx, nil, email, ipAddress)
+		if err != nil {
+			s.log.Error("internal error", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "internal error")
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "invalid email or password")
 	}
 
-	err = securitypkg.ComparePasswords(
+	needsRehash, err := s.hasher.Verify(
 		user.Password,
 		req.Password,
 		user.Salt,
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "password invalid")
-	}
+		if !errors.Is(err, securitypkg.ErrPasswordMismatch) {
+			s.log.Error("failed to verify password", zap.Error(err), zap.String("userID", user.ID.String()))
+		}
 
-	// Obtain user agent and ip address
-	userAgent := "unknown"
-	m, ok := metadata.FromIncomingContext(ctx)
-	if ok {
-		userAgent = strings.Join(m["user-agent"], "")
+		err = s.recordLoginFailure(ctx, user, email, ipAddress)
+		if err != nil {
+			s.log.Error("internal error", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "internal error")
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "invalid email or password")
 	}
 
-	ipAddress := "unknown"
-	p, ok := peer.FromContext(ctx)
-	if ok {
-		parts := strings.Split(p.Addr.String(), ":")
-		if len(parts) == 2 {
-			ipAddress = parts[0]
-		}
+	// Password is correct, so revealing verification state is safe
+	if !user.IsVerified {
+		return nil, status.Errorf(codes.FailedPrecondition, "user not verified")
+	}
+	if !user.IsApproved {
+		return nil, status.Errorf(codes.FailedPrecondition, "registration is awaiting approval")
 	}
 
-	if userAgent == "unknown" || ipAddress == "unknown" {
+	err = s.database.DeleteAuthThrottle(securitypkg.LOGIN_THROTTLE_ACCOUNT, email)
+	if err != nil {
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
 
+	// Upgrade legacy or outdated password hash while the plain password is known
+	if needsRehash {
+		hash, err := s.hasher.Hash(req.Password)
+		if err == nil {
+			err = s.database.UpdateUserPassword(user.ID.String(), hash)
+		}
+		if err != nil {
+			s.log.Error("failed to rehash password", zap.Error(err), zap.String("userID", user.ID.String()))
+		}
+	}
+
+	return s.startSession(ctx, user, userAgent, ipAddress)
+}
+
+// startSession creates a session for an authenticated user and issues its
+// token pair. Shared by every way of logging in.
+func (s *AuthorizationServer) startSession(ctx context.Context, user *ormpkg.User, userAgent string, ipAddress string) (*protopkg.LoginResponse, error) {
+	// Accounts waiting for approval cannot log in by any means
+	if !user.IsApproved {
+		return nil, status.Errorf(codes.FailedPrecondition, "registration is awaiting approval")
+	}
+
 	// Check existing sessions
-	sessions, err := s.database.SelectSessionsByUserID(user.ID.String(), "", 0)
+	sessions, _, err := s.database.SelectSessionsByUserID(user.ID.String(), "", -1)
 	if err != nil {
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "internal error")
@@ -86,23 +140,66 @@ func (s *AuthorizationServer) Login(ctx context.Context, req *protopkg.LoginRequ
 	}
 
 	// Create session
-	session := ormpkg.Session{
+	device := lib.ParseUserAgent(userAgent)
+	location := s.geoip.Lookup(ipAddress)
+	newSession := ormpkg.Session{
 		UserID:    user.ID,
 		UserAgent: userAgent,
 		IpAddress: ipAddress,
+		Browser:   device.Browser,
+		OS:        device.OS,
+		Device:    device.Device,
+		Country:   location.Country,
+		City:      location.City,
 	}
-	err = s.database.InsertSession(&session)
+	var session ormpkg.Session
+	var accessToken, refreshToken string
+	err = s.database.WithTx(ctx, func(tx ormpkg.Store) error {
+		// A retried attempt starts from the unsaved session
+		session = newSession
+
+		// Logging in during the grace period cancels a pending account deletion
+		if user.DeletionScheduledAt != nil {
+			err := tx.CancelUserDeletion(user.ID.String())
+			if err != nil {
+				return err
+			}
+		}
+
+		err := tx.InsertSession(&session)
+		if err != nil {
+			return err
+		}
+
+		accessToken, err = s.jwt.GenerateAccessToken(session.ID.String())
+		if err != nil {
+			return err
+		}
+
+		refreshToken, err = s.jwt.GenerateRefreshToken(session.ID.String())
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.RotateSessionRefreshToken(session.ID.String(), "", securitypkg.HashToken(refreshToken))
+		return err
+	})
 	if err != nil {
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
 
+	if user.DeletionScheduledAt != nil {
+		s.log.Info("account deletion cancelled by login", zap.String("userID", user.ID.String()))
+	}
+
 	// Write message to broker
 	err = s.broker.WriteMessage(
 		ctx,
 		eventpkg.AUTHORIZATION_LOGIN,
 		eventpkg.AuthorizationLoginMessage{
-			ID: user.ID.String(),
+			ID:        user.ID.String(),
+			SessionID: session.ID.String(),
 		},
 	)
 	if err != nil {
@@ -110,18 +207,6 @@ func (s *AuthorizationServer) Login(ctx context.Context, req *protopkg.LoginRequ
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
 
-	accessToken, err := s.jwt.GenerateAccessToken(session.ID.String())
-	if err != nil {
-		s.log.Error("internal error", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "internal error")
-	}
-
-	refreshToken, err := s.jwt.GenerateRefreshToken(session.ID.String())
-	if err != nil {
-		s.log.Error("internal error", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "internal error")
-	}
-
 	return &protopkg.LoginResponse{
 			User: &protopkg.User{
 				Id:          user.ID.String(),
@@ -136,3 +221,124 @@ func (s *AuthorizationServer) Login(ctx context.Context, req *protopkg.LoginRequ
 		},
 		nil
 }
+
+func (s *AuthorizationServer) isLoginThrottled(email string, ipAddress string) (bool, error) {
+	now := time.Now()
+	keys := map[string]string{
+		securitypkg.LOGIN_THROTTLE_ACCOUNT: email,
+		securitypkg.LOGIN_THROTTLE_IP:      ipAddress,
+	}
+
+	for kind, key := range keys {
+		throttle, err := s.database.SelectAuthThrottle(kind, key)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return false, err
+		}
+
+		if throttle.IsLocked(now) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// recordLoginFailure counts a failed attempt against the account and the ip
+// address, applies backoff and, whenever the lockout threshold is reached
+// with no lockout active, records the lockout and sends the account owner an
+// unlock email. A failure after a lockout ended in the same window locks
+// again.
+func (s *AuthorizationServer) recordLoginFailure(ctx context.Context, user *ormpkg.User, email string, ipAddress string) error {
+	now := time.Now()
+	policies := []struct {
+		policy securitypkg.LoginThrottlePolicy
+		key    string
+	}{
+		{securitypkg.AccountLoginThrottlePolicy, email},
+		{securitypkg.IPLoginThrottlePolicy, ipAddress},
+	}
+
+	for _, p := range policies {
+		throttle, delay, err := s.incrementThrottle(p.policy, p.key)
+		if err != nil {
+			return err
+		}
+
+		if !p.policy.IsLockout(throttle.Count) {
+			continue
+		}
+
+		// Failures racing past the threshold together record one lockout
+		// and send one email
+		lockout := ormpkg.LoginLockout{
+			Kind:         p.policy.Kind,
+			Email:        email,
+			IpAddress:    ipAddress,
+			Key:          p.key,
+			FailureCount: throttle.Count,
+			LockedUntil:  now.Add(delay),
+		}
+		if user != nil {
+			lockout.UserID = &user.ID
+		}
+		recorded, err := s.database.InsertLoginLockoutUnlessActive(&lockout)
+		if err != nil {
+			return err
+		}
+		if !recorded {
+			continue
+		}
+
+		s.log.Warn(
+			"login locked out",
+			zap.String("kind", p.policy.Kind),
+			zap.String("email", email),
+			zap.String("ip_address", ipAddress),
+		)
+
+		if p.policy.Kind != securitypkg.LOGIN_THROTTLE_ACCOUNT || user == nil {
+			continue
+		}
+
+		unlockToken, err := issueOneTimeToken(s.database, user.ID, ormpkg.TOKEN_PURPOSE_UNLOCK, securitypkg.UNLOCK_TOKEN_TTL)
+		if err != nil {
+			return err
+		}
+
+		err = s.broker.WriteMessage(
+			ctx,
+			eventpkg.AUTHORIZATION_ACCOUNT_LOCKED,
+			eventpkg.AuthorizationAccountLockedMessage{
+				ID:    user.ID.String(),
+				Token: unlockToken,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// incrementThrottle counts one more hit for the key and applies the policy
+// backoff. It returns the updated counter and the delay now in effect.
+func (s *AuthorizationServer) incrementThrottle(policy securitypkg.LoginThrottlePolicy, key string) (*ormpkg.AuthThrottle, time.Duration, error) {
+	throttle, err := s.database.IncrementAuthThrottle(policy.Kind, key, policy.Window)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	delay := policy.Delay(throttle.Count)
+	if delay > 0 {
+		err = s.database.UpdateAuthThrottleLockedUntil(policy.Kind, key, time.Now().Add(delay))
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return throttle, delay, nil
+}
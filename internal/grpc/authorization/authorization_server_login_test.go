@@ -0,0 +1,132 @@
+package grpcauthorization
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	eventpkg "github.com/stormhead-org/backend/internal/event"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	"github.com/stormhead-org/backend/internal/orm/ormtest"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
+)
+
+// testDatabase runs the server on the memory store. The stores only
+// PostgresClient implements are left nil, the tested RPCs do not use them.
+type testDatabase struct {
+	*ormtest.MemoryStore
+	ormpkg.PlatformSettingStore
+	ormpkg.PersonalAccessTokenStore
+	ormpkg.EmailChangeStore
+}
+
+type testBroker struct {
+	keys []string
+}
+
+func (b *testBroker) WriteMessage(ctx context.Context, key string, value any) error {
+	b.keys = append(b.keys, key)
+	return nil
+}
+
+func (b *testBroker) count(key string) int {
+	count := 0
+	for _, written := range b.keys {
+		if written == key {
+			count++
+		}
+	}
+	return count
+}
+
+func clientContext() context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", "test"))
+	return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 4242}})
+}
+
+// An account locked out once is locked out again, with a new record and a
+// new unlock email, when failures go on after the lockout within the same
+// throttle window.
+func TestLoginSecondLockoutInWindow(t *testing.T) {
+	policy := securitypkg.AccountLoginThrottlePolicy
+	t.Cleanup(func() {
+		securitypkg.AccountLoginThrottlePolicy = policy
+	})
+	securitypkg.AccountLoginThrottlePolicy.BaseDelay = 0
+	securitypkg.AccountLoginThrottlePolicy.LockoutDuration = 100 * time.Millisecond
+
+	store := ormtest.NewMemoryStore()
+	broker := &testBroker{}
+	hasher := securitypkg.NewPasswordHasher("")
+	server := NewAuthorizationServer(zap.NewNop(), nil, nil, nil, hasher, testDatabase{MemoryStore: store}, broker, nil, nil)
+
+	password, err := hasher.Hash("correct password")
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	err = store.InsertUser(&ormpkg.User{
+		Slug:         "alice",
+		SlugSkeleton: "alice",
+		Name:         "alice",
+		Email:        "alice@example.com",
+		Password:     password,
+		IsVerified:   true,
+		IsApproved:   true,
+		LastActivity: time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	login := func() error {
+		_, err := server.Login(clientContext(), &protopkg.LoginRequest{Email: "alice@example.com", Password: "wrong password"})
+		return err
+	}
+	lockouts := func() []*ormpkg.LoginLockout {
+		lockouts, _, err := store.SelectLoginLockoutsWithPagination(10, "")
+		if err != nil {
+			t.Fatalf("failed to select lockouts: %v", err)
+		}
+		return lockouts
+	}
+
+	for i := 0; i < policy.LockoutThreshold; i++ {
+		if code := status.Code(login()); code != codes.Unauthenticated {
+			t.Fatalf("failure %d: got %s, want %s", i+1, code, codes.Unauthenticated)
+		}
+	}
+	if got := len(lockouts()); got != 1 {
+		t.Fatalf("lockouts after the threshold: got %d, want 1", got)
+	}
+	if got := broker.count(eventpkg.AUTHORIZATION_ACCOUNT_LOCKED); got != 1 {
+		t.Fatalf("unlock emails after the threshold: got %d, want 1", got)
+	}
+
+	if code := status.Code(login()); code != codes.ResourceExhausted {
+		t.Fatalf("while locked: got %s, want %s", code, codes.ResourceExhausted)
+	}
+
+	time.Sleep(150 * time.Millisecond)
+
+	if code := status.Code(login()); code != codes.Unauthenticated {
+		t.Fatalf("after the lockout: got %s, want %s", code, codes.Unauthenticated)
+	}
+	second := lockouts()
+	if len(second) != 2 {
+		t.Fatalf("lockouts after the second lockout: got %d, want 2", len(second))
+	}
+	if second[0].FailureCount != policy.LockoutThreshold+1 || second[0].Key != "alice@example.com" {
+		t.Fatalf("second lockout: got %d failures for %q", second[0].FailureCount, second[0].Key)
+	}
+	if got := broker.count(eventpkg.AUTHORIZATION_ACCOUNT_LOCKED); got != 2 {
+		t.Fatalf("unlock emails after the second lockout: got %d, want 2", got)
+	}
+}
@@ -3,10 +3,33 @@ package grpcauthorization
 import (
 	"context"
 
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
 	protopkg "github.com/stormhead-org/backend/internal/proto"
 )
 
 func (s *AuthorizationServer) Logout(ctx context.Context, req *protopkg.LogoutRequest) (*protopkg.LogoutResponse, error) {
-	// Client handles token deletion. Server-side invalidation can be added if needed.
+	sessionID, err := middlewarepkg.GetSessionID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "not authenticated")
+	}
+
+	id, err := uuid.Parse(sessionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid session ID in token")
+	}
+
+	err = s.database.DeleteSession(&ormpkg.Session{ID: id})
+	if err != nil {
+		s.log.Error("failed to delete session on logout", zap.Error(err), zap.String("sessionID", sessionID))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+	s.revokeSessions(ctx, []string{sessionID})
+
 	return &protopkg.LogoutResponse{}, nil
 }
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	protopkg "github.com/stormhead-org/backend/internal/proto"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
 )
 
 func (s *AuthorizationServer) RefreshToken(ctx context.Context, req *protopkg.RefreshTokenRequest) (*protopkg.RefreshTokenResponse, error) {
@@ -20,6 +21,24 @@ func (s *AuthorizationServer) RefreshToken(ctx context.Context, req *protopkg.Re
 		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token")
 	}
 
+	session, err := s.database.SelectSessionByID(sessionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token")
+	}
+
+	// Every refresh token can be exchanged once. Presenting an already
+	// rotated one means it leaked, so the whole session is revoked. Sessions
+	// created before rotation have no hash yet and accept their token once.
+	if session.RefreshTokenHash != "" && !securitypkg.VerifyTokenHash(req.RefreshToken, session.RefreshTokenHash) {
+		s.log.Warn("refresh token reuse detected", zap.String("session_id", sessionID))
+		err = s.database.DeleteSession(session)
+		if err != nil {
+			s.log.Error("failed to delete session after refresh token reuse", zap.Error(err))
+		}
+		s.revokeSessions(ctx, []string{sessionID})
+		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token")
+	}
+
 	newAccessToken, err := s.jwt.GenerateAccessToken(sessionID)
 	if err != nil {
 		s.log.Error("failed to generate new access token", zap.Error(err))
@@ -32,6 +51,16 @@ func (s *AuthorizationServer) RefreshToken(ctx context.Context, req *protopkg.Re
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
 
+	rotated, err := s.database.RotateSessionRefreshToken(sessionID, session.RefreshTokenHash, securitypkg.HashToken(newRefreshToken))
+	if err != nil {
+		s.log.Error("failed to rotate refresh token", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+	if !rotated {
+		// Lost a race with a concurrent refresh of the same token
+		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token")
+	}
+
 	return &protopkg.RefreshTokenResponse{
 			AccessToken:  newAccessToken,
 			RefreshToken: newRefreshToken,
@@ -2,6 +2,8 @@ package grpcauthorization
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
@@ -10,6 +12,7 @@ import (
 	"gorm.io/gorm"
 
 	eventpkg "github.com/stormhead-org/backend/internal/event"
+	"github.com/stormhead-org/backend/internal/lib"
 	ormpkg "github.com/stormhead-org/backend/internal/orm"
 	protopkg "github.com/stormhead-org/backend/internal/proto"
 	securitypkg "github.com/stormhead-org/backend/internal/security"
@@ -23,6 +26,58 @@ func (s *AuthorizationServer) Register(ctx context.Context, req *protopkg.Regist
 		return nil, status.Errorf(codes.InvalidArgument, "password is required")
 	}
 
+	// Validate slug and display name
+	slug := lib.NormalizeUserSlug(req.Slug)
+	err := lib.ValidateUserSlug(slug)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	name := lib.NormalizeUserName(req.Name)
+	err = lib.ValidateUserName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	// Normalize email and apply the domain policy
+	email, err := s.checkEmail(req.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	// Enforce the registration mode. The very first user always gets in to
+	// become the platform owner
+	settings, err := s.database.SelectPlatformSetting()
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	userCount, err := s.database.CountUsers()
+	if err != nil {
+		s.log.Error("failed to count users", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	isFirstUser := userCount == 0
+	inviteCode := strings.TrimSpace(req.InviteCode)
+	if !isFirstUser {
+		switch settings.RegistrationMode {
+		case ormpkg.REGISTRATION_MODE_CLOSED:
+			return nil, status.Errorf(codes.PermissionDenied, "registration is closed")
+		case ormpkg.REGISTRATION_MODE_INVITE_ONLY:
+			if inviteCode == "" {
+				return nil, status.Errorf(codes.PermissionDenied, "an invite code is required")
+			}
+		}
+	}
+
+	// Invite codes are spent only where they matter: they are required in
+	// invite_only mode and skip the approval queue in approval mode
+	useInviteCode := !isFirstUser && inviteCode != "" &&
+		(settings.RegistrationMode == ormpkg.REGISTRATION_MODE_INVITE_ONLY ||
+			settings.RegistrationMode == ormpkg.REGISTRATION_MODE_APPROVAL)
+
 	// Validate password complexity
 	if len(req.Password) < 12 {
 		return nil, status.Errorf(codes.InvalidArgument, "password must be at least 12 characters long")
@@ -32,110 +87,78 @@ func (s *AuthorizationServer) Register(ctx context.Context, req *protopkg.Regist
 	isPwned, err := s.hibp.IsPasswordPwned(req.Password)
 	if err != nil {
 		s.log.Error("failed to check password against HIBP", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "failed to validate password")
+		return nil, status.Errorf(codes.Unavailable, "failed to validate password, try again later")
 	}
 	if isPwned {
 		return nil, status.Errorf(codes.InvalidArgument, "password has been pwned, please choose a different one")
 	}
 
-	// Validate slug (assuming email is used as slug for now)
-	_, err = s.database.SelectUserBySlug(
-		req.Email,
-	)
-	if err != gorm.ErrRecordNotFound {
-		return nil, status.Errorf(codes.InvalidArgument, "slug already exist")
-	}
-
-	// Validate name (assuming email is used as name for now)
-	_, err = s.database.SelectUserByName(
-		req.Email,
-	)
-	if err != gorm.ErrRecordNotFound {
-		return nil, status.Errorf(codes.InvalidArgument, "name already exist")
-	}
-
 	// Validate email
 	_, err = s.database.SelectUserByEmail(
-		req.Email,
+		email,
 	)
 	if err != gorm.ErrRecordNotFound {
 		return nil, status.Errorf(codes.InvalidArgument, "email already exist")
 	}
 
-	// Salt password
-	salt := securitypkg.GenerateSalt()
-
-	hash, err := securitypkg.HashPassword(
-		req.Password,
-		salt,
-	)
+	// Hash password
+	hash, err := s.hasher.Hash(req.Password)
 	if err != nil {
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
 
-	// Check if this is the first user
-	userCount, err := s.database.CountUsers()
-	if err != nil {
-		s.log.Error("failed to count users", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "internal error")
-	}
-
-	isFirstUser := userCount == 0
-
 	// Create user
-	user := &ormpkg.User{
-		Slug:              req.Email, // Assuming email is used as slug for now, will be updated later
-		Name:              req.Email, // Assuming email is used as name for now, will be updated later
-		Email:             req.Email,
-		Password:          hash,
-		Salt:              salt,
-		VerificationToken: securitypkg.GenerateToken(),
-		IsVerified:        false,
-		Reputation:        0,
-		LastActivity:      time.Now(),
-	}
-	err = s.database.InsertUser(user)
-	if err != nil {
-		s.log.Error("internal error", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "internal error")
+	newUser := ormpkg.User{
+		Slug:         slug,
+		SlugSkeleton: lib.UserSlugSkeleton(slug),
+		Name:         name,
+		Email:        email,
+		Password:     hash,
+		IsVerified:   false,
+		IsApproved:   isFirstUser || settings.RegistrationMode != ormpkg.REGISTRATION_MODE_APPROVAL || useInviteCode,
+		Reputation:   0,
+		LastActivity: time.Now(),
 	}
-
-	if isFirstUser {
-		if err := s.database.UpdatePlatformOwner(user.ID); err != nil {
-			s.log.Error("failed to set platform owner", zap.Error(err))
-			return nil, status.Errorf(codes.Internal, "internal error")
+	// The account, its roles and the verification token are created together.
+	// Inserting the user checks the slug is not taken by an existing or
+	// former slug.
+	var user *ormpkg.User
+	var verificationToken string
+	err = s.database.WithTx(ctx, func(tx ormpkg.Store) error {
+		// A retried attempt starts from the unsaved user
+		attempt := newUser
+		user = &attempt
+
+		var err error
+		if useInviteCode {
+			err = tx.InsertUserWithInviteCode(user, securitypkg.HashToken(inviteCode))
+		} else {
+			err = tx.InsertUser(user)
+		}
+		if err != nil {
+			return err
 		}
 
-		// Assign "platform owner" role to the first user
-		ownerRole, err := s.database.SelectRoleByName("platform owner", nil) // nil for community_id for platform role
-		if err == gorm.ErrRecordNotFound {
-			// If "platform owner" role doesn't exist, create it (this should ideally be seeded)
-			ownerRole = &ormpkg.Role{
-				Name:        "platform owner",
-				Color:       "#FFD700", // Gold color
-				Type:        "platform",
-				Permissions: []byte(`{"can_manage_platform": true}`), // Example permission
-			}
-			err = s.database.InsertRole(ownerRole)
+		if isFirstUser {
+			err = assignPlatformOwner(tx, user)
 			if err != nil {
-				s.log.Error("failed to create platform owner role", zap.Error(err))
-				return nil, status.Errorf(codes.Internal, "internal error")
+				return err
 			}
-		} else if err != nil {
-			s.log.Error("failed to select platform owner role", zap.Error(err))
-			return nil, status.Errorf(codes.Internal, "internal error")
 		}
 
-		userRole := &ormpkg.UserRole{
-			UserID: user.ID,
-			RoleID: ownerRole.ID,
-		}
-		err = s.database.InsertUserRole(userRole)
-		if err != nil {
-			s.log.Error("failed to assign platform owner role to first user", zap.Error(err))
-			return nil, status.Errorf(codes.Internal, "internal error")
-		}
+		verificationToken, err = issueOneTimeToken(tx, user.ID, ormpkg.TOKEN_PURPOSE_VERIFICATION, securitypkg.VERIFICATION_TOKEN_TTL)
+		return err
+	})
+	if errors.Is(err, ormpkg.ErrUserSlugTaken) {
+		return nil, status.Errorf(codes.InvalidArgument, "slug already exist")
+	}
+	if errors.Is(err, ormpkg.ErrInviteCodeInvalid) {
+		return nil, status.Errorf(codes.InvalidArgument, "invite code is invalid or expired")
+	}
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
 	}
 
 	// Write message to broker
@@ -143,7 +166,8 @@ func (s *AuthorizationServer) Register(ctx context.Context, req *protopkg.Regist
 		ctx,
 		eventpkg.AUTHORIZATION_REGISTER,
 		eventpkg.AuthorizationRegisterMessage{
-			ID: user.ID.String(),
+			ID:                user.ID.String(),
+			VerificationToken: verificationToken,
 		},
 	)
 	if err != nil {
@@ -152,7 +176,37 @@ func (s *AuthorizationServer) Register(ctx context.Context, req *protopkg.Regist
 	}
 
 	return &protopkg.RegisterResponse{
-			UserId: user.ID.String(),
+			UserId:          user.ID.String(),
+			PendingApproval: !user.IsApproved,
 		},
 		nil
 }
+
+// assignPlatformOwner makes the first registered user the platform owner.
+func assignPlatformOwner(tx ormpkg.RoleStore, user *ormpkg.User) error {
+	err := tx.UpdatePlatformOwner(user.ID)
+	if err != nil {
+		return err
+	}
+
+	// Assign "platform owner" role to the first user
+	ownerRole, err := tx.SelectRoleByName("platform owner", nil) // nil for community_id for platform role
+	if err == gorm.ErrRecordNotFound {
+		// If "platform owner" role doesn't exist, create it (this should ideally be seeded)
+		ownerRole = &ormpkg.Role{
+			Name:        "platform owner",
+			Color:       "#FFD700", // Gold color
+			Type:        "platform",
+			Permissions: []byte(`{"can_manage_platform": true}`), // Example permission
+		}
+		err = tx.InsertRole(ownerRole)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.InsertUserRole(&ormpkg.UserRole{
+		UserID: user.ID,
+		RoleID: ownerRole.ID,
+	})
+}
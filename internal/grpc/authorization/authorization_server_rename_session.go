@@ -0,0 +1,60 @@
+package grpcauthorization
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+const SESSION_NAME_MAX_LENGTH = 64
+
+func (s *AuthorizationServer) RenameSession(ctx context.Context, req *protopkg.RenameSessionRequest) (*protopkg.RenameSessionResponse, error) {
+	userID, err := middlewarepkg.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "not authenticated")
+	}
+
+	sessionID, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session ID format")
+	}
+
+	name := lib.NormalizeUserName(req.Name)
+	if utf8.RuneCountInString(name) > SESSION_NAME_MAX_LENGTH {
+		return nil, status.Errorf(codes.InvalidArgument, "session name must be at most %d characters", SESSION_NAME_MAX_LENGTH)
+	}
+	if strings.IndexFunc(name, func(r rune) bool { return !unicode.IsPrint(r) }) >= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "session name contains unsupported characters")
+	}
+
+	err = s.database.UpdateSessionName(sessionID.String(), userID, name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "session not found")
+	}
+	if err != nil {
+		s.log.Error("failed to rename session", zap.Error(err), zap.String("sessionID", sessionID.String()))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	session, err := s.database.SelectSessionByID(sessionID.String())
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	return &protopkg.RenameSessionResponse{
+		Session: sessionToProto(session),
+	}, nil
+}
@@ -0,0 +1,97 @@
+package grpcauthorization
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	eventpkg "github.com/stormhead-org/backend/internal/event"
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+// ACCOUNT_DELETION_GRACE_PERIOD is how long a deletion request can still be
+// cancelled by logging in.
+const ACCOUNT_DELETION_GRACE_PERIOD = 14 * 24 * time.Hour
+
+func (s *AuthorizationServer) RequestAccountDeletion(ctx context.Context, req *protopkg.RequestAccountDeletionRequest) (*protopkg.RequestAccountDeletionResponse, error) {
+	userID, err := middlewarepkg.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "not authenticated")
+	}
+
+	if req.Password == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "password is required")
+	}
+
+	user, err := s.database.SelectUserByID(userID)
+	if err != nil {
+		s.log.Error("failed to retrieve user for account deletion", zap.Error(err), zap.String("userID", userID))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	// Re-authenticate, a stolen session alone must not delete the account
+	_, err = s.hasher.Verify(
+		user.Password,
+		req.Password,
+		user.Salt,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "password invalid")
+	}
+
+	settings, err := s.database.SelectPlatformSetting()
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+	if settings.PlatformOwnerID != nil && *settings.PlatformOwnerID == user.ID {
+		return nil, status.Errorf(codes.FailedPrecondition, "platform owner must transfer ownership before deleting the account")
+	}
+
+	// Repeated requests keep the original schedule
+	if user.DeletionScheduledAt != nil {
+		return &protopkg.RequestAccountDeletionResponse{
+			ScheduledAt: timestamppb.New(*user.DeletionScheduledAt),
+		}, nil
+	}
+
+	// Sign out everywhere, logging in again is how the deletion is cancelled
+	scheduledAt := time.Now().Add(ACCOUNT_DELETION_GRACE_PERIOD)
+	var sessionIDs []string
+	err = s.database.WithTx(ctx, func(tx ormpkg.Store) error {
+		err := tx.ScheduleUserDeletion(userID, scheduledAt)
+		if err != nil {
+			return err
+		}
+
+		sessionIDs, err = tx.DeleteSessionsByUserID(userID)
+		return err
+	})
+	if err != nil {
+		s.log.Error("failed to schedule account deletion", zap.Error(err), zap.String("userID", userID))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+	s.revokeSessions(ctx, sessionIDs)
+
+	err = s.broker.WriteMessage(
+		ctx,
+		eventpkg.AUTHORIZATION_REQUEST_ACCOUNT_DELETION,
+		eventpkg.AuthorizationRequestAccountDeletionMessage{
+			ID: userID,
+		},
+	)
+	if err != nil {
+		s.log.Error("failed to write account deletion event to broker", zap.Error(err), zap.String("userID", userID))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	return &protopkg.RequestAccountDeletionResponse{
+		ScheduledAt: timestamppb.New(scheduledAt),
+	}, nil
+}
@@ -0,0 +1,75 @@
+package grpcauthorization
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	eventpkg "github.com/stormhead-org/backend/internal/event"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
+)
+
+func (s *AuthorizationServer) RequestMagicLink(ctx context.Context, req *protopkg.RequestMagicLinkRequest) (*protopkg.RequestMagicLinkResponse, error) {
+	if req.Email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email is required")
+	}
+
+	email := lookupEmail(req.Email)
+
+	// Rate limit per email address, whether or not the account exists
+	throttle, err := s.database.SelectAuthThrottle(securitypkg.MAGIC_LINK_THROTTLE_EMAIL, email)
+	if err == nil && throttle.IsLocked(time.Now()) {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many magic link requests, try again later")
+	}
+
+	_, _, err = s.incrementThrottle(securitypkg.MagicLinkThrottlePolicy, email)
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	// Get user from database
+	user, err := s.database.SelectUserByEmail(
+		email,
+	)
+	if err != nil {
+		// Always return success to prevent enumeration attacks
+		s.log.Warn("magic link requested for non-existent user", zap.String("email", req.Email))
+		return &protopkg.RequestMagicLinkResponse{}, nil
+	}
+	if !user.IsVerified {
+		s.log.Warn("magic link requested for unverified user", zap.String("email", req.Email), zap.String("userID", user.ID.String()))
+		return &protopkg.RequestMagicLinkResponse{}, nil
+	}
+	if !user.IsApproved {
+		s.log.Warn("magic link requested for user awaiting approval", zap.String("email", req.Email), zap.String("userID", user.ID.String()))
+		return &protopkg.RequestMagicLinkResponse{}, nil
+	}
+
+	magicLinkToken, err := issueOneTimeToken(s.database, user.ID, ormpkg.TOKEN_PURPOSE_MAGIC_LINK, securitypkg.MAGIC_LINK_TOKEN_TTL)
+	if err != nil {
+		s.log.Error("failed to update user with magic link token", zap.Error(err), zap.String("userID", user.ID.String()))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	// Write message to broker for email sending
+	err = s.broker.WriteMessage(
+		ctx,
+		eventpkg.AUTHORIZATION_REQUEST_MAGIC_LINK,
+		eventpkg.AuthorizationRequestMagicLinkMessage{
+			ID:    user.ID.String(),
+			Token: magicLinkToken,
+		},
+	)
+	if err != nil {
+		s.log.Error("failed to write magic link event to broker", zap.Error(err), zap.String("userID", user.ID.String()))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	return &protopkg.RequestMagicLinkResponse{}, nil
+}
@@ -2,13 +2,13 @@ package grpcauthorization
 
 import (
 	"context"
-	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	eventpkg "github.com/stormhead-org/backend/internal/event"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
 	protopkg "github.com/stormhead-org/backend/internal/proto"
 	securitypkg "github.com/stormhead-org/backend/internal/security"
 )
@@ -20,7 +20,7 @@ func (s *AuthorizationServer) RequestPasswordReset(ctx context.Context, req *pro
 
 	// Get user from database
 	user, err := s.database.SelectUserByEmail(
-		req.Email,
+		lookupEmail(req.Email),
 	)
 	if err != nil {
 		// Always return success to prevent enumeration attacks, as specified in T043.
@@ -35,12 +35,8 @@ func (s *AuthorizationServer) RequestPasswordReset(ctx context.Context, req *pro
 		return &protopkg.RequestPasswordResetResponse{}, nil
 	}
 
-	// Update user with reset token
-	user.ResetToken = securitypkg.GenerateToken()
-	expiresAt := time.Now().Add(time.Hour) // Token valid for 1 hour
-	user.ResetTokenExpiresAt = &expiresAt
-
-	err = s.database.UpdateUser(user)
+	// Issue reset token
+	resetToken, err := issueOneTimeToken(s.database, user.ID, ormpkg.TOKEN_PURPOSE_PASSWORD_RESET, securitypkg.PASSWORD_RESET_TOKEN_TTL)
 	if err != nil {
 		s.log.Error("failed to update user with reset token", zap.Error(err), zap.String("userID", user.ID.String()))
 		return nil, status.Errorf(codes.Internal, "internal error")
@@ -51,7 +47,8 @@ func (s *AuthorizationServer) RequestPasswordReset(ctx context.Context, req *pro
 		ctx,
 		eventpkg.AUTHORIZATION_REQUEST_PASSWORD_RESET,
 		eventpkg.AuthorizationRequestPasswordReset{
-			ID: user.ID.String(),
+			ID:    user.ID.String(),
+			Token: resetToken,
 		},
 	)
 	if err != nil {
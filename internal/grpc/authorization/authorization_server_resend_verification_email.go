@@ -0,0 +1,72 @@
+package grpcauthorization
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	eventpkg "github.com/stormhead-org/backend/internal/event"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
+)
+
+func (s *AuthorizationServer) ResendVerificationEmail(ctx context.Context, req *protopkg.ResendVerificationEmailRequest) (*protopkg.ResendVerificationEmailResponse, error) {
+	if req.Email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email is required")
+	}
+
+	email := lookupEmail(req.Email)
+
+	// Rate limit per email address, whether or not the account exists
+	throttle, err := s.database.SelectAuthThrottle(securitypkg.VERIFICATION_THROTTLE_EMAIL, email)
+	if err == nil && throttle.IsLocked(time.Now()) {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many verification emails requested, try again later")
+	}
+
+	_, _, err = s.incrementThrottle(securitypkg.VerificationThrottlePolicy, email)
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	// Get user from database
+	user, err := s.database.SelectUserByEmail(
+		email,
+	)
+	if err != nil {
+		// Always return success to prevent enumeration attacks
+		s.log.Warn("verification email requested for non-existent user", zap.String("email", req.Email))
+		return &protopkg.ResendVerificationEmailResponse{}, nil
+	}
+	if user.IsVerified {
+		s.log.Warn("verification email requested for verified user", zap.String("email", req.Email), zap.String("userID", user.ID.String()))
+		return &protopkg.ResendVerificationEmailResponse{}, nil
+	}
+
+	// Replace the token, so links from earlier emails stop working
+	verificationToken, err := issueOneTimeToken(s.database, user.ID, ormpkg.TOKEN_PURPOSE_VERIFICATION, securitypkg.VERIFICATION_TOKEN_TTL)
+	if err != nil {
+		s.log.Error("failed to update user with verification token", zap.Error(err), zap.String("userID", user.ID.String()))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	// Write message to broker for email sending
+	err = s.broker.WriteMessage(
+		ctx,
+		eventpkg.AUTHORIZATION_REQUEST_VERIFICATION,
+		eventpkg.AuthorizationRequestVerificationMessage{
+			ID:                user.ID.String(),
+			VerificationToken: verificationToken,
+		},
+	)
+	if err != nil {
+		s.log.Error("failed to write verification event to broker", zap.Error(err), zap.String("userID", user.ID.String()))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	return &protopkg.ResendVerificationEmailResponse{}, nil
+}
@@ -0,0 +1,44 @@
+package grpcauthorization
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
+)
+
+func (s *AuthorizationServer) RevertEmailChange(ctx context.Context, req *protopkg.RevertEmailChangeRequest) (*protopkg.RevertEmailChangeResponse, error) {
+	if req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email revert token is required")
+	}
+
+	change, err := s.database.RevertEmailChange(securitypkg.HashToken(req.Token))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid or expired token")
+	}
+	if errors.Is(err, ormpkg.ErrEmailTaken) {
+		return nil, status.Errorf(codes.FailedPrecondition, "email already exist")
+	}
+	if err != nil {
+		s.log.Error("failed to revert email change", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	// The change was likely not requested by the owner, sign out everywhere
+	sessionIDs, err := s.database.DeleteSessionsByUserID(change.UserID.String())
+	if err != nil {
+		s.log.Error("failed to delete user sessions after email revert", zap.Error(err), zap.String("userID", change.UserID.String()))
+	}
+	s.revokeSessions(ctx, sessionIDs)
+
+	return &protopkg.RevertEmailChangeResponse{
+		Email: change.OldEmail,
+	}, nil
+}
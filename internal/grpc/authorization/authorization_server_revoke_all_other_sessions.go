@@ -0,0 +1,35 @@
+package grpcauthorization
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+func (s *AuthorizationServer) RevokeAllOtherSessions(ctx context.Context, req *protopkg.RevokeAllOtherSessionsRequest) (*protopkg.RevokeAllOtherSessionsResponse, error) {
+	userID, err := middlewarepkg.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "not authenticated")
+	}
+
+	sessionID, err := middlewarepkg.GetSessionID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "not authenticated")
+	}
+
+	sessionIDs, err := s.database.DeleteOtherSessionsByUserID(userID, sessionID)
+	if err != nil {
+		s.log.Error("failed to delete other sessions", zap.Error(err), zap.String("userID", userID))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+	s.revokeSessions(ctx, sessionIDs)
+
+	return &protopkg.RevokeAllOtherSessionsResponse{
+		RevokedCount: int32(len(sessionIDs)),
+	}, nil
+}
@@ -0,0 +1,45 @@
+package grpcauthorization
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+func (s *AuthorizationServer) RevokePersonalAccessToken(ctx context.Context, req *protopkg.RevokePersonalAccessTokenRequest) (*protopkg.RevokePersonalAccessTokenResponse, error) {
+	userID, err := middlewarepkg.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "not authenticated")
+	}
+
+	_, err = uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token ID format")
+	}
+
+	token, err := s.database.SelectPersonalAccessTokenByID(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "token not found")
+	}
+
+	if token.UserID.String() != userID {
+		s.log.Warn("attempt to revoke personal access token belonging to another user",
+			zap.String("currentUserID", userID),
+			zap.String("tokenID", req.Id))
+		return nil, status.Errorf(codes.NotFound, "token not found")
+	}
+
+	err = s.database.RevokePersonalAccessToken(req.Id)
+	if err != nil {
+		s.log.Error("failed to revoke personal access token", zap.Error(err), zap.String("tokenID", req.Id))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	return &protopkg.RevokePersonalAccessTokenResponse{}, nil
+}
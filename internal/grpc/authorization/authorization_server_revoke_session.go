@@ -62,6 +62,7 @@ func (s *AuthorizationServer) RevokeSession(ctx context.Context, req *protopkg.R
 		s.log.Error("failed to delete session", zap.Error(err), zap.String("sessionID", sessionIDToRevoke.String()))
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
+	s.revokeSessions(ctx, []string{requestedSession.ID.String()})
 
 	return &protopkg.RevokeSessionResponse{}, nil
 }
@@ -0,0 +1,45 @@
+package grpcauthorization
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
+)
+
+func (s *AuthorizationServer) UnlockAccount(ctx context.Context, req *protopkg.UnlockAccountRequest) (*protopkg.UnlockAccountResponse, error) {
+	if req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "unlock token is required")
+	}
+
+	err := s.database.WithTx(ctx, func(tx ormpkg.Store) error {
+		token, err := tx.ConsumeOneTimeToken(ormpkg.TOKEN_PURPOSE_UNLOCK, securitypkg.HashToken(req.Token))
+		if err != nil {
+			return err
+		}
+
+		user, err := tx.SelectUserByID(token.UserID.String())
+		if err != nil {
+			return err
+		}
+
+		return tx.DeleteAuthThrottle(securitypkg.LOGIN_THROTTLE_ACCOUNT, strings.ToLower(user.Email))
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid or expired token")
+	}
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	return &protopkg.UnlockAccountResponse{}, nil
+}
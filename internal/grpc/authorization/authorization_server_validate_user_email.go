@@ -0,0 +1,19 @@
+package grpcauthorization
+
+import (
+	"context"
+
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+// ValidateUserEmail checks the format and the domain policy only. Unlike
+// ValidateUserSlug it does not tell whether the address is registered, so
+// it cannot be used to enumerate accounts.
+func (s *AuthorizationServer) ValidateUserEmail(ctx context.Context, req *protopkg.ValidateUserEmailRequest) (*protopkg.ValidateUserEmailResponse, error) {
+	_, err := s.checkEmail(req.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopkg.ValidateUserEmailResponse{}, nil
+}
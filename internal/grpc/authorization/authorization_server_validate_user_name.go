@@ -0,0 +1,20 @@
+package grpcauthorization
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+func (s *AuthorizationServer) ValidateUserName(ctx context.Context, req *protopkg.ValidateUserNameRequest) (*protopkg.ValidateUserNameResponse, error) {
+	err := lib.ValidateUserName(lib.NormalizeUserName(req.Name))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	return &protopkg.ValidateUserNameResponse{}, nil
+}
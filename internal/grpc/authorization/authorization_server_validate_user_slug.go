@@ -0,0 +1,31 @@
+package grpcauthorization
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+func (s *AuthorizationServer) ValidateUserSlug(ctx context.Context, req *protopkg.ValidateUserSlugRequest) (*protopkg.ValidateUserSlugResponse, error) {
+	slug := lib.NormalizeUserSlug(req.Slug)
+	err := lib.ValidateUserSlug(slug)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	taken, err := s.database.SelectUserSlugTaken(lib.UserSlugSkeleton(slug), "")
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+	if taken {
+		return nil, status.Errorf(codes.AlreadyExists, "slug already exist")
+	}
+
+	return &protopkg.ValidateUserSlugResponse{}, nil
+}
@@ -2,12 +2,16 @@ package grpcauthorization
 
 import (
 	"context"
+	"errors"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
 	protopkg "github.com/stormhead-org/backend/internal/proto"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
 )
 
 func (s *AuthorizationServer) VerifyEmail(ctx context.Context, req *protopkg.VerifyEmailRequest) (*protopkg.VerifyEmailResponse, error) {
@@ -15,13 +19,18 @@ func (s *AuthorizationServer) VerifyEmail(ctx context.Context, req *protopkg.Ver
 		return nil, status.Errorf(codes.InvalidArgument, "verification token is required")
 	}
 
-	user, err := s.database.SelectUserByVerificationToken(req.Token)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "user not exist")
-	}
+	err := s.database.WithTx(ctx, func(tx ormpkg.Store) error {
+		token, err := tx.ConsumeOneTimeToken(ormpkg.TOKEN_PURPOSE_VERIFICATION, securitypkg.HashToken(req.Token))
+		if err != nil {
+			return err
+		}
 
-	user.IsVerified = true
-	if err := s.database.UpdateUser(user); err != nil {
+		return tx.UpdateUserVerified(token.UserID.String())
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid or expired token")
+	}
+	if err != nil {
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
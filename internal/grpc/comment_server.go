@@ -16,14 +16,24 @@ import (
 	protopkg "github.com/stormhead-org/backend/internal/proto"
 )
 
+const PERMISSION_DELETE_ANY_COMMENT = "delete_any_comment"
+
+// CommentDatabase is the part of the store CommentServer uses.
+type CommentDatabase interface {
+	ormpkg.CommentStore
+	ormpkg.PostStore
+	ormpkg.LikeStore
+	ormpkg.RoleStore
+}
+
 type CommentServer struct {
 	protopkg.UnimplementedCommentServiceServer
 	log      *zap.Logger
-	database *ormpkg.PostgresClient
+	database CommentDatabase
 	broker   *eventpkg.KafkaClient
 }
 
-func NewCommentServer(log *zap.Logger, database *ormpkg.PostgresClient, broker *eventpkg.KafkaClient) *CommentServer {
+func NewCommentServer(log *zap.Logger, database CommentDatabase, broker *eventpkg.KafkaClient) *CommentServer {
 	return &CommentServer{
 		log:      log,
 		database: database,
@@ -171,11 +181,18 @@ func (s *CommentServer) Delete(ctx context.Context, request *protopkg.DeleteComm
 	}
 
 	if comment.AuthorID != userID {
-		s.log.Error("wrong comment ownership")
-		return nil, status.Errorf(codes.PermissionDenied, "not an owner")
+		allowed, err := s.database.SelectUserHasPlatformPermission(userID.String(), PERMISSION_DELETE_ANY_COMMENT)
+		if err != nil {
+			s.log.Error("internal error", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "")
+		}
+		if !allowed {
+			s.log.Error("wrong comment ownership")
+			return nil, status.Errorf(codes.PermissionDenied, "not an owner")
+		}
 	}
 
-	err = s.database.DeleteComment(comment)
+	err = s.database.DeleteComment(comment, userID, request.Reason)
 	if err != nil {
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "")
@@ -184,10 +201,13 @@ func (s *CommentServer) Delete(ctx context.Context, request *protopkg.DeleteComm
 	return &protopkg.DeleteCommentResponse{}, nil
 }
 
-func (s *CommentServer) Like(ctx context.Context, request *protopkg.LikeCommentRequest) (*protopkg.LikeCommentResponse, error) {
-	comment, err := s.database.SelectCommentByID(request.CommentId)
+// Restore undoes a deletion within orm.DELETED_CONTENT_RETENTION. Authors
+// can undo their own deletions, a moderator's only another moderator. The
+// post must not be deleted itself.
+func (s *CommentServer) Restore(ctx context.Context, request *protopkg.RestoreCommentRequest) (*protopkg.RestoreCommentResponse, error) {
+	comment, err := s.database.SelectDeletedCommentByID(request.CommentId)
 	if err == gorm.ErrRecordNotFound {
-		s.log.Debug("comment not found", zap.String("comment_id", request.CommentId))
+		s.log.Debug("deleted comment not found", zap.String("comment_id", request.CommentId))
 		return nil, status.Errorf(codes.NotFound, "")
 	}
 	if err != nil {
@@ -201,45 +221,39 @@ func (s *CommentServer) Like(ctx context.Context, request *protopkg.LikeCommentR
 		return nil, status.Errorf(codes.Internal, "")
 	}
 
-	_, err = s.database.SelectCommentLikeByID(
-		comment.ID.String(),
-		userID.String(),
-	)
-	if err == nil {
-		s.log.Debug(
-			"comment already liked",
-			zap.String("comment_id", comment.ID.String()),
-			zap.String("user_id", userID.String()),
-		)
-		return nil, status.Errorf(codes.InvalidArgument, "already liked")
-	} else if err != gorm.ErrRecordNotFound {
-		s.log.Error("internal error", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "")
+	if comment.AuthorID != userID || comment.DeletedBy == nil || *comment.DeletedBy != userID {
+		allowed, err := s.database.SelectUserHasPlatformPermission(userID.String(), PERMISSION_DELETE_ANY_COMMENT)
+		if err != nil {
+			s.log.Error("internal error", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "")
+		}
+		if !allowed {
+			return nil, status.Errorf(codes.PermissionDenied, "not allowed to restore")
+		}
 	}
 
-	commentLike := &ormpkg.CommentLike{
-		CommentID: comment.ID,
-		UserID:    userID,
+	_, err = s.database.SelectPostByID(comment.PostID.String())
+	if err == gorm.ErrRecordNotFound {
+		return nil, status.Errorf(codes.FailedPrecondition, "post is deleted")
 	}
-
-	err = s.database.InsertCommentLike(commentLike)
 	if err != nil {
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "")
 	}
 
-	comment.LikeCount += 1
-
-	err = s.database.UpdateComment(comment)
+	err = s.database.RestoreComment(comment)
+	if err == gorm.ErrRecordNotFound {
+		return nil, status.Errorf(codes.FailedPrecondition, "retention period is over")
+	}
 	if err != nil {
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "")
 	}
 
-	return &protopkg.LikeCommentResponse{}, nil
+	return &protopkg.RestoreCommentResponse{}, nil
 }
 
-func (s *CommentServer) Unlike(ctx context.Context, request *protopkg.UnlikeCommentRequest) (*protopkg.UnlikeCommentResponse, error) {
+func (s *CommentServer) Like(ctx context.Context, request *protopkg.LikeCommentRequest) (*protopkg.LikeCommentResponse, error) {
 	comment, err := s.database.SelectCommentByID(request.CommentId)
 	if err == gorm.ErrRecordNotFound {
 		s.log.Debug("comment not found", zap.String("comment_id", request.CommentId))
@@ -256,36 +270,58 @@ func (s *CommentServer) Unlike(ctx context.Context, request *protopkg.UnlikeComm
 		return nil, status.Errorf(codes.Internal, "")
 	}
 
-	commentLike, err := s.database.SelectCommentLikeByID(
-		comment.ID.String(),
-		userID.String(),
-	)
-	if err == gorm.ErrRecordNotFound {
+	commentLike := &ormpkg.CommentLike{
+		CommentID: comment.ID,
+		UserID:    userID,
+	}
+
+	inserted, err := s.database.InsertCommentLike(commentLike)
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "")
+	}
+	if !inserted {
 		s.log.Debug(
-			"comment not liked",
+			"comment already liked",
 			zap.String("comment_id", comment.ID.String()),
 			zap.String("user_id", userID.String()),
 		)
-		return nil, status.Errorf(codes.InvalidArgument, "not liked")
+		return nil, status.Errorf(codes.InvalidArgument, "already liked")
+	}
+
+	return &protopkg.LikeCommentResponse{}, nil
+}
+
+func (s *CommentServer) Unlike(ctx context.Context, request *protopkg.UnlikeCommentRequest) (*protopkg.UnlikeCommentResponse, error) {
+	comment, err := s.database.SelectCommentByID(request.CommentId)
+	if err == gorm.ErrRecordNotFound {
+		s.log.Debug("comment not found", zap.String("comment_id", request.CommentId))
+		return nil, status.Errorf(codes.NotFound, "")
 	}
 	if err != nil {
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "")
 	}
 
-	err = s.database.DeleteCommentLike(commentLike)
+	userID, err := middlewarepkg.GetUserUUID(ctx)
 	if err != nil {
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "")
 	}
 
-	comment.LikeCount -= 1
-
-	err = s.database.UpdateComment(comment)
+	deleted, err := s.database.DeleteCommentLike(comment.ID, userID)
 	if err != nil {
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "")
 	}
+	if !deleted {
+		s.log.Debug(
+			"comment not liked",
+			zap.String("comment_id", comment.ID.String()),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, status.Errorf(codes.InvalidArgument, "not liked")
+	}
 
 	return &protopkg.UnlikeCommentResponse{}, nil
 }
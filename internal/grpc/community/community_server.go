@@ -7,16 +7,27 @@ import (
 	protopkg "github.com/stormhead-org/backend/internal/proto"
 )
 
+const PERMISSION_DELETE_COMMUNITY = "delete_community"
+
+// Database is the part of the store CommunityServer uses.
+type Database interface {
+	orm.CommunityStore
+	orm.RoleStore
+	orm.Transactor
+}
+
 type CommunityServer struct {
 	protopkg.UnimplementedCommunityServiceServer
-	log *zap.Logger
-	db  *orm.PostgresClient
+	log     *zap.Logger
+	db      Database
+	replica Database // lists, which may lag behind db
 }
 
-func NewCommunityServer(log *zap.Logger, db *orm.PostgresClient) *CommunityServer {
+func NewCommunityServer(log *zap.Logger, db Database, replica Database) *CommunityServer {
 	return &CommunityServer{
-		log: log,
-		db:  db,
+		log:     log,
+		db:      db,
+		replica: replica,
 	}
 }
 
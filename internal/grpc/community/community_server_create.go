@@ -41,7 +41,7 @@ func (s *CommunityServer) Create(ctx context.Context, req *protopkg.CreateCommun
 		return nil, status.Errorf(codes.Internal, "cannot get user from context")
 	}
 
-	community := &ormpkg.Community{
+	newCommunity := ormpkg.Community{
 		OwnerID:     userID,
 		Slug:        req.Slug,
 		Name:        req.Name,
@@ -49,29 +49,37 @@ func (s *CommunityServer) Create(ctx context.Context, req *protopkg.CreateCommun
 		Rules:       req.Rules,
 	}
 
-	if err := s.db.InsertCommunity(community); err != nil {
-		s.log.Error("internal error inserting community", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "could not create community")
-	}
+	// The community is unusable without the @everyone role of its creator
+	var community *ormpkg.Community
+	err = s.db.WithTx(ctx, func(tx ormpkg.Store) error {
+		// A retried attempt starts from the unsaved community
+		attempt := newCommunity
+		community = &attempt
 
-	// Create and assign @everyone role
-	everyoneRole := &ormpkg.Role{
-		Name:        "@everyone",
-		CommunityID: &community.ID,
-		Type:        "community",
-		Permissions: json.RawMessage(`{}`),
-	}
-	if err := s.db.InsertRole(everyoneRole); err != nil {
-		s.log.Error("failed to create @everyone role for community", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "could not create role")
-	}
-	userRole := &ormpkg.UserRole{
-		UserID: userID,
-		RoleID: everyoneRole.ID,
-	}
-	if err := s.db.InsertUserRole(userRole); err != nil {
-		s.log.Error("failed to assign @everyone role to creator", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "could not assign role")
+		err := tx.InsertCommunity(community)
+		if err != nil {
+			return err
+		}
+
+		everyoneRole := &ormpkg.Role{
+			Name:        "@everyone",
+			CommunityID: &community.ID,
+			Type:        "community",
+			Permissions: json.RawMessage(`{}`),
+		}
+		err = tx.InsertRole(everyoneRole)
+		if err != nil {
+			return err
+		}
+
+		return tx.InsertUserRole(&ormpkg.UserRole{
+			UserID: userID,
+			RoleID: everyoneRole.ID,
+		})
+	})
+	if err != nil {
+		s.log.Error("internal error creating community", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "could not create community")
 	}
 
 	s.log.Info("community created",
@@ -27,12 +27,18 @@ func (s *CommunityServer) Delete(ctx context.Context, req *protopkg.DeleteCommun
 		return nil, status.Errorf(codes.Internal, "cannot get user from context")
 	}
 
-	// TODO: Replace with proper permission check (Phase 5)
 	if community.OwnerID != userID {
-		return nil, status.Errorf(codes.PermissionDenied, "not an owner")
+		allowed, err := s.db.SelectUserHasPlatformPermission(userID.String(), PERMISSION_DELETE_COMMUNITY)
+		if err != nil {
+			s.log.Error("internal error checking platform permission", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "database error")
+		}
+		if !allowed {
+			return nil, status.Errorf(codes.PermissionDenied, "not an owner")
+		}
 	}
 
-	if err := s.db.DeleteCommunity(community); err != nil {
+	if err := s.db.DeleteCommunity(community, userID, req.Reason); err != nil {
 		s.log.Error("internal error deleting community", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "could not delete community")
 	}
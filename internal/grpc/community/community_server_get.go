@@ -2,6 +2,7 @@ package communitygrpc
 
 import (
 	"context"
+	"math"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -9,7 +10,6 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 
-	"github.com/stormhead-org/backend/internal/lib"
 	protopkg "github.com/stormhead-org/backend/internal/proto"
 )
 
@@ -23,24 +23,22 @@ func (s *CommunityServer) Get(ctx context.Context, req *protopkg.GetCommunityReq
 		return nil, status.Errorf(codes.Internal, "database error")
 	}
 
-	reputation, err := lib.CalculateCommunityReputation(s.db, community)
-	if err != nil {
-		s.log.Error("failed to calculate community reputation", zap.Error(err), zap.String("community_id", req.CommunityId))
-		// Do not fail the request if reputation calculation fails, just log it.
+	result := &protopkg.Community{
+		Id:          community.ID.String(),
+		OwnerId:     community.OwnerID.String(),
+		Slug:        community.Slug,
+		Name:        community.Name,
+		Description: community.Description,
+		Rules:       community.Rules,
+		Reputation:  int32(math.Round(community.Reputation)),
+		CreatedAt:   timestamppb.New(community.CreatedAt),
+		UpdatedAt:   timestamppb.New(community.UpdatedAt),
+	}
+	if community.ArchivedAt != nil {
+		result.ArchivedAt = timestamppb.New(*community.ArchivedAt)
 	}
-	community.Reputation = int(reputation)
 
 	return &protopkg.GetCommunityResponse{
-		Community: &protopkg.Community{
-			Id:          community.ID.String(),
-			OwnerId:     community.OwnerID.String(),
-			Slug:        community.Slug,
-			Name:        community.Name,
-			Description: community.Description,
-			Rules:       community.Rules,
-			Reputation:  int32(community.Reputation),
-			CreatedAt:   timestamppb.New(community.CreatedAt),
-			UpdatedAt:   timestamppb.New(community.UpdatedAt),
-		},
+		Community: result,
 	}, nil
 }
@@ -24,7 +24,7 @@ func (s *CommunityServer) Join(ctx context.Context, req *protopkg.JoinCommunityR
 		return nil, status.Errorf(codes.InvalidArgument, "invalid community id")
 	}
 
-	_, err = s.db.SelectCommunityByID(req.CommunityId)
+	community, err := s.db.SelectCommunityByID(req.CommunityId)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, status.Errorf(codes.NotFound, "community not found")
@@ -33,39 +33,35 @@ func (s *CommunityServer) Join(ctx context.Context, req *protopkg.JoinCommunityR
 		return nil, status.Errorf(codes.Internal, "database error")
 	}
 
-	_, err = s.db.SelectCommunityUser(req.CommunityId, userID.String())
-	if err != gorm.ErrRecordNotFound {
-		if err == nil {
-			// Idempotency: if user is already a member, return success
-			return &protopkg.JoinCommunityResponse{}, nil
-		}
-		s.log.Error("error checking community membership", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "database error")
+	if community.ArchivedAt != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "community is archived")
 	}
 
-	communityUser := &orm.CommunityUser{
-		CommunityID: communityUUID,
-		UserID:      userID,
-	}
+	// Membership and the @everyone role are granted together
+	err = s.db.WithTx(ctx, func(tx orm.Store) error {
+		everyoneRole, err := tx.SelectRoleByName("@everyone", &communityUUID)
+		if err != nil {
+			return err
+		}
 
-	if err := s.db.InsertCommunityUser(communityUser); err != nil {
-		s.log.Error("error inserting community user", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "could not join community")
-	}
+		inserted, err := tx.InsertCommunityUser(&orm.CommunityUser{
+			CommunityID: communityUUID,
+			UserID:      userID,
+		})
+		if err != nil || !inserted {
+			// Idempotency: if user is already a member, return success
+			return err
+		}
 
-	// Assign "@everyone" role
-	everyoneRole, err := s.db.SelectRoleByName("@everyone", &communityUUID)
+		return tx.InsertUserRole(&orm.UserRole{
+			UserID: userID,
+			RoleID: everyoneRole.ID,
+		})
+	})
 	if err != nil {
-		s.log.Error("could not find @everyone role for community", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "could not find role")
-	}
-	userRole := &orm.UserRole{
-		UserID: userID,
-		RoleID: everyoneRole.ID,
-	}
-	if err := s.db.InsertUserRole(userRole); err != nil {
-		s.log.Error("failed to assign @everyone role to user", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "could not assign role")
+		s.log.Error("error joining community", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "could not join community")
 	}
+
 	return &protopkg.JoinCommunityResponse{}, nil
 }
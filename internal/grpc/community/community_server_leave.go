@@ -37,34 +37,26 @@ func (s *CommunityServer) Leave(ctx context.Context, req *protopkg.LeaveCommunit
 		return nil, status.Errorf(codes.PermissionDenied, "owner cannot leave the community, transfer ownership first")
 	}
 
-	communityUser, err := s.db.SelectCommunityUser(req.CommunityId, userID.String())
-	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			// Idempotency: if user is not a member, return success
-			return &protopkg.LeaveCommunityResponse{}, nil
+	err = s.db.WithTx(ctx, func(tx orm.Store) error {
+		everyoneRole, err := tx.SelectRoleByName("@everyone", &communityUUID)
+		if err != nil {
+			return err
 		}
-		s.log.Error("error checking community membership", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "database error")
-	}
 
-	if err := s.db.DeleteCommunityUser(communityUser); err != nil {
-		s.log.Error("error deleting community user", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "could not leave community")
-	}
+		deleted, err := tx.DeleteCommunityUser(communityUUID, userID)
+		if err != nil || !deleted {
+			// Idempotency: if user is not a member, return success
+			return err
+		}
 
-	// Remove "@everyone" role
-	everyoneRole, err := s.db.SelectRoleByName("@everyone", &communityUUID)
+		return tx.DeleteUserRole(&orm.UserRole{
+			UserID: userID,
+			RoleID: everyoneRole.ID,
+		})
+	})
 	if err != nil {
-		s.log.Error("could not find @everyone role for community", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "could not find role")
-	}
-	userRole := &orm.UserRole{
-		UserID: userID,
-		RoleID: everyoneRole.ID,
-	}
-	if err := s.db.DeleteUserRole(userRole); err != nil {
-		s.log.Error("failed to remove @everyone role from user", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "could not remove role")
+		s.log.Error("error leaving community", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "could not leave community")
 	}
 
 	return &protopkg.LeaveCommunityResponse{}, nil
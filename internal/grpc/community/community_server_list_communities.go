@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/stormhead-org/backend/internal/lib"
 	protopkg "github.com/stormhead-org/backend/internal/proto"
 )
 
@@ -16,18 +17,15 @@ func (s *CommunityServer) ListCommunities(ctx context.Context, req *protopkg.Lis
 		req.Limit = 50
 	}
 
-	communities, err := s.db.SelectCommunitiesWithPagination("", int(req.Limit)+1, req.Cursor)
+	communities, page, err := s.replica.SelectCommunitiesWithPagination("", int(req.Limit), req.Cursor)
 	if err != nil {
+		if err == lib.ErrInvalidCursor {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
 		s.log.Error("internal error listing communities", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "database error")
 	}
 
-	var nextCursor string
-	if len(communities) > int(req.Limit) {
-		nextCursor = communities[req.Limit-1].ID.String()
-		communities = communities[:req.Limit]
-	}
-
 	protoCommunities := make([]*protopkg.Community, len(communities))
 	for i, community := range communities {
 		protoCommunities[i] = &protopkg.Community{
@@ -43,7 +41,8 @@ func (s *CommunityServer) ListCommunities(ctx context.Context, req *protopkg.Lis
 
 	return &protopkg.ListCommunitiesResponse{
 		Communities: protoCommunities,
-		NextCursor:  nextCursor,
-		HasMore:     nextCursor != "",
+		NextCursor:  page.NextCursor,
+		PrevCursor:  page.PrevCursor,
+		HasMore:     page.HasMore,
 	}, nil
 }
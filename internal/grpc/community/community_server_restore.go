@@ -0,0 +1,52 @@
+package communitygrpc
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+// Restore undoes a deletion within orm.DELETED_CONTENT_RETENTION. Owners
+// can undo their own deletions, a moderator's only another moderator.
+func (s *CommunityServer) Restore(ctx context.Context, req *protopkg.RestoreCommunityRequest) (*protopkg.RestoreCommunityResponse, error) {
+	community, err := s.db.SelectDeletedCommunityByID(req.CommunityId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "deleted community not found")
+		}
+		s.log.Error("error selecting deleted community by id", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	userID, err := middlewarepkg.GetUserUUID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get user from context")
+	}
+
+	if community.OwnerID != userID || community.DeletedBy == nil || *community.DeletedBy != userID {
+		allowed, err := s.db.SelectUserHasPlatformPermission(userID.String(), PERMISSION_DELETE_COMMUNITY)
+		if err != nil {
+			s.log.Error("internal error checking platform permission", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "database error")
+		}
+		if !allowed {
+			return nil, status.Errorf(codes.PermissionDenied, "not allowed to restore")
+		}
+	}
+
+	if err := s.db.RestoreCommunity(community); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Errorf(codes.FailedPrecondition, "retention period is over")
+		}
+		s.log.Error("internal error restoring community", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "could not restore community")
+	}
+
+	return &protopkg.RestoreCommunityResponse{}, nil
+}
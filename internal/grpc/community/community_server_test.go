@@ -0,0 +1,135 @@
+package communitygrpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stormhead-org/backend/internal/middleware"
+	"github.com/stormhead-org/backend/internal/orm"
+	"github.com/stormhead-org/backend/internal/orm/ormtest"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+func newTestServer() (*CommunityServer, *ormtest.MemoryStore) {
+	store := ormtest.NewMemoryStore()
+	return NewCommunityServer(zap.NewNop(), store, store), store
+}
+
+func insertTestUser(t *testing.T, store *ormtest.MemoryStore, name string) context.Context {
+	t.Helper()
+	user := &orm.User{
+		Slug:         name,
+		SlugSkeleton: name,
+		Name:         name,
+		Email:        name + "@example.com",
+		LastActivity: time.Now(),
+	}
+	err := store.InsertUser(user)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+	return middleware.SetUserID(context.Background(), user.ID.String())
+}
+
+func mustCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if status.Code(err) != code {
+		t.Fatalf("expected %s, got %v", code, err)
+	}
+}
+
+func memberCount(t *testing.T, store *ormtest.MemoryStore, communityID string) int {
+	t.Helper()
+	community, err := store.SelectCommunityByID(communityID)
+	if err != nil {
+		t.Fatalf("failed to select community: %v", err)
+	}
+	return community.MemberCount
+}
+
+func TestCommunityMembership(t *testing.T) {
+	server, store := newTestServer()
+	owner := insertTestUser(t, store, "owner")
+	member := insertTestUser(t, store, "member")
+
+	created, err := server.Create(owner, &protopkg.CreateCommunityRequest{Slug: "go", Name: "Go"})
+	if err != nil {
+		t.Fatalf("failed to create community: %v", err)
+	}
+	communityID := created.Community.Id
+
+	_, err = server.Create(member, &protopkg.CreateCommunityRequest{Slug: "go", Name: "Go again"})
+	mustCode(t, err, codes.AlreadyExists)
+
+	// Joining twice makes one membership
+	for i := 0; i < 2; i++ {
+		_, err = server.Join(member, &protopkg.JoinCommunityRequest{CommunityId: communityID})
+		if err != nil {
+			t.Fatalf("failed to join community: %v", err)
+		}
+	}
+	if got := memberCount(t, store, communityID); got != 1 {
+		t.Fatalf("member count after join: got %d, want 1", got)
+	}
+
+	memberID, _ := middleware.GetUserID(member)
+	roles, err := store.SelectRolesByUserID(memberID)
+	if err != nil || len(roles) != 1 || roles[0].Name != "@everyone" {
+		t.Fatalf("roles after join: got %v, %v", roles, err)
+	}
+
+	_, err = server.Leave(owner, &protopkg.LeaveCommunityRequest{CommunityId: communityID})
+	mustCode(t, err, codes.PermissionDenied)
+
+	_, err = server.Leave(member, &protopkg.LeaveCommunityRequest{CommunityId: communityID})
+	if err != nil {
+		t.Fatalf("failed to leave community: %v", err)
+	}
+	if got := memberCount(t, store, communityID); got != 0 {
+		t.Fatalf("member count after leave: got %d, want 0", got)
+	}
+
+	roles, err = store.SelectRolesByUserID(memberID)
+	if err != nil || len(roles) != 0 {
+		t.Fatalf("roles after leave: got %v, %v", roles, err)
+	}
+}
+
+func TestCommunityJoinRejected(t *testing.T) {
+	server, store := newTestServer()
+	owner := insertTestUser(t, store, "owner")
+	member := insertTestUser(t, store, "member")
+
+	_, err := server.Join(member, &protopkg.JoinCommunityRequest{CommunityId: "not-a-uuid"})
+	mustCode(t, err, codes.InvalidArgument)
+
+	_, err = server.Join(member, &protopkg.JoinCommunityRequest{CommunityId: "00000000-0000-0000-0000-000000000001"})
+	mustCode(t, err, codes.NotFound)
+
+	created, err := server.Create(owner, &protopkg.CreateCommunityRequest{Slug: "go", Name: "Go"})
+	if err != nil {
+		t.Fatalf("failed to create community: %v", err)
+	}
+
+	community, err := store.SelectCommunityByID(created.Community.Id)
+	if err != nil {
+		t.Fatalf("failed to select community: %v", err)
+	}
+	archivedAt := time.Now()
+	community.ArchivedAt = &archivedAt
+	err = store.UpdateCommunity(community)
+	if err != nil {
+		t.Fatalf("failed to archive community: %v", err)
+	}
+
+	_, err = server.Join(member, &protopkg.JoinCommunityRequest{CommunityId: created.Community.Id})
+	mustCode(t, err, codes.FailedPrecondition)
+	if got := memberCount(t, store, created.Community.Id); got != 0 {
+		t.Fatalf("member count: got %d, want 0", got)
+	}
+}
@@ -23,6 +23,10 @@ func (s *CommunityServer) Update(ctx context.Context, req *protopkg.UpdateCommun
 		return nil, status.Errorf(codes.Internal, "database error")
 	}
 
+	if community.ArchivedAt != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "community is archived")
+	}
+
 	userID, err := middlewarepkg.GetUserUUID(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "cannot get user from context")
@@ -17,6 +17,7 @@ import (
 
 	authorizationgrpcpkg "github.com/stormhead-org/backend/internal/grpc/authorization"
 	communitygrpcpkg "github.com/stormhead-org/backend/internal/grpc/community"
+	platformgrpcpkg "github.com/stormhead-org/backend/internal/grpc/platform"
 	postgrpcpkg "github.com/stormhead-org/backend/internal/grpc/post"
 )
 
@@ -31,6 +32,8 @@ func NewGRPC(
 	logger *zap.Logger,
 	jwt *jwt.JWT,
 	db *orm.PostgresClient,
+	sessions *middleware.SessionCache,
+	challenges *middleware.ChallengeGuard,
 	host string,
 	port string,
 	authServer *authorizationgrpcpkg.AuthorizationServer,
@@ -38,13 +41,15 @@ func NewGRPC(
 	postServer *postgrpcpkg.PostServer,
 	commentServer *CommentServer,
 	userServer *UserServer,
+	platformServer *platformgrpcpkg.PlatformServer,
 ) (*GRPC, error) {
 	rateLimitMiddleware := middleware.NewRateLimitMiddleware(5, 600)
-	authMiddleware := middleware.NewAuthorizationMiddleware(logger, jwt, db)
+	authMiddleware := middleware.NewAuthorizationMiddleware(logger, jwt, db, sessions)
 
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			rateLimitMiddleware,
+			challenges.Unary(),
 			authMiddleware,
 		),
 	)
@@ -55,6 +60,7 @@ func NewGRPC(
 	proto.RegisterPostServiceServer(grpcServer, postServer)
 	proto.RegisterCommentServiceServer(grpcServer, commentServer)
 	proto.RegisterUserServiceServer(grpcServer, userServer)
+	proto.RegisterPlatformServiceServer(grpcServer, platformServer)
 
 	// Search API
 	// searchServer := NewSearchServer(logger, database, broker)
@@ -0,0 +1,105 @@
+package platformgrpc
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stormhead-org/backend/internal/orm"
+
+	eventpkg "github.com/stormhead-org/backend/internal/event"
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+const PERMISSION_EDIT_PLATFORM_SETTINGS = "edit_platform_settings"
+const PERMISSION_MANAGE_PLATFORM_USERS = "manage_platform_users"
+const PERMISSION_CREATE_INVITES = "create_invites"
+
+// Database is the part of the store PlatformServer uses.
+type Database interface {
+	orm.UserStore
+	orm.CommunityStore
+	orm.RoleStore
+	orm.AuthThrottleStore
+	orm.InviteCodeStore
+	orm.PlatformSettingStore
+	orm.ReputationStore
+	orm.StatisticsStore
+}
+
+type PlatformServer struct {
+	protopkg.UnimplementedPlatformServiceServer
+	log     *zap.Logger
+	db      Database
+	replica Database // analytics, which may lag behind db
+	broker  *eventpkg.KafkaClient
+}
+
+func NewPlatformServer(log *zap.Logger, db Database, replica Database, broker *eventpkg.KafkaClient) *PlatformServer {
+	return &PlatformServer{
+		log:     log,
+		db:      db,
+		replica: replica,
+		broker:  broker,
+	}
+}
+
+// requirePermission fails unless the caller holds the given platform
+// permission.
+func (s *PlatformServer) requirePermission(ctx context.Context, permission string) error {
+	userID, err := middlewarepkg.GetUserID(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "not authenticated")
+	}
+
+	allowed, err := s.db.SelectUserHasPlatformPermission(userID, permission)
+	if err != nil {
+		s.log.Error("internal error checking platform permission", zap.Error(err))
+		return status.Errorf(codes.Internal, "database error")
+	}
+	if !allowed {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	return nil
+}
+
+func inviteCodeToProto(code *orm.InviteCode) *protopkg.InviteCode {
+	result := &protopkg.InviteCode{
+		Id:         code.ID.String(),
+		CodePrefix: code.CodePrefix,
+		UseCount:   int32(code.UseCount),
+		CreatedAt:  timestamppb.New(code.CreatedAt),
+		IsActive:   code.IsActive(time.Now()),
+	}
+	if code.CreatedBy != nil {
+		result.CreatedBy = code.CreatedBy.String()
+	}
+	if code.MaxUses != nil {
+		result.MaxUses = int32(*code.MaxUses)
+	}
+	if code.ExpiresAt != nil {
+		result.ExpiresAt = timestamppb.New(*code.ExpiresAt)
+	}
+	if code.RevokedAt != nil {
+		result.RevokedAt = timestamppb.New(*code.RevokedAt)
+	}
+	return result
+}
+
+func emailDomainRuleToProto(rule *orm.EmailDomainRule) *protopkg.EmailDomainRule {
+	result := &protopkg.EmailDomainRule{
+		Domain:    rule.Domain,
+		Kind:      rule.Kind,
+		CreatedAt: timestamppb.New(rule.CreatedAt),
+	}
+	if rule.CreatedBy != nil {
+		result.CreatedBy = rule.CreatedBy.String()
+	}
+	return result
+}
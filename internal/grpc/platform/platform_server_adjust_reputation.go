@@ -0,0 +1,119 @@
+package platformgrpc
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"github.com/stormhead-org/backend/internal/orm"
+
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+const REPUTATION_REASON_MAX_LENGTH = 500
+
+// AdjustReputation records a moderator correction in the reputation ledger.
+// With a user the delta goes to their reputation, and to their karma in the
+// community if one is given; with a community only it goes to its rating.
+func (s *PlatformServer) AdjustReputation(ctx context.Context, req *protopkg.AdjustReputationRequest) (*protopkg.AdjustReputationResponse, error) {
+	err := s.requirePermission(ctx, PERMISSION_MANAGE_PLATFORM_USERS)
+	if err != nil {
+		return nil, err
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is required")
+	}
+	if len([]rune(reason)) > REPUTATION_REASON_MAX_LENGTH {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is too long")
+	}
+
+	if req.Delta == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "delta must not be zero")
+	}
+
+	actorID, err := middlewarepkg.GetUserUUID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "not authenticated")
+	}
+
+	event := orm.ReputationEvent{
+		Kind:    orm.REPUTATION_ADJUSTMENT,
+		ActorID: &actorID,
+		Reason:  reason,
+	}
+
+	if req.CommunityId != "" {
+		communityID, err := uuid.Parse(req.CommunityId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid community_id")
+		}
+
+		_, err = s.db.SelectCommunityByID(req.CommunityId)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "community not found")
+		}
+		if err != nil {
+			s.log.Error("internal error selecting community", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "database error")
+		}
+
+		event.CommunityID = &communityID
+	}
+
+	switch {
+	case req.UserId != "":
+		userID, err := uuid.Parse(req.UserId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user_id")
+		}
+		if req.Delta != math.Trunc(req.Delta) {
+			return nil, status.Errorf(codes.InvalidArgument, "user reputation changes by whole points")
+		}
+
+		_, err = s.db.SelectUserByID(req.UserId)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		if err != nil {
+			s.log.Error("internal error selecting user", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "database error")
+		}
+
+		event.UserID = &userID
+		event.UserDelta = int64(req.Delta)
+	case event.CommunityID != nil:
+		if req.Delta*10 != math.Trunc(req.Delta*10) {
+			return nil, status.Errorf(codes.InvalidArgument, "community rating changes by tenths of a point")
+		}
+
+		event.CommunityDelta = req.Delta
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "user_id or community_id is required")
+	}
+
+	err = s.db.InsertReputationEvent(&event)
+	if err != nil {
+		s.log.Error("internal error adjusting reputation", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	s.log.Info(
+		"reputation adjusted",
+		zap.String("userID", req.UserId),
+		zap.String("communityID", req.CommunityId),
+		zap.Float64("delta", req.Delta),
+		zap.String("actorID", actorID.String()),
+	)
+
+	return &protopkg.AdjustReputationResponse{}, nil
+}
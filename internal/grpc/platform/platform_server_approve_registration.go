@@ -0,0 +1,50 @@
+package platformgrpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	eventpkg "github.com/stormhead-org/backend/internal/event"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+func (s *PlatformServer) ApproveRegistration(ctx context.Context, req *protopkg.ApproveRegistrationRequest) (*protopkg.ApproveRegistrationResponse, error) {
+	err := s.requirePermission(ctx, PERMISSION_MANAGE_PLATFORM_USERS)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id")
+	}
+
+	err = s.db.ApproveUser(req.UserId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "pending registration not found")
+	}
+	if err != nil {
+		s.log.Error("internal error approving registration", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	err = s.broker.WriteMessage(
+		ctx,
+		eventpkg.PLATFORM_REGISTRATION_APPROVED,
+		eventpkg.PlatformRegistrationApprovedMessage{
+			ID: req.UserId,
+		},
+	)
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	return &protopkg.ApproveRegistrationResponse{}, nil
+}
@@ -0,0 +1,68 @@
+package platformgrpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stormhead-org/backend/internal/orm"
+
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
+)
+
+const INVITE_CODE_PREFIX_LENGTH = 8
+
+func (s *PlatformServer) CreateInviteCode(ctx context.Context, req *protopkg.CreateInviteCodeRequest) (*protopkg.CreateInviteCodeResponse, error) {
+	err := s.requirePermission(ctx, PERMISSION_CREATE_INVITES)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.MaxUses < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max_uses must not be negative")
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.AsTime().After(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "expires_at must be in the future")
+	}
+
+	userID, err := middlewarepkg.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "not authenticated")
+	}
+	creatorID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	code := securitypkg.GenerateURLSafeToken()
+	invite := orm.InviteCode{
+		CodeHash:   securitypkg.HashToken(code),
+		CodePrefix: code[:INVITE_CODE_PREFIX_LENGTH],
+		CreatedBy:  &creatorID,
+	}
+	if req.MaxUses > 0 {
+		maxUses := int(req.MaxUses)
+		invite.MaxUses = &maxUses
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		invite.ExpiresAt = &expiresAt
+	}
+
+	err = s.db.InsertInviteCode(&invite)
+	if err != nil {
+		s.log.Error("internal error creating invite code", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	return &protopkg.CreateInviteCodeResponse{
+		Invite: inviteCodeToProto(&invite),
+		Code:   code,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package platformgrpc
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"github.com/stormhead-org/backend/internal/lib"
+
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+func (s *PlatformServer) DeleteEmailDomainRule(ctx context.Context, req *protopkg.DeleteEmailDomainRuleRequest) (*protopkg.DeleteEmailDomainRuleResponse, error) {
+	err := s.requirePermission(ctx, PERMISSION_EDIT_PLATFORM_SETTINGS)
+	if err != nil {
+		return nil, err
+	}
+
+	domain, err := lib.NormalizeEmailDomain(req.Domain)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid domain")
+	}
+
+	err = s.db.DeleteEmailDomainRule(domain)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "email domain rule not found")
+	}
+	if err != nil {
+		s.log.Error("internal error deleting email domain rule", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	return &protopkg.DeleteEmailDomainRuleResponse{}, nil
+}
@@ -0,0 +1,164 @@
+package platformgrpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"github.com/stormhead-org/backend/internal/orm"
+
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+const PERMISSION_VIEW_ANALYTICS = "view_analytics"
+
+// ANALYTICS_DEFAULT_BUCKETS is the length of a series without from,
+// ANALYTICS_MAX_BUCKETS the longest series returned at once.
+const ANALYTICS_DEFAULT_BUCKETS = 30
+const ANALYTICS_MAX_BUCKETS = 1000
+
+// GetAnalytics returns the activity time series of the platform or of a
+// community from the statistics rollups, with a point for every bucket.
+// Buckets the worker hasn't computed yet are zero. The platform series
+// requires view_analytics, a community series the community owner or
+// view_analytics. Access is checked before the range is looked at.
+func (s *PlatformServer) GetAnalytics(ctx context.Context, req *protopkg.GetAnalyticsRequest) (*protopkg.GetAnalyticsResponse, error) {
+	err := s.authorizeAnalytics(ctx, req.CommunityId)
+	if err != nil {
+		return nil, err
+	}
+
+	size := orm.StatisticsBucketSize(req.Granularity)
+	if size == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "granularity must be hour or day")
+	}
+
+	to := time.Now()
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	from := to.Add(-ANALYTICS_DEFAULT_BUCKETS * size)
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if !from.Before(to) {
+		return nil, status.Errorf(codes.InvalidArgument, "from must be before to")
+	}
+
+	// Count the buckets before building them, from is truncated to the start
+	// of its bucket so the range can cover one more
+	if to.Sub(from)/size+1 > ANALYTICS_MAX_BUCKETS {
+		return nil, status.Errorf(codes.InvalidArgument, "range is too long")
+	}
+
+	buckets := orm.StatisticsBuckets(req.Granularity, from, to)
+	from = buckets[0]
+
+	if req.CommunityId == "" {
+		return s.getPlatformAnalytics(req.Granularity, buckets, from, to)
+	}
+	return s.getCommunityAnalytics(req.CommunityId, req.Granularity, buckets, from, to)
+}
+
+// authorizeAnalytics checks the caller may read the series of the platform,
+// for an empty communityID, or of the community.
+func (s *PlatformServer) authorizeAnalytics(ctx context.Context, communityID string) error {
+	if communityID == "" {
+		return s.requirePermission(ctx, PERMISSION_VIEW_ANALYTICS)
+	}
+
+	_, err := uuid.Parse(communityID)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid community_id")
+	}
+
+	userID, err := middlewarepkg.GetUserUUID(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "not authenticated")
+	}
+
+	community, err := s.db.SelectCommunityByID(communityID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return status.Errorf(codes.NotFound, "community not found")
+		}
+		s.log.Error("error selecting community by id", zap.Error(err))
+		return status.Errorf(codes.Internal, "database error")
+	}
+
+	if community.OwnerID != userID {
+		return s.requirePermission(ctx, PERMISSION_VIEW_ANALYTICS)
+	}
+	return nil
+}
+
+func (s *PlatformServer) getPlatformAnalytics(granularity string, buckets []time.Time, from time.Time, to time.Time) (*protopkg.GetAnalyticsResponse, error) {
+	statistics, err := s.replica.SelectPlatformStatistics(granularity, from, to)
+	if err != nil {
+		s.log.Error("internal error selecting platform statistics", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	computed := make(map[time.Time]*orm.PlatformStatistics, len(statistics))
+	for _, bucket := range statistics {
+		computed[bucket.BucketStart.UTC()] = bucket
+	}
+
+	points := make([]*protopkg.AnalyticsPoint, len(buckets))
+	for i, start := range buckets {
+		points[i] = &protopkg.AnalyticsPoint{
+			BucketStart: timestamppb.New(start),
+		}
+		if bucket, ok := computed[start]; ok {
+			points[i].NewUsers = bucket.NewUsers
+			points[i].ActiveUsers = bucket.ActiveUsers
+			points[i].NewCommunities = bucket.NewCommunities
+			points[i].Posts = bucket.Posts
+			points[i].Comments = bucket.Comments
+			points[i].Likes = bucket.Likes
+			points[i].Joins = bucket.Joins
+		}
+	}
+
+	return &protopkg.GetAnalyticsResponse{
+		Points: points,
+	}, nil
+}
+
+func (s *PlatformServer) getCommunityAnalytics(communityID string, granularity string, buckets []time.Time, from time.Time, to time.Time) (*protopkg.GetAnalyticsResponse, error) {
+	statistics, err := s.replica.SelectCommunityStatistics(communityID, granularity, from, to)
+	if err != nil {
+		s.log.Error("internal error selecting community statistics", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	computed := make(map[time.Time]*orm.CommunityStatistics, len(statistics))
+	for _, bucket := range statistics {
+		computed[bucket.BucketStart.UTC()] = bucket
+	}
+
+	points := make([]*protopkg.AnalyticsPoint, len(buckets))
+	for i, start := range buckets {
+		points[i] = &protopkg.AnalyticsPoint{
+			BucketStart: timestamppb.New(start),
+		}
+		if bucket, ok := computed[start]; ok {
+			points[i].ActiveUsers = bucket.ActiveUsers
+			points[i].Posts = bucket.Posts
+			points[i].Comments = bucket.Comments
+			points[i].Likes = bucket.Likes
+			points[i].Joins = bucket.Joins
+		}
+	}
+
+	return &protopkg.GetAnalyticsResponse{
+		Points: points,
+	}, nil
+}
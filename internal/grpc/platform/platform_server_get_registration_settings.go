@@ -0,0 +1,25 @@
+package platformgrpc
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+// GetRegistrationSettings is public, clients use it to decide whether to
+// show the sign up form and the invite code field.
+func (s *PlatformServer) GetRegistrationSettings(ctx context.Context, req *protopkg.GetRegistrationSettingsRequest) (*protopkg.GetRegistrationSettingsResponse, error) {
+	settings, err := s.db.SelectPlatformSetting()
+	if err != nil {
+		s.log.Error("internal error selecting platform settings", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	return &protopkg.GetRegistrationSettingsResponse{
+		Mode: settings.RegistrationMode,
+	}, nil
+}
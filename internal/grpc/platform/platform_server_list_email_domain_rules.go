@@ -0,0 +1,33 @@
+package platformgrpc
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+func (s *PlatformServer) ListEmailDomainRules(ctx context.Context, req *protopkg.ListEmailDomainRulesRequest) (*protopkg.ListEmailDomainRulesResponse, error) {
+	err := s.requirePermission(ctx, PERMISSION_EDIT_PLATFORM_SETTINGS)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.db.SelectEmailDomainRules()
+	if err != nil {
+		s.log.Error("internal error listing email domain rules", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	protoRules := make([]*protopkg.EmailDomainRule, len(rules))
+	for i, rule := range rules {
+		protoRules[i] = emailDomainRuleToProto(rule)
+	}
+
+	return &protopkg.ListEmailDomainRulesResponse{
+		Rules: protoRules,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package platformgrpc
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+func (s *PlatformServer) ListInviteCodes(ctx context.Context, req *protopkg.ListInviteCodesRequest) (*protopkg.ListInviteCodesResponse, error) {
+	err := s.requirePermission(ctx, PERMISSION_CREATE_INVITES)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Limit <= 0 || req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	inviteCodes, page, err := s.db.SelectInviteCodesWithPagination(int(req.Limit), req.Cursor)
+	if err != nil {
+		if err == lib.ErrInvalidCursor {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
+		s.log.Error("internal error listing invite codes", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	invites := make([]*protopkg.InviteCode, len(inviteCodes))
+	for i, code := range inviteCodes {
+		invites[i] = inviteCodeToProto(code)
+	}
+
+	return &protopkg.ListInviteCodesResponse{
+		Invites:    invites,
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+		HasMore:    page.HasMore,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package platformgrpc
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+func (s *PlatformServer) ListLoginLockouts(ctx context.Context, req *protopkg.ListLoginLockoutsRequest) (*protopkg.ListLoginLockoutsResponse, error) {
+	err := s.requirePermission(ctx, PERMISSION_MANAGE_PLATFORM_USERS)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Limit <= 0 || req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	lockouts, page, err := s.db.SelectLoginLockoutsWithPagination(int(req.Limit), req.Cursor)
+	if err != nil {
+		if err == lib.ErrInvalidCursor {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
+		s.log.Error("internal error listing login lockouts", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	protoLockouts := make([]*protopkg.LoginLockout, len(lockouts))
+	for i, lockout := range lockouts {
+		userID := ""
+		if lockout.UserID != nil {
+			userID = lockout.UserID.String()
+		}
+
+		protoLockouts[i] = &protopkg.LoginLockout{
+			Id:           lockout.ID.String(),
+			Kind:         lockout.Kind,
+			UserId:       userID,
+			Email:        lockout.Email,
+			IpAddress:    lockout.IpAddress,
+			FailureCount: int32(lockout.FailureCount),
+			LockedUntil:  timestamppb.New(lockout.LockedUntil),
+			CreatedAt:    timestamppb.New(lockout.CreatedAt),
+		}
+	}
+
+	return &protopkg.ListLoginLockoutsResponse{
+		Lockouts:   protoLockouts,
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+		HasMore:    page.HasMore,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package platformgrpc
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+func (s *PlatformServer) ListPendingRegistrations(ctx context.Context, req *protopkg.ListPendingRegistrationsRequest) (*protopkg.ListPendingRegistrationsResponse, error) {
+	err := s.requirePermission(ctx, PERMISSION_MANAGE_PLATFORM_USERS)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Limit <= 0 || req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	users, page, err := s.db.SelectPendingUsersWithPagination(int(req.Limit), req.Cursor)
+	if err != nil {
+		if err == lib.ErrInvalidCursor {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
+		s.log.Error("internal error listing pending registrations", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	registrations := make([]*protopkg.PendingRegistration, len(users))
+	for i, user := range users {
+		registrations[i] = &protopkg.PendingRegistration{
+			UserId:     user.ID.String(),
+			Slug:       user.Slug,
+			Name:       user.Name,
+			Email:      user.Email,
+			IsVerified: user.IsVerified,
+			CreatedAt:  timestamppb.New(user.CreatedAt),
+		}
+	}
+
+	return &protopkg.ListPendingRegistrationsResponse{
+		Registrations: registrations,
+		NextCursor:    page.NextCursor,
+		PrevCursor:    page.PrevCursor,
+		HasMore:       page.HasMore,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package platformgrpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	eventpkg "github.com/stormhead-org/backend/internal/event"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+// RejectRegistration deletes the pending account, so the email address and
+// slug can be used again.
+func (s *PlatformServer) RejectRegistration(ctx context.Context, req *protopkg.RejectRegistrationRequest) (*protopkg.RejectRegistrationResponse, error) {
+	err := s.requirePermission(ctx, PERMISSION_MANAGE_PLATFORM_USERS)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id")
+	}
+
+	user, err := s.db.SelectUserByID(req.UserId)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && user.IsApproved) {
+		return nil, status.Errorf(codes.NotFound, "pending registration not found")
+	}
+	if err != nil {
+		s.log.Error("internal error selecting user", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	err = s.db.DeletePendingUser(req.UserId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "pending registration not found")
+	}
+	if err != nil {
+		s.log.Error("internal error rejecting registration", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	err = s.broker.WriteMessage(
+		ctx,
+		eventpkg.PLATFORM_REGISTRATION_REJECTED,
+		eventpkg.PlatformRegistrationRejectedMessage{
+			Email: user.Email,
+			Name:  user.Name,
+		},
+	)
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	return &protopkg.RejectRegistrationResponse{}, nil
+}
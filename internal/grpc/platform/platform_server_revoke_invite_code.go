@@ -0,0 +1,37 @@
+package platformgrpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+func (s *PlatformServer) RevokeInviteCode(ctx context.Context, req *protopkg.RevokeInviteCodeRequest) (*protopkg.RevokeInviteCodeResponse, error) {
+	err := s.requirePermission(ctx, PERMISSION_CREATE_INVITES)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = uuid.Parse(req.InviteId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invite_id")
+	}
+
+	err = s.db.RevokeInviteCode(req.InviteId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "invite code not found or already revoked")
+	}
+	if err != nil {
+		s.log.Error("internal error revoking invite code", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	return &protopkg.RevokeInviteCodeResponse{}, nil
+}
@@ -0,0 +1,58 @@
+package platformgrpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	"github.com/stormhead-org/backend/internal/orm"
+
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+func (s *PlatformServer) SetEmailDomainRule(ctx context.Context, req *protopkg.SetEmailDomainRuleRequest) (*protopkg.SetEmailDomainRuleResponse, error) {
+	err := s.requirePermission(ctx, PERMISSION_EDIT_PLATFORM_SETTINGS)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Kind != orm.EMAIL_DOMAIN_RULE_ALLOW && req.Kind != orm.EMAIL_DOMAIN_RULE_DENY {
+		return nil, status.Errorf(codes.InvalidArgument, "kind must be allow or deny")
+	}
+
+	domain, err := lib.NormalizeEmailDomain(req.Domain)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid domain")
+	}
+
+	userID, err := middlewarepkg.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "not authenticated")
+	}
+	creatorID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	rule := orm.EmailDomainRule{
+		Domain:    domain,
+		Kind:      req.Kind,
+		CreatedBy: &creatorID,
+	}
+	err = s.db.UpsertEmailDomainRule(&rule)
+	if err != nil {
+		s.log.Error("internal error saving email domain rule", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	s.log.Info("email domain rule set", zap.String("domain", domain), zap.String("kind", req.Kind), zap.String("userID", userID))
+
+	return &protopkg.SetEmailDomainRuleResponse{
+		Rule: emailDomainRuleToProto(&rule),
+	}, nil
+}
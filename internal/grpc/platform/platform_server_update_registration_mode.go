@@ -0,0 +1,38 @@
+package platformgrpc
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stormhead-org/backend/internal/orm"
+
+	middlewarepkg "github.com/stormhead-org/backend/internal/middleware"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+)
+
+func (s *PlatformServer) UpdateRegistrationMode(ctx context.Context, req *protopkg.UpdateRegistrationModeRequest) (*protopkg.UpdateRegistrationModeResponse, error) {
+	err := s.requirePermission(ctx, PERMISSION_EDIT_PLATFORM_SETTINGS)
+	if err != nil {
+		return nil, err
+	}
+
+	if !orm.IsValidRegistrationMode(req.Mode) {
+		return nil, status.Errorf(codes.InvalidArgument, "mode must be one of open, invite_only, approval, closed")
+	}
+
+	err = s.db.UpdatePlatformRegistrationMode(req.Mode)
+	if err != nil {
+		s.log.Error("internal error updating registration mode", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	userID, _ := middlewarepkg.GetUserID(ctx)
+	s.log.Info("registration mode changed", zap.String("mode", req.Mode), zap.String("userID", userID))
+
+	return &protopkg.UpdateRegistrationModeResponse{
+		Mode: req.Mode,
+	}, nil
+}
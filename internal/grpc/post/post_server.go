@@ -6,15 +6,29 @@ import (
 	"go.uber.org/zap"
 )
 
+const PERMISSION_DELETE_ANY_POST = "delete_any_post"
+
+// Database is the part of the store PostServer uses.
+type Database interface {
+	orm.PostStore
+	orm.CommentStore
+	orm.CommunityStore
+	orm.LikeStore
+	orm.BookmarkStore
+	orm.RoleStore
+}
+
 type PostServer struct {
 	protopkg.UnimplementedPostServiceServer
-	log *zap.Logger
-	db  *orm.PostgresClient
+	log     *zap.Logger
+	db      Database
+	replica Database // lists, which may lag behind db
 }
 
-func NewPostServer(log *zap.Logger, db *orm.PostgresClient) *PostServer {
+func NewPostServer(log *zap.Logger, db Database, replica Database) *PostServer {
 	return &PostServer{
-		log: log,
-		db:  db,
+		log:     log,
+		db:      db,
+		replica: replica,
 	}
 }
@@ -35,7 +35,20 @@ func (s *PostServer) Create(ctx context.Context, request *protopkg.CreatePostReq
 		return nil, status.Errorf(codes.Internal, "cannot get user from context")
 	}
 
-	// Check if community exists and user is a member
+	community, err := s.db.SelectCommunityByID(request.CommunityId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "community not found")
+		}
+		s.log.Error("error selecting community by id", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	if community.ArchivedAt != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "community is archived")
+	}
+
+	// Check the user is a member
 	_, err = s.db.SelectCommunityUser(request.CommunityId, userID.String())
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
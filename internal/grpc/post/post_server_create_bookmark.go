@@ -3,7 +3,6 @@ package postgrpc
 import (
 	"context"
 
-	"github.com/google/uuid"
 	"github.com/stormhead-org/backend/internal/middleware"
 	"github.com/stormhead-org/backend/internal/orm"
 	protopkg "github.com/stormhead-org/backend/internal/proto"
@@ -19,7 +18,7 @@ func (s *PostServer) CreateBookmark(ctx context.Context, request *protopkg.Creat
 		return nil, status.Errorf(codes.Internal, "cannot get user from context")
 	}
 
-	_, err = s.db.SelectPostByID(request.PostId)
+	post, err := s.db.SelectPostByID(request.PostId)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, status.Errorf(codes.NotFound, "post not found")
@@ -28,21 +27,13 @@ func (s *PostServer) CreateBookmark(ctx context.Context, request *protopkg.Creat
 		return nil, status.Errorf(codes.Internal, "database error")
 	}
 
-	_, err = s.db.SelectBookmarkByID(request.PostId, userID.String())
-	if err != gorm.ErrRecordNotFound {
-		if err == nil {
-			// Idempotency: already bookmarked, return success
-			return &protopkg.CreateBookmarkResponse{}, nil
-		}
-		s.log.Error("error checking bookmark", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "database error")
-	}
-
-	bookmark := &orm.Bookmark{
-		PostID: uuid.MustParse(request.PostId),
+	// Idempotency: bookmarking an already bookmarked post succeeds and
+	// changes nothing
+	_, err = s.db.InsertBookmark(&orm.Bookmark{
+		PostID: post.ID,
 		UserID: userID,
-	}
-	if err := s.db.InsertBookmark(bookmark); err != nil {
+	})
+	if err != nil {
 		s.log.Error("error inserting bookmark", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "could not create bookmark")
 	}
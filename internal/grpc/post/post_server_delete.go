@@ -27,11 +27,17 @@ func (s *PostServer) Delete(ctx context.Context, request *protopkg.DeletePostReq
 	}
 
 	if post.AuthorID != userID {
-		// TODO: Add moderator check (Phase 5)
-		return nil, status.Errorf(codes.PermissionDenied, "not an author")
+		allowed, err := s.db.SelectUserHasPlatformPermission(userID.String(), PERMISSION_DELETE_ANY_POST)
+		if err != nil {
+			s.log.Error("error checking platform permission", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "database error")
+		}
+		if !allowed {
+			return nil, status.Errorf(codes.PermissionDenied, "not an author")
+		}
 	}
 
-	if err := s.db.DeletePost(post); err != nil {
+	if err := s.db.DeletePost(post, userID, request.Reason); err != nil {
 		s.log.Error("error deleting post", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "could not delete post")
 	}
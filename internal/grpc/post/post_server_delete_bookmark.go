@@ -3,12 +3,12 @@ package postgrpc
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/stormhead-org/backend/internal/middleware"
 	protopkg "github.com/stormhead-org/backend/internal/proto"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"gorm.io/gorm"
 )
 
 func (s *PostServer) DeleteBookmark(ctx context.Context, request *protopkg.DeleteBookmarkRequest) (*protopkg.DeleteBookmarkResponse, error) {
@@ -17,17 +17,14 @@ func (s *PostServer) DeleteBookmark(ctx context.Context, request *protopkg.Delet
 		return nil, status.Errorf(codes.Internal, "cannot get user from context")
 	}
 
-	bookmark, err := s.db.SelectBookmarkByID(request.PostId, userID.String())
+	postID, err := uuid.Parse(request.PostId)
 	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			// Idempotency: not bookmarked, return success
-			return &protopkg.DeleteBookmarkResponse{}, nil
-		}
-		s.log.Error("error checking bookmark", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "database error")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid post_id")
 	}
 
-	if err := s.db.DeleteBookmark(bookmark); err != nil {
+	// Idempotency: removing a missing bookmark succeeds
+	_, err = s.db.DeleteBookmark(postID, userID)
+	if err != nil {
 		s.log.Error("error deleting bookmark", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "could not delete bookmark")
 	}
@@ -0,0 +1,71 @@
+package postgrpc
+
+import (
+	"context"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	"github.com/stormhead-org/backend/internal/middleware"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListBookmarks lists the posts the caller bookmarked, most recently
+// bookmarked first. Deleted posts are left out.
+func (s *PostServer) ListBookmarks(ctx context.Context, request *protopkg.ListBookmarksRequest) (*protopkg.ListBookmarksResponse, error) {
+	userID, err := middleware.GetUserUUID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get user from context")
+	}
+
+	limit := int(request.Limit)
+	if limit <= 0 || limit > 50 {
+		limit = 50
+	}
+
+	bookmarks, page, err := s.replica.SelectBookmarksWithPagination(userID.String(), limit, request.Cursor)
+	if err != nil {
+		if err == lib.ErrInvalidCursor {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
+		s.log.Error("error selecting bookmarks", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	posts := make([]*protopkg.Post, len(bookmarks))
+	for i, bookmark := range bookmarks {
+		post := bookmark.Post
+
+		structContent, err := rawContentToStruct(post.Content)
+		if err != nil {
+			s.log.Error("failed to convert raw content to struct", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to process content")
+		}
+
+		posts[i] = &protopkg.Post{
+			Id:               post.ID.String(),
+			CommunityId:      post.CommunityID.String(),
+			CommunityName:    post.Community.Name,
+			AuthorId:         post.AuthorID.String(),
+			AuthorName:       post.Author.Name,
+			Title:            post.Title,
+			Content:          structContent,
+			Status:           protopkg.PostStatus(post.Status),
+			LikeCount:        int32(post.LikeCount),
+			CommentCount:     int32(post.CommentCount),
+			IsBookmarkedByMe: true,
+			CreatedAt:        timestamppb.New(post.CreatedAt),
+			UpdatedAt:        timestamppb.New(post.UpdatedAt),
+			PublishedAt:      timestamppb.New(post.PublishedAt),
+		}
+	}
+
+	return &protopkg.ListBookmarksResponse{
+		Posts:      posts,
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+		HasMore:    page.HasMore,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package postgrpc
+
+import (
+	"context"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// ListComments lists the thread of a post newest first. Deleted comments
+// with replies are kept as placeholders without author and content.
+func (s *PostServer) ListComments(ctx context.Context, request *protopkg.ListPostCommentsRequest) (*protopkg.ListPostCommentsResponse, error) {
+	_, err := s.db.SelectPostByID(request.PostId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "post not found")
+		}
+		s.log.Error("error selecting post by id", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	limit := int(request.Limit)
+	if limit <= 0 || limit > 50 {
+		limit = 50
+	}
+
+	comments, page, err := s.replica.SelectCommentsWithPagination(request.PostId, "", limit, request.Cursor)
+	if err != nil {
+		if err == lib.ErrInvalidCursor {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
+		s.log.Error("error selecting comments", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	result := make([]*protopkg.Comment, len(comments))
+	for i, comment := range comments {
+		parentCommentID := ""
+		if comment.ParentCommentID != nil {
+			parentCommentID = comment.ParentCommentID.String()
+		}
+
+		result[i] = &protopkg.Comment{
+			Id:              comment.ID.String(),
+			ParentCommentId: parentCommentID,
+			PostId:          comment.PostID.String(),
+			AuthorName:      comment.Author.Name,
+			Content:         comment.Content,
+			LikeCount:       int32(comment.LikeCount),
+			CreatedAt:       timestamppb.New(comment.CreatedAt),
+			UpdatedAt:       timestamppb.New(comment.UpdatedAt),
+			IsDeleted:       comment.DeletedAt != nil,
+		}
+		if comment.DeletedAt == nil {
+			result[i].AuthorId = comment.AuthorID.String()
+		}
+	}
+
+	return &protopkg.ListPostCommentsResponse{
+		Comments:   result,
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+		HasMore:    page.HasMore,
+	}, nil
+}
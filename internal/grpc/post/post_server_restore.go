@@ -0,0 +1,60 @@
+package postgrpc
+
+import (
+	"context"
+
+	"github.com/stormhead-org/backend/internal/middleware"
+	protopkg "github.com/stormhead-org/backend/internal/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// Restore undoes a deletion within orm.DELETED_CONTENT_RETENTION. Authors
+// can undo their own deletions, a moderator's only another moderator. The
+// community must not be deleted itself.
+func (s *PostServer) Restore(ctx context.Context, request *protopkg.RestorePostRequest) (*protopkg.RestorePostResponse, error) {
+	post, err := s.db.SelectDeletedPostByID(request.PostId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "deleted post not found")
+		}
+		s.log.Error("error selecting deleted post by id", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	userID, err := middleware.GetUserUUID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get user from context")
+	}
+
+	if post.AuthorID != userID || post.DeletedBy == nil || *post.DeletedBy != userID {
+		allowed, err := s.db.SelectUserHasPlatformPermission(userID.String(), PERMISSION_DELETE_ANY_POST)
+		if err != nil {
+			s.log.Error("error checking platform permission", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "database error")
+		}
+		if !allowed {
+			return nil, status.Errorf(codes.PermissionDenied, "not allowed to restore")
+		}
+	}
+
+	_, err = s.db.SelectCommunityByID(post.CommunityID.String())
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Errorf(codes.FailedPrecondition, "community is deleted")
+		}
+		s.log.Error("error selecting community by id", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	if err := s.db.RestorePost(post); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Errorf(codes.FailedPrecondition, "retention period is over")
+		}
+		s.log.Error("error restoring post", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "could not restore post")
+	}
+	return &protopkg.RestorePostResponse{}, nil
+}
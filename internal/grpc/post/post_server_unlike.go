@@ -3,7 +3,6 @@ package postgrpc
 import (
 	"context"
 
-	"github.com/stormhead-org/backend/internal/lib"
 	"github.com/stormhead-org/backend/internal/middleware"
 	protopkg "github.com/stormhead-org/backend/internal/proto"
 	"go.uber.org/zap"
@@ -27,43 +26,12 @@ func (s *PostServer) Unlike(ctx context.Context, request *protopkg.UnlikePostReq
 		return nil, status.Errorf(codes.Internal, "database error")
 	}
 
-	postLike, err := s.db.SelectPostLikeByID(request.PostId, userID.String())
+	// Idempotency: unliking a post that is not liked succeeds
+	_, err = s.db.DeletePostLike(post.ID, userID)
 	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			// Idempotency: not liked, return success
-			return &protopkg.UnlikePostResponse{}, nil
-		}
-		s.log.Error("error checking post like", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "database error")
-	}
-
-	if err := s.db.DeletePostLike(postLike); err != nil {
-		s.log.Error("error deleting post like", zap.Error(err))
+		s.log.Error("error unliking post", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "could not unlike post")
 	}
 
-	// This should be a transaction
-	if post.LikeCount > 0 {
-		post.LikeCount--
-	}
-	if err := s.db.UpdatePost(post); err != nil {
-		s.log.Error("error updating post like count", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "could not update like count")
-	}
-
-	author, err := s.db.SelectUserByID(post.AuthorID.String())
-	if err != nil {
-		s.log.Error("could not find author to update reputation", zap.Error(err))
-		return &protopkg.UnlikePostResponse{}, nil
-	}
-	reputation, err := lib.CalculateUserReputation(s.db, author)
-	if err != nil {
-		s.log.Error("failed to calculate author reputation", zap.Error(err))
-		return &protopkg.UnlikePostResponse{}, nil
-	}
-	author.Reputation = int64(reputation)
-	if err := s.db.UpdateUser(author); err != nil {
-		s.log.Error("failed to update author reputation", zap.Error(err))
-	}
 	return &protopkg.UnlikePostResponse{}, nil
 }
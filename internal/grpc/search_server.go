@@ -13,11 +13,11 @@ import (
 type SearchServer struct {
 	protopkg.UnimplementedSearchServiceServer
 	log      *zap.Logger
-	database *ormpkg.PostgresClient
+	database ormpkg.Store
 	broker   *eventpkg.KafkaClient
 }
 
-func NewSearchServer(log *zap.Logger, database *ormpkg.PostgresClient, broker *eventpkg.KafkaClient) *SearchServer {
+func NewSearchServer(log *zap.Logger, database ormpkg.Store, broker *eventpkg.KafkaClient) *SearchServer {
 	return &SearchServer{
 		log:      log,
 		database: database,
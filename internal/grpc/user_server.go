@@ -3,6 +3,10 @@ package grpc
 import (
 	"context"
 	"encoding/json" // Добавлен импорт json
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,23 +21,39 @@ import (
 	protopkg "github.com/stormhead-org/backend/internal/proto"
 )
 
+const USER_SLUG_CHANGE_COOLDOWN = 30 * 24 * time.Hour
+const USER_DATA_EXPORT_INTERVAL = 24 * time.Hour
+
+// UserDatabase is the part of the store UserServer uses.
+type UserDatabase interface {
+	ormpkg.UserStore
+	ormpkg.CommunityStore
+	ormpkg.PostStore
+	ormpkg.CommentStore
+	ormpkg.FollowerStore
+	ormpkg.ReputationStore
+	ormpkg.DataExportStore
+}
+
 type UserServer struct {
 	protopkg.UnimplementedUserServiceServer
 	log      *zap.Logger
-	database *ormpkg.PostgresClient
+	database UserDatabase
+	replica  UserDatabase // lists, which may lag behind database
 	broker   *eventpkg.KafkaClient
 }
 
-func NewUserServer(log *zap.Logger, database *ormpkg.PostgresClient, broker *eventpkg.KafkaClient) *UserServer {
+func NewUserServer(log *zap.Logger, database UserDatabase, replica UserDatabase, broker *eventpkg.KafkaClient) *UserServer {
 	return &UserServer{
 		log:      log,
 		database: database,
+		replica:  replica,
 		broker:   broker,
 	}
 }
 
 func (s *UserServer) Get(ctx context.Context, request *protopkg.GetUserRequest) (*protopkg.GetUserResponse, error) {
-	user, err := s.database.SelectUserByID(request.UserId)
+	user, err := s.selectUserByIDOrSlug(request.UserId)
 	if err != nil {
 		s.log.Error("failed to select user by id", zap.Error(err), zap.String("user_id", request.UserId))
 		return nil, lib.HandleError(err)
@@ -42,6 +62,7 @@ func (s *UserServer) Get(ctx context.Context, request *protopkg.GetUserRequest)
 	return &protopkg.GetUserResponse{
 		User: &protopkg.UserProfile{
 			Id:          user.ID.String(),
+			Slug:        user.Slug,
 			Name:        user.Name,
 			Description: user.Description,
 			CreatedAt:   timestamppb.New(user.CreatedAt),
@@ -65,6 +86,7 @@ func (s *UserServer) GetCurrent(ctx context.Context, request *protopkg.GetCurren
 	return &protopkg.GetCurrentUserResponse{
 		User: &protopkg.CurrentUserProfile{
 			Id:          user.ID.String(),
+			Slug:        user.Slug,
 			Name:        user.Name,
 			Description: user.Description,
 			CreatedAt:   timestamppb.New(user.CreatedAt),
@@ -87,6 +109,15 @@ func (s *UserServer) UpdateProfile(ctx context.Context, request *protopkg.Update
 
 	user.Description = request.Description
 
+	if request.Name != nil {
+		name := lib.NormalizeUserName(request.GetName())
+		err = lib.ValidateUserName(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+		}
+		user.Name = name
+	}
+
 	err = s.database.UpdateUser(user)
 	if err != nil {
 		s.log.Error("internal error", zap.Error(err))
@@ -96,6 +127,73 @@ func (s *UserServer) UpdateProfile(ctx context.Context, request *protopkg.Update
 	return &protopkg.UpdateProfileResponse{}, nil
 }
 
+func (s *UserServer) ChangeSlug(ctx context.Context, request *protopkg.ChangeSlugRequest) (*protopkg.ChangeSlugResponse, error) {
+	userID, err := middlewarepkg.GetUserID(ctx)
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "")
+	}
+
+	slug := lib.NormalizeUserSlug(request.Slug)
+	err = ValidateUserSlug(slug)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	user, err := s.database.SelectUserByID(userID)
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "")
+	}
+
+	if user.Slug == slug {
+		return nil, status.Errorf(codes.InvalidArgument, "slug is unchanged")
+	}
+
+	if user.SlugChangedAt != nil {
+		nextChangeAt := user.SlugChangedAt.Add(USER_SLUG_CHANGE_COOLDOWN)
+		if time.Now().Before(nextChangeAt) {
+			return nil, status.Errorf(codes.FailedPrecondition, "slug can be changed again after %s", nextChangeAt.Format(time.RFC3339))
+		}
+	}
+
+	// The slug is checked against existing and former slugs as it is written
+	err = s.database.UpdateUserSlug(user, slug, lib.UserSlugSkeleton(slug))
+	if errors.Is(err, ormpkg.ErrUserSlugTaken) {
+		return nil, status.Errorf(codes.AlreadyExists, "slug already exist")
+	}
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "")
+	}
+
+	return &protopkg.ChangeSlugResponse{
+		Slug:         slug,
+		NextChangeAt: timestamppb.New(time.Now().Add(USER_SLUG_CHANGE_COOLDOWN)),
+	}, nil
+}
+
+// selectUserByIDOrSlug resolves a user by id, current slug or former slug.
+func (s *UserServer) selectUserByIDOrSlug(idOrSlug string) (*ormpkg.User, error) {
+	_, err := uuid.Parse(idOrSlug)
+	if err == nil {
+		return s.database.SelectUserByID(idOrSlug)
+	}
+
+	slug := lib.NormalizeUserSlug(idOrSlug)
+	user, err := s.database.SelectUserBySlug(slug)
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return user, err
+	}
+
+	redirect, err := s.database.SelectUserSlugRedirect(slug)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.database.SelectUserByID(redirect.UserID.String())
+}
+
 func (s *UserServer) GetStatistics(ctx context.Context, request *protopkg.GetUserStatisticsRequest) (*protopkg.GetUserStatisticsResponse, error) {
 	user, err := s.database.SelectUserByID(request.UserId)
 	if err != nil {
@@ -103,39 +201,98 @@ func (s *UserServer) GetStatistics(ctx context.Context, request *protopkg.GetUse
 		return nil, lib.HandleError(err)
 	}
 
-	reputation, err := lib.CalculateUserReputation(s.database, user)
+	karma, err := s.database.SelectCommunityKarmaByUserID(user.ID.String())
 	if err != nil {
-		s.log.Error("failed to calculate user reputation", zap.Error(err), zap.String("user_id", request.UserId))
-		return nil, status.Errorf(codes.Internal, "failed to calculate reputation")
+		s.log.Error("failed to select community karma", zap.Error(err), zap.String("user_id", request.UserId))
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	communityKarma := make([]*protopkg.CommunityKarma, len(karma))
+	for i, k := range karma {
+		communityKarma[i] = &protopkg.CommunityKarma{
+			CommunityId:   k.CommunityID.String(),
+			CommunityName: k.Community.Name,
+			Karma:         k.Karma,
+		}
 	}
 
 	return &protopkg.GetUserStatisticsResponse{
 		Statistics: &protopkg.UserStatistics{
-			Reputation: reputation,
+			Reputation:     float64(user.Reputation),
+			CommunityKarma: communityKarma,
 		},
 	}, nil
 }
 
-func (s *UserServer) ListCommunities(ctx context.Context, request *protopkg.ListUserCommunitiesRequest) (*protopkg.ListUserCommunitiesResponse, error) {
+func (s *UserServer) ListReputationHistory(ctx context.Context, request *protopkg.ListReputationHistoryRequest) (*protopkg.ListReputationHistoryResponse, error) {
+	_, err := uuid.Parse(request.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id")
+	}
+	if request.CommunityId != "" {
+		_, err = uuid.Parse(request.CommunityId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid community_id")
+		}
+	}
+
 	limit := int(request.Limit)
 	if limit <= 0 || limit > 50 {
 		limit = 50
 	}
 
-	communities, err := s.database.SelectCommunitiesWithPagination(request.UserId, limit+1, request.Cursor)
+	events, page, err := s.replica.SelectReputationEventsWithPagination(request.UserId, request.CommunityId, limit, request.Cursor)
 	if err != nil {
+		if err == lib.ErrInvalidCursor {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "")
 	}
 
-	hasMore := len(communities) > limit
-	if hasMore {
-		communities = communities[:limit]
+	result := make([]*protopkg.ReputationEvent, len(events))
+	for i, event := range events {
+		communityID := ""
+		if event.CommunityID != nil {
+			communityID = event.CommunityID.String()
+		}
+		sourceID := ""
+		if event.SourceID != nil {
+			sourceID = event.SourceID.String()
+		}
+
+		result[i] = &protopkg.ReputationEvent{
+			Id:          event.ID.String(),
+			Kind:        event.Kind,
+			CommunityId: communityID,
+			SourceId:    sourceID,
+			Delta:       event.UserDelta,
+			Reason:      event.Reason,
+			CreatedAt:   timestamppb.New(event.CreatedAt),
+		}
+	}
+
+	return &protopkg.ListReputationHistoryResponse{
+		Events:     result,
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+		HasMore:    page.HasMore,
+	}, nil
+}
+
+func (s *UserServer) ListCommunities(ctx context.Context, request *protopkg.ListUserCommunitiesRequest) (*protopkg.ListUserCommunitiesResponse, error) {
+	limit := int(request.Limit)
+	if limit <= 0 || limit > 50 {
+		limit = 50
 	}
 
-	var nextCursor string
-	if hasMore && len(communities) > 0 {
-		nextCursor = communities[len(communities)-1].ID.String()
+	communities, page, err := s.replica.SelectCommunitiesWithPagination(request.UserId, limit, request.Cursor)
+	if err != nil {
+		if err == lib.ErrInvalidCursor {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "")
 	}
 
 	result := make([]*protopkg.Community, len(communities))
@@ -153,8 +310,9 @@ func (s *UserServer) ListCommunities(ctx context.Context, request *protopkg.List
 
 	return &protopkg.ListUserCommunitiesResponse{
 		Communities: result,
-		NextCursor:  nextCursor,
-		HasMore:     hasMore,
+		NextCursor:  page.NextCursor,
+		PrevCursor:  page.PrevCursor,
+		HasMore:     page.HasMore,
 	}, nil
 }
 
@@ -164,22 +322,24 @@ func (s *UserServer) ListPosts(ctx context.Context, request *protopkg.ListUserPo
 		limit = 50
 	}
 
-	posts, err := s.database.SelectPostsWithPagination(request.UserId, limit+1, request.Cursor)
+	sort := ormpkg.POST_SORT_NEWEST
+	switch request.Sort {
+	case protopkg.PostSort_POST_SORT_UNSPECIFIED, protopkg.PostSort_POST_SORT_NEWEST:
+	case protopkg.PostSort_POST_SORT_TOP:
+		sort = ormpkg.POST_SORT_TOP
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort")
+	}
+
+	posts, page, err := s.replica.SelectPostsWithPagination(request.UserId, sort, limit, request.Cursor)
 	if err != nil {
+		if err == lib.ErrInvalidCursor {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "")
 	}
 
-	hasMore := len(posts) > limit
-	if hasMore {
-		posts = posts[:limit]
-	}
-
-	var nextCursor string
-	if hasMore && len(posts) > 0 {
-		nextCursor = posts[len(posts)-1].ID.String()
-	}
-
 	result := make([]*protopkg.Post, len(posts))
 	for i, post := range posts {
 		var structContent *structpb.Struct
@@ -221,8 +381,9 @@ func (s *UserServer) ListPosts(ctx context.Context, request *protopkg.ListUserPo
 
 	return &protopkg.ListUserPostsResponse{
 		Posts:      result,
-		NextCursor: nextCursor,
-		HasMore:    hasMore,
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+		HasMore:    page.HasMore,
 	}, nil
 }
 
@@ -232,22 +393,15 @@ func (s *UserServer) ListComments(ctx context.Context, request *protopkg.ListUse
 		limit = 50
 	}
 
-	comments, err := s.database.SelectCommentsWithPagination("", request.UserId, limit+1, request.Cursor)
+	comments, page, err := s.replica.SelectCommentsWithPagination("", request.UserId, limit, request.Cursor)
 	if err != nil {
+		if err == lib.ErrInvalidCursor {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "")
 	}
 
-	hasMore := len(comments) > limit
-	if hasMore {
-		comments = comments[:limit]
-	}
-
-	var nextCursor string
-	if hasMore && len(comments) > 0 {
-		nextCursor = comments[len(comments)-1].ID.String()
-	}
-
 	result := make([]*protopkg.CommentWithPostInfo, len(comments))
 	for i, comment := range comments {
 		parentCommentID := ""
@@ -273,8 +427,9 @@ func (s *UserServer) ListComments(ctx context.Context, request *protopkg.ListUse
 
 	return &protopkg.ListUserCommentsResponse{
 		Comments:   result,
-		NextCursor: nextCursor,
-		HasMore:    hasMore,
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+		HasMore:    page.HasMore,
 	}, nil
 }
 
@@ -300,28 +455,12 @@ func (s *UserServer) Follow(ctx context.Context, request *protopkg.FollowRequest
 		return nil, status.Errorf(codes.InvalidArgument, "following themself")
 	}
 
-	_, err = s.database.SelectFollowerByID(
-		user.ID.String(),
-		userID.String(),
-	)
-	if err == nil {
-		s.log.Debug(
-			"user already followed",
-			zap.String("user_id", user.ID.String()),
-			zap.String("follower_id", userID.String()),
-		)
-		return nil, status.Errorf(codes.InvalidArgument, "already followed")
-	} else if err != gorm.ErrRecordNotFound {
-		s.log.Error("internal error", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "")
-	}
-
-	follower := &ormpkg.Follower{
+	// Idempotency: following an already followed user succeeds and changes
+	// nothing
+	_, err = s.database.InsertFollower(&ormpkg.Follower{
 		FollowerID: user.ID,
 		UserID:     userID,
-	}
-
-	err = s.database.InsertFollower(follower)
+	})
 	if err != nil {
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "")
@@ -347,24 +486,9 @@ func (s *UserServer) Unfollow(ctx context.Context, request *protopkg.UnfollowReq
 		return nil, status.Errorf(codes.Internal, "")
 	}
 
-	follower, err := s.database.SelectFollowerByID(
-		user.ID.String(),
-		userID.String(),
-	)
-	if err == gorm.ErrRecordNotFound {
-		s.log.Debug(
-			"user not followed",
-			zap.String("user_id", user.ID.String()),
-			zap.String("follower_id", userID.String()),
-		)
-		return nil, status.Errorf(codes.InvalidArgument, "not followed")
-	}
-	if err != nil {
-		s.log.Error("internal error", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "")
-	}
-
-	err = s.database.DeleteFollower(follower)
+	// Idempotency: unfollowing a user that is not followed succeeds. Removes
+	// the row Follow stores
+	_, err = s.database.DeleteFollower(userID, user.ID)
 	if err != nil {
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "")
@@ -379,22 +503,15 @@ func (s *UserServer) ListFollowers(ctx context.Context, request *protopkg.ListFo
 		limit = 50
 	}
 
-	followers, err := s.database.SelectFollowersWithPagination(request.UserId, "", limit+1, request.Cursor)
+	followers, page, err := s.replica.SelectFollowersWithPagination(request.UserId, "", limit, request.Cursor)
 	if err != nil {
+		if err == lib.ErrInvalidCursor {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "")
 	}
 
-	hasMore := len(followers) > limit
-	if hasMore {
-		followers = followers[:limit]
-	}
-
-	var nextCursor string
-	if hasMore && len(followers) > 0 {
-		nextCursor = followers[len(followers)-1].UserID.String()
-	}
-
 	result := make([]*protopkg.UserProfile, len(followers))
 	for i, follower := range followers {
 		result[i] = &protopkg.UserProfile{
@@ -407,8 +524,9 @@ func (s *UserServer) ListFollowers(ctx context.Context, request *protopkg.ListFo
 
 	return &protopkg.ListFollowersResponse{
 		Users:      result,
-		NextCursor: nextCursor,
-		HasMore:    hasMore,
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+		HasMore:    page.HasMore,
 	}, nil
 }
 
@@ -418,22 +536,15 @@ func (s *UserServer) ListFollowing(ctx context.Context, request *protopkg.ListFo
 		limit = 50
 	}
 
-	followers, err := s.database.SelectFollowersWithPagination("", request.UserId, limit+1, request.Cursor)
+	followers, page, err := s.replica.SelectFollowersWithPagination("", request.UserId, limit, request.Cursor)
 	if err != nil {
+		if err == lib.ErrInvalidCursor {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
 		s.log.Error("internal error", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "")
 	}
 
-	hasMore := len(followers) > limit
-	if hasMore {
-		followers = followers[:limit]
-	}
-
-	var nextCursor string
-	if hasMore && len(followers) > 0 {
-		nextCursor = followers[len(followers)-1].UserID.String()
-	}
-
 	result := make([]*protopkg.UserProfile, len(followers))
 	for i, follower := range followers {
 		result[i] = &protopkg.UserProfile{
@@ -446,11 +557,90 @@ func (s *UserServer) ListFollowing(ctx context.Context, request *protopkg.ListFo
 
 	return &protopkg.ListFollowingResponse{
 		Users:      result,
-		NextCursor: nextCursor,
-		HasMore:    hasMore,
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+		HasMore:    page.HasMore,
 	}, nil
 }
 
 func (s *UserServer) Heartbeat(ctx context.Context, request *protopkg.HeartbeatRequest) (*protopkg.HeartbeatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
 }
+
+func (s *UserServer) RequestDataExport(ctx context.Context, request *protopkg.RequestDataExportRequest) (*protopkg.RequestDataExportResponse, error) {
+	userID, err := middlewarepkg.GetUserID(ctx)
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "")
+	}
+
+	export := ormpkg.DataExport{
+		UserID: uuid.MustParse(userID),
+	}
+	err = s.database.InsertDataExport(&export, USER_DATA_EXPORT_INTERVAL)
+	if errors.Is(err, ormpkg.ErrDataExportTooSoon) {
+		return nil, status.Errorf(codes.ResourceExhausted, "data export can be requested once per 24 hours")
+	}
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "")
+	}
+
+	err = s.broker.WriteMessage(
+		ctx,
+		eventpkg.USER_REQUEST_DATA_EXPORT,
+		eventpkg.UserRequestDataExportMessage{
+			ID:       userID,
+			ExportID: export.ID.String(),
+		},
+	)
+	if err != nil {
+		s.log.Error("failed to write data export event to broker", zap.Error(err), zap.String("user_id", userID))
+		failErr := s.database.FailDataExport(export.ID.String())
+		if failErr != nil {
+			s.log.Error("internal error", zap.Error(failErr))
+		}
+		return nil, status.Errorf(codes.Internal, "")
+	}
+
+	return &protopkg.RequestDataExportResponse{
+		Export: dataExportToProto(&export),
+	}, nil
+}
+
+func (s *UserServer) GetDataExport(ctx context.Context, request *protopkg.GetDataExportRequest) (*protopkg.GetDataExportResponse, error) {
+	userID, err := middlewarepkg.GetUserID(ctx)
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "")
+	}
+
+	export, err := s.database.SelectLatestDataExportByUserID(userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no data export requested")
+	}
+	if err != nil {
+		s.log.Error("internal error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "")
+	}
+
+	return &protopkg.GetDataExportResponse{
+		Export: dataExportToProto(export),
+	}, nil
+}
+
+func dataExportToProto(export *ormpkg.DataExport) *protopkg.DataExport {
+	result := &protopkg.DataExport{
+		Id:        export.ID.String(),
+		Status:    export.Status,
+		Progress:  int32(export.Progress),
+		CreatedAt: timestamppb.New(export.CreatedAt),
+	}
+	if export.CompletedAt != nil {
+		result.CompletedAt = timestamppb.New(*export.CompletedAt)
+	}
+	if export.ExpiresAt != nil {
+		result.ExpiresAt = timestamppb.New(*export.ExpiresAt)
+	}
+	return result
+}
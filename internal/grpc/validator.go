@@ -2,38 +2,27 @@ package grpc
 
 import (
 	"errors"
-	"regexp"
+
+	"github.com/stormhead-org/backend/internal/lib"
 )
 
 var ErrInvalid = errors.New("invalid")
 
-func ValidateUserSlug(name string) error {
-	if len(name) < 5 {
-		return ErrInvalid
-	}
-
-	return nil
+// ValidateUserSlug normalizes and checks a user slug, see lib.ValidateUserSlug.
+func ValidateUserSlug(slug string) error {
+	return lib.ValidateUserSlug(lib.NormalizeUserSlug(slug))
 }
 
+// ValidateUserName normalizes and checks a display name, see lib.ValidateUserName.
 func ValidateUserName(name string) error {
-	if len(name) < 5 {
-		return ErrInvalid
-	}
-
-	return nil
+	return lib.ValidateUserName(lib.NormalizeUserName(name))
 }
 
+// ValidateUserEmail checks the address format, see lib.NormalizeEmail. The
+// domain policy needs the database and is applied by AuthorizationServer.
 func ValidateUserEmail(email string) error {
-	regex, err := regexp.Compile(`[a-z\-\_\.]+@[a-z\-\_\.]+`)
-	if err != nil {
-		return ErrInvalid
-	}
-
-	if !regex.MatchString(email) {
-		return ErrInvalid
-	}
-
-	return nil
+	_, err := lib.NormalizeEmail(email)
+	return err
 }
 
 func ValidateCommunitySlug(slug string) error {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 var ErrTokenInvalid = errors.New("token invalid")
@@ -45,6 +46,7 @@ func (this *JWT) GenerateRefreshToken(sessionID string) (string, error) {
 		jwt.SigningMethodHS256,
 		jwt.MapClaims{
 			"session_id": sessionID,
+			"token_id":   uuid.New().String(), // refresh tokens are rotated, keep every one distinct
 			"expiration": time.Now().Add(REFRESH_TOKEN_EXPIRATION).Unix(),
 			"kind":       KIND_REFRESH,
 		},
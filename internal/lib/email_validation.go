@@ -0,0 +1,158 @@
+package lib
+
+import (
+	_ "embed"
+	"errors"
+	"net/mail"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+var ErrEmailInvalid = errors.New("email is invalid")
+var ErrEmailDomainNotAllowed = errors.New("email domain is not allowed")
+var ErrEmailDisposable = errors.New("disposable email addresses are not allowed")
+
+const EMAIL_MAX_LENGTH = 254
+const EMAIL_LOCAL_PART_MAX_LENGTH = 64
+
+// disposableEmailDomains is a bundled list of throwaway mailbox providers,
+// one domain per line. Subdomains of listed domains are disposable too.
+//
+//go:embed disposable_email_domains.txt
+var disposableEmailDomainsFile string
+
+var disposableEmailDomains = parseDomainList(disposableEmailDomainsFile)
+
+// NormalizeEmail parses a bare address per RFC 5322 and returns it in the
+// form stored in user.email: lowercased, with the domain converted to its
+// ASCII (punycode) form. Display names ("Name <a@b.c>"), quoted local
+// parts, domain literals and domains without a dot are rejected. Plus tags
+// and dots in the local part are kept for every provider: only some
+// providers ignore them, and the address is where mail is sent.
+func NormalizeEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	if email == "" || strings.ContainsAny(email, "<>") {
+		return "", ErrEmailInvalid
+	}
+
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Name != "" {
+		return "", ErrEmailInvalid
+	}
+
+	at := strings.LastIndex(address.Address, "@")
+	if at <= 0 {
+		return "", ErrEmailInvalid
+	}
+	local := address.Address[:at]
+	if !isDotAtom(local) {
+		return "", ErrEmailInvalid
+	}
+	domain, err := NormalizeEmailDomain(address.Address[at+1:])
+	if err != nil {
+		return "", err
+	}
+
+	normalized := strings.ToLower(local) + "@" + domain
+	if len(local) > EMAIL_LOCAL_PART_MAX_LENGTH || len(normalized) > EMAIL_MAX_LENGTH {
+		return "", ErrEmailInvalid
+	}
+
+	return normalized, nil
+}
+
+// NormalizeEmailDomain converts a domain to lowercase ASCII (punycode) and
+// checks that it is a valid host name with at least two labels.
+func NormalizeEmailDomain(domain string) (string, error) {
+	domain, err := idna.Lookup.ToASCII(strings.TrimSuffix(strings.TrimSpace(domain), "."))
+	if err != nil || !strings.Contains(domain, ".") {
+		return "", ErrEmailInvalid
+	}
+	return strings.ToLower(domain), nil
+}
+
+// CheckEmailDomain applies the administrator domain rules and the bundled
+// disposable list to a normalized domain. The most specific rule matching
+// the domain or a parent domain wins, so "deny example.com" can be combined
+// with "allow mail.example.com". Once any allow rule exists, domains no rule
+// matches are rejected. An allow rule also overrides the disposable list.
+func CheckEmailDomain(domain string, allowed map[string]bool, denied map[string]bool) error {
+	for _, candidate := range DomainAndParents(domain) {
+		if denied[candidate] {
+			return ErrEmailDomainNotAllowed
+		}
+		if allowed[candidate] {
+			return nil
+		}
+	}
+
+	if len(allowed) > 0 {
+		return ErrEmailDomainNotAllowed
+	}
+	if IsDisposableEmailDomain(domain) {
+		return ErrEmailDisposable
+	}
+
+	return nil
+}
+
+// EmailDomain returns the domain of an address produced by NormalizeEmail.
+func EmailDomain(email string) string {
+	return email[strings.LastIndex(email, "@")+1:]
+}
+
+// IsDisposableEmailDomain reports whether the ASCII domain, or one of its
+// parent domains, is on the bundled disposable list.
+func IsDisposableEmailDomain(domain string) bool {
+	for _, candidate := range DomainAndParents(domain) {
+		if disposableEmailDomains[candidate] {
+			return true
+		}
+	}
+	return false
+}
+
+// DomainAndParents returns the domain followed by each parent domain down
+// to the second level, e.g. "a.b.example.com", "b.example.com",
+// "example.com".
+func DomainAndParents(domain string) []string {
+	var domains []string
+	for {
+		domains = append(domains, domain)
+		dot := strings.Index(domain, ".")
+		if dot < 0 || !strings.Contains(domain[dot+1:], ".") {
+			return domains
+		}
+		domain = domain[dot+1:]
+	}
+}
+
+// isDotAtom reports whether the local part needs no quoting. Non-ASCII
+// characters are allowed as in RFC 6532.
+func isDotAtom(local string) bool {
+	if local == "" || local[0] == '.' || local[len(local)-1] == '.' || strings.Contains(local, "..") {
+		return false
+	}
+	for _, r := range local {
+		if r > 127 || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			continue
+		}
+		if !strings.ContainsRune("!#$%&'*+-/=?^_`{|}~.", r) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseDomainList(list string) map[string]bool {
+	domains := map[string]bool{}
+	for _, line := range strings.Split(list, "\n") {
+		line = strings.ToLower(strings.TrimSpace(line))
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		domains[line] = true
+	}
+	return domains
+}
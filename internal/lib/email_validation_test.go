@@ -0,0 +1,199 @@
+package lib
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeEmail(t *testing.T) {
+	tests := []struct {
+		name  string
+		email string
+		want  string
+	}{
+		// Case folding
+		{"lower case", "user@example.com", "user@example.com"},
+		{"upper case", "USER@EXAMPLE.COM", "user@example.com"},
+		{"mixed case", "John.Doe@Example.Org", "john.doe@example.org"},
+		{"digits", "User123@mail2.example.com", "user123@mail2.example.com"},
+		{"surrounding spaces", "  user@example.com\t", "user@example.com"},
+
+		// Plus tags and dots are kept for every provider
+		{"gmail plus tag", "John.Doe+News@Gmail.com", "john.doe+news@gmail.com"},
+		{"gmail dots", "j.o.h.n@gmail.com", "j.o.h.n@gmail.com"},
+		{"googlemail", "john.doe+x@googlemail.com", "john.doe+x@googlemail.com"},
+		{"outlook plus tag", "john+tag@outlook.com", "john+tag@outlook.com"},
+		{"custom domain plus tag", "john+tag@example.com", "john+tag@example.com"},
+		{"special characters", "a!#$%&'*+-/=?^_`{|}~b@example.com", "a!#$%&'*+-/=?^_`{|}~b@example.com"},
+
+		// Internationalized domains are stored in ASCII
+		{"cyrillic domain", "user@пример.рф", "user@xn--e1afmkfd.xn--p1ai"},
+		{"upper case cyrillic domain", "User@ПРИМЕР.РФ", "user@xn--e1afmkfd.xn--p1ai"},
+		{"umlaut domain", "user@bücher.example", "user@xn--bcher-kva.example"},
+		{"punycode domain", "user@XN--E1AFMKFD.XN--P1AI", "user@xn--e1afmkfd.xn--p1ai"},
+		{"unicode local part", "пользователь@example.com", "пользователь@example.com"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := NormalizeEmail(test.email)
+			if err != nil {
+				t.Fatalf("%q: %v", test.email, err)
+			}
+			if got != test.want {
+				t.Fatalf("%q: got %q, want %q", test.email, got, test.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeEmailInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		email string
+	}{
+		{"empty", ""},
+		{"spaces", "   "},
+		{"no at", "user.example.com"},
+		{"no local part", "@example.com"},
+		{"no domain", "user@"},
+		{"two ats", "user@host@example.com"},
+		{"display name", "User <user@example.com>"},
+		{"angle brackets", "<user@example.com>"},
+		{"quoted local part", `"user name"@example.com`},
+		{"domain literal", "user@[192.0.2.1]"},
+		{"domain without dot", "user@localhost"},
+		{"trailing dot in domain", "user@example.com."},
+		{"leading dot", ".user@example.com"},
+		{"trailing dot", "user.@example.com"},
+		{"double dot", "us..er@example.com"},
+		{"space in local part", "us er@example.com"},
+		{"comma in local part", "us,er@example.com"},
+		{"invalid domain label", "user@exa_mple.com"},
+		{"domain starting with hyphen", "user@-example.com"},
+		{"local part too long", strings.Repeat("a", EMAIL_LOCAL_PART_MAX_LENGTH+1) + "@example.com"},
+		{"address too long", strings.Repeat("u", 10) + "@" + strings.Repeat("a", 60) + "." + strings.Repeat("b", 60) + "." + strings.Repeat("c", 60) + "." + strings.Repeat("d", 60) + ".com"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := NormalizeEmail(test.email)
+			if !errors.Is(err, ErrEmailInvalid) {
+				t.Fatalf("%q: got (%q, %v), want %v", test.email, got, err, ErrEmailInvalid)
+			}
+		})
+	}
+}
+
+func TestNormalizeEmailDomain(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   string
+		err    error
+	}{
+		{"Example.COM", "example.com", nil},
+		{" example.com. ", "example.com", nil},
+		{"пример.рф", "xn--e1afmkfd.xn--p1ai", nil},
+		{"mail.bücher.example", "mail.xn--bcher-kva.example", nil},
+		{"localhost", "", ErrEmailInvalid},
+		{"", "", ErrEmailInvalid},
+		{"exa mple.com", "", ErrEmailInvalid},
+	}
+
+	for _, test := range tests {
+		got, err := NormalizeEmailDomain(test.domain)
+		if !errors.Is(err, test.err) || got != test.want {
+			t.Fatalf("%q: got (%q, %v), want (%q, %v)", test.domain, got, err, test.want, test.err)
+		}
+	}
+}
+
+func TestCheckEmailDomain(t *testing.T) {
+	tests := []struct {
+		name    string
+		domain  string
+		allowed []string
+		denied  []string
+		want    error
+	}{
+		{"no rules", "example.com", nil, nil, nil},
+		{"disposable", "mailinator.com", nil, nil, ErrEmailDisposable},
+		{"disposable subdomain", "inbox.mailinator.com", nil, nil, ErrEmailDisposable},
+		{"disposable lookalike", "notmailinator.com", nil, nil, nil},
+		{"denied", "example.com", nil, []string{"example.com"}, ErrEmailDomainNotAllowed},
+		{"denied parent", "mail.example.com", nil, []string{"example.com"}, ErrEmailDomainNotAllowed},
+		{"denied child only", "example.com", nil, []string{"mail.example.com"}, nil},
+		{"allowed", "example.com", []string{"example.com"}, nil, nil},
+		{"allowed parent", "mail.example.com", []string{"example.com"}, nil, nil},
+		{"not on the allow list", "other.com", []string{"example.com"}, nil, ErrEmailDomainNotAllowed},
+		{"allow overrides disposable", "mailinator.com", []string{"mailinator.com"}, nil, nil},
+		{"allow elsewhere keeps disposable out", "mailinator.com", []string{"example.com"}, nil, ErrEmailDomainNotAllowed},
+		{"more specific allow wins", "mail.example.com", []string{"mail.example.com"}, []string{"example.com"}, nil},
+		{"more specific deny wins", "mail.example.com", []string{"example.com"}, []string{"mail.example.com"}, ErrEmailDomainNotAllowed},
+		{"deny beside a specific allow", "www.example.com", []string{"mail.example.com"}, []string{"example.com"}, ErrEmailDomainNotAllowed},
+		{"punycode rule", "xn--e1afmkfd.xn--p1ai", nil, []string{"xn--e1afmkfd.xn--p1ai"}, ErrEmailDomainNotAllowed},
+	}
+
+	set := func(domains []string) map[string]bool {
+		result := map[string]bool{}
+		for _, domain := range domains {
+			result[domain] = true
+		}
+		return result
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckEmailDomain(test.domain, set(test.allowed), set(test.denied))
+			if !errors.Is(err, test.want) || (err == nil) != (test.want == nil) {
+				t.Fatalf("got %v, want %v", err, test.want)
+			}
+		})
+	}
+}
+
+func TestDisposableEmailDomains(t *testing.T) {
+	for _, domain := range []string{"mailinator.com", "guerrillamail.com", "10minutemail.com", "yopmail.com"} {
+		if !IsDisposableEmailDomain(domain) {
+			t.Fatalf("%s is not disposable", domain)
+		}
+	}
+	for _, domain := range []string{"gmail.com", "example.com", "com"} {
+		if IsDisposableEmailDomain(domain) {
+			t.Fatalf("%s is disposable", domain)
+		}
+	}
+
+	// The comment header of the list is not a domain
+	for domain := range disposableEmailDomains {
+		if strings.HasPrefix(domain, "#") || strings.TrimSpace(domain) != domain || domain != strings.ToLower(domain) {
+			t.Fatalf("malformed list entry %q", domain)
+		}
+	}
+}
+
+func TestDomainAndParents(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   []string
+	}{
+		{"example.com", []string{"example.com"}},
+		{"a.b.example.com", []string{"a.b.example.com", "b.example.com", "example.com"}},
+		{"localhost", []string{"localhost"}},
+	}
+
+	for _, test := range tests {
+		got := DomainAndParents(test.domain)
+		if !slices.Equal(got, test.want) {
+			t.Fatalf("%q: got %v, want %v", test.domain, got, test.want)
+		}
+	}
+}
+
+func TestEmailDomain(t *testing.T) {
+	if got := EmailDomain("john+tag@mail.example.com"); got != "mail.example.com" {
+		t.Fatalf("got %q", got)
+	}
+}
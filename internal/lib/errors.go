@@ -31,7 +31,7 @@ func HandleError(err error) error {
 	// 	message = validationErr.Error()
 	// }
 
-	return status.Errorf(code, message)
+	return status.Error(code, message)
 }
 
 // NotFoundError returns a gRPC NotFound error.
@@ -39,7 +39,7 @@ func NotFoundError(message string) error {
 	if message == "" {
 		message = "The requested resource was not found."
 	}
-	return status.Errorf(codes.NotFound, message)
+	return status.Error(codes.NotFound, message)
 }
 
 // InternalError returns a gRPC Internal error.
@@ -49,7 +49,7 @@ func InternalError() error {
 
 // InvalidArgumentError returns a gRPC InvalidArgument error.
 func InvalidArgumentError(message string) error {
-	return status.Errorf(codes.InvalidArgument, message)
+	return status.Error(codes.InvalidArgument, message)
 }
 
 // PermissionDeniedError returns a gRPC PermissionDenied error.
@@ -57,5 +57,5 @@ func PermissionDeniedError(message string) error {
 	if message == "" {
 		message = "You do not have permission to perform this action."
 	}
-	return status.Errorf(codes.PermissionDenied, message)
+	return status.Error(codes.PermissionDenied, message)
 }
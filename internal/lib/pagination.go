@@ -1,43 +1,392 @@
 package lib
 
 import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
-// Paginatable defines the interface for models that can be paginated.
-// The model must have an ID and a CreatedAt field.
-type Paginatable interface {
-	GetID() uuid.UUID
-	GetCreatedAt() time.Time
+// ErrInvalidCursor is returned for a cursor that was not issued for the
+// list it is used with, or was tampered with.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// SortKey is one column of the order a list is paged in. The last key of a
+// sort spec must be unique, usually id, so that no two rows tie. Columns
+// must not be NULL.
+type SortKey struct {
+	Column     string
+	Descending bool
+}
+
+// PageInfo describes where a page sits in its list. NextCursor and
+// PrevCursor are empty at the ends of the list. HasMore reports whether
+// there are more rows in the direction the page was read.
+type PageInfo struct {
+	NextCursor string
+	PrevCursor string
+	HasMore    bool
+}
+
+// Cursors are opaque to clients: the sort-key values of the row a page
+// starts after, signed with HMAC-SHA256 so that they can't be forged to
+// probe other rows. A cursor stays valid when its row is deleted.
+const (
+	cursorNext = "next"
+	cursorPrev = "prev"
+)
+
+type cursorPayload struct {
+	Direction string   `json:"d"`
+	Keys      []string `json:"k"`
+	Values    []string `json:"v"`
+}
+
+var cursorSecretMutex sync.RWMutex
+var cursorSecret []byte
+
+// SetCursorSecret sets the key cursors are signed with. It must be the same
+// on every instance serving a list, so the server sets it at startup; there
+// is no fallback key.
+func SetCursorSecret(secret string) error {
+	if secret == "" {
+		return errors.New("cursor secret is empty")
+	}
+
+	cursorSecretMutex.Lock()
+	defer cursorSecretMutex.Unlock()
+	cursorSecret = []byte(secret)
+	return nil
+}
+
+func signCursor(payload []byte) []byte {
+	cursorSecretMutex.RLock()
+	defer cursorSecretMutex.RUnlock()
+
+	mac := hmac.New(sha256.New, cursorSecret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// encodeCursor builds the cursor of the rows after (cursorNext) or before
+// (cursorPrev) the row with the given sort-key values.
+func encodeCursor(direction string, keys []SortKey, values []interface{}) (string, error) {
+	payload := cursorPayload{
+		Direction: direction,
+		Keys:      sortKeyNames(keys),
+		Values:    make([]string, len(values)),
+	}
+	for i, value := range values {
+		encoded, err := encodeCursorValue(value)
+		if err != nil {
+			return "", err
+		}
+		payload.Values[i] = encoded
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data) + "." +
+		base64.RawURLEncoding.EncodeToString(signCursor(data)), nil
+}
+
+// decodeCursor checks the signature of a cursor and that it was issued for
+// the sort spec, and returns its direction and sort-key values.
+func decodeCursor(cursor string, keys []SortKey) (string, []interface{}, error) {
+	encodedData, encodedSignature, ok := strings.Cut(cursor, ".")
+	if !ok {
+		return "", nil, ErrInvalidCursor
+	}
+	data, err := base64.RawURLEncoding.DecodeString(encodedData)
+	if err != nil {
+		return "", nil, ErrInvalidCursor
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, signCursor(data)) {
+		return "", nil, ErrInvalidCursor
+	}
+
+	var payload cursorPayload
+	err = json.Unmarshal(data, &payload)
+	if err != nil {
+		return "", nil, ErrInvalidCursor
+	}
+	if payload.Direction != cursorNext && payload.Direction != cursorPrev {
+		return "", nil, ErrInvalidCursor
+	}
+	if !slices.Equal(payload.Keys, sortKeyNames(keys)) || len(payload.Values) != len(keys) {
+		return "", nil, ErrInvalidCursor
+	}
+
+	values := make([]interface{}, len(payload.Values))
+	for i, encoded := range payload.Values {
+		values[i], err = decodeCursorValue(encoded)
+		if err != nil {
+			return "", nil, ErrInvalidCursor
+		}
+	}
+
+	return payload.Direction, values, nil
+}
+
+// sortKeyNames identifies a sort spec inside a cursor, e.g. "-created_at".
+func sortKeyNames(keys []SortKey) []string {
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = key.Column
+		if key.Descending {
+			names[i] = "-" + key.Column
+		}
+	}
+	return names
+}
+
+// Cursor values are tagged with their type, so that they are compared as
+// what they are and not as strings.
+func encodeCursorValue(value interface{}) (string, error) {
+	switch value := sortValue(value).(type) {
+	case time.Time:
+		return "t" + value.UTC().Format(time.RFC3339Nano), nil
+	case uuid.UUID:
+		return "u" + value.String(), nil
+	case string:
+		return "s" + value, nil
+	case int64:
+		return "i" + strconv.FormatInt(value, 10), nil
+	case float64:
+		return "f" + strconv.FormatFloat(value, 'g', -1, 64), nil
+	case bool:
+		return "b" + strconv.FormatBool(value), nil
+	}
+	return "", fmt.Errorf("unsupported sort key type %T", value)
+}
+
+func decodeCursorValue(encoded string) (interface{}, error) {
+	if encoded == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	tag, value := encoded[0], encoded[1:]
+	switch tag {
+	case 't':
+		return time.Parse(time.RFC3339Nano, value)
+	case 'u':
+		return uuid.Parse(value)
+	case 's':
+		return value, nil
+	case 'i':
+		return strconv.ParseInt(value, 10, 64)
+	case 'f':
+		return strconv.ParseFloat(value, 64)
+	case 'b':
+		return strconv.ParseBool(value)
+	}
+	return nil, ErrInvalidCursor
 }
 
-// Paginate applies cursor-based keyset pagination to a GORM query.
-// It orders by `created_at DESC` and `id DESC`.
-// The cursor is the ID of the last item from the previous page.
-func Paginate[T Paginatable](db *gorm.DB, query *gorm.DB, cursor string, limit int) (*gorm.DB, error) {
-	if cursor == "" {
-		return query.Limit(limit), nil
+// sortValue widens integers and floats, so that every column of a kind
+// encodes and compares the same.
+func sortValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case int:
+		return int64(value)
+	case int32:
+		return int64(value)
+	case float32:
+		return float64(value)
+	}
+	return value
+}
+
+// Paginate reads a page of a query in the order of keys and returns it with
+// the cursors of the neighbouring pages. values returns the sort-key values
+// of a row. The query must not be ordered or limited already. A negative
+// limit reads the whole list.
+//
+// The page after a cursor is read with the keyset condition of the sort
+// spec, the page before it in the reverse order, and one row more than
+// asked decides HasMore.
+func Paginate[T any](query *gorm.DB, keys []SortKey, values func(row *T) []interface{}, cursor string, limit int) ([]*T, PageInfo, error) {
+	direction := cursorNext
+	if cursor != "" {
+		var cursorValues []interface{}
+		var err error
+		direction, cursorValues, err = decodeCursor(cursor, keys)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+
+		condition, arguments := keysetCondition(keys, cursorValues, direction == cursorPrev)
+		query = query.Where(condition, arguments...)
 	}
 
-	var cursorModel T
-	err := db.Model(&cursorModel).Where("id = ?", cursor).First(&cursorModel).Error
+	for _, key := range keys {
+		order := key.Column
+		if key.Descending != (direction == cursorPrev) {
+			order += " DESC"
+		}
+		query = query.Order(order)
+	}
+	if limit >= 0 {
+		query = query.Limit(limit + 1)
+	}
+
+	var rows []*T
+	err := query.Find(&rows).Error
 	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			// If cursor is not found, return no results
-			return query.Where("1 = 0"), nil
+		return nil, PageInfo{}, err
+	}
+
+	return page(rows, keys, values, direction, cursor != "", limit)
+}
+
+// keysetCondition matches the rows after the cursor values in the order of
+// keys, or before them if reverse: (a > ?) OR (a = ? AND b > ?) and so on,
+// with < for descending keys.
+func keysetCondition(keys []SortKey, cursorValues []interface{}, reverse bool) (string, []interface{}) {
+	alternatives := make([]string, len(keys))
+	arguments := []interface{}{}
+	for i, key := range keys {
+		terms := []string{}
+		for _, equal := range keys[:i] {
+			terms = append(terms, equal.Column+" = ?")
+		}
+		if key.Descending != reverse {
+			terms = append(terms, key.Column+" < ?")
+		} else {
+			terms = append(terms, key.Column+" > ?")
+		}
+
+		alternatives[i] = "(" + strings.Join(terms, " AND ") + ")"
+		arguments = append(arguments, cursorValues[:i+1]...)
+	}
+
+	return "(" + strings.Join(alternatives, " OR ") + ")", arguments
+}
+
+// PaginateRows pages rows held in memory the way Paginate pages a query. It
+// compares strings bytewise, which matches Postgres only under the C
+// collation.
+func PaginateRows[T any](rows []*T, keys []SortKey, values func(row *T) []interface{}, cursor string, limit int) ([]*T, PageInfo, error) {
+	direction := cursorNext
+	var cursorValues []interface{}
+	if cursor != "" {
+		var err error
+		direction, cursorValues, err = decodeCursor(cursor, keys)
+		if err != nil {
+			return nil, PageInfo{}, err
 		}
-		return nil, err
 	}
+	reverse := direction == cursorPrev
 
-	paginatedQuery := query.Where(
-		"(created_at < ?) OR (created_at = ? AND id < ?)",
-		cursorModel.GetCreatedAt(),
-		cursorModel.GetCreatedAt(),
-		cursorModel.GetID(),
-	).Limit(limit)
+	selected := []*T{}
+	for _, row := range rows {
+		if cursorValues == nil || compareSortValues(keys, values(row), cursorValues, reverse) > 0 {
+			selected = append(selected, row)
+		}
+	}
+	slices.SortStableFunc(selected, func(a *T, b *T) int {
+		return compareSortValues(keys, values(a), values(b), reverse)
+	})
+	if limit >= 0 && len(selected) > limit+1 {
+		selected = selected[:limit+1]
+	}
+
+	return page(selected, keys, values, direction, cursor != "", limit)
+}
+
+// compareSortValues orders two rows by their sort-key values, or in the
+// reverse order.
+func compareSortValues(keys []SortKey, a []interface{}, b []interface{}, reverse bool) int {
+	for i, key := range keys {
+		c := compareSortValue(sortValue(a[i]), sortValue(b[i]))
+		if key.Descending != reverse {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func compareSortValue(a interface{}, b interface{}) int {
+	switch a := a.(type) {
+	case time.Time:
+		return a.Compare(b.(time.Time))
+	case uuid.UUID:
+		b := b.(uuid.UUID)
+		return bytes.Compare(a[:], b[:])
+	case string:
+		return strings.Compare(a, b.(string))
+	case int64:
+		return compareOrdered(a, b.(int64))
+	case float64:
+		return compareOrdered(a, b.(float64))
+	case bool:
+		return compareOrdered(strconv.FormatBool(a), strconv.FormatBool(b.(bool)))
+	}
+	panic(fmt.Sprintf("unsupported sort key type %T", a))
+}
+
+func compareOrdered[T int64 | float64 | string](a T, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// page trims the extra row of a read, puts a backwards read back in list
+// order and builds the cursors. afterCursor tells whether the read started
+// from a cursor, so there are rows behind it.
+func page[T any](rows []*T, keys []SortKey, values func(row *T) []interface{}, direction string, afterCursor bool, limit int) ([]*T, PageInfo, error) {
+	var info PageInfo
+	if limit >= 0 && len(rows) > limit {
+		rows = rows[:limit]
+		info.HasMore = true
+	}
+	if direction == cursorPrev {
+		slices.Reverse(rows)
+	}
+	if len(rows) == 0 {
+		return rows, info, nil
+	}
+
+	hasNext, hasPrev := info.HasMore, afterCursor
+	if direction == cursorPrev {
+		hasNext, hasPrev = afterCursor, info.HasMore
+	}
+
+	var err error
+	if hasNext {
+		info.NextCursor, err = encodeCursor(cursorNext, keys, values(rows[len(rows)-1]))
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+	}
+	if hasPrev {
+		info.PrevCursor, err = encodeCursor(cursorPrev, keys, values(rows[0]))
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+	}
 
-	return paginatedQuery, nil
+	return rows, info, nil
 }
@@ -0,0 +1,242 @@
+package lib
+
+import (
+	"encoding/base64"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var testSortKeys = []SortKey{
+	{Column: "created_at", Descending: true},
+	{Column: "id", Descending: true},
+}
+
+// setTestCursorSecret signs cursors with secret for the rest of the test.
+func setTestCursorSecret(t *testing.T, secret string) {
+	t.Helper()
+
+	cursorSecretMutex.RLock()
+	previous := cursorSecret
+	cursorSecretMutex.RUnlock()
+	t.Cleanup(func() {
+		cursorSecretMutex.Lock()
+		defer cursorSecretMutex.Unlock()
+		cursorSecret = previous
+	})
+
+	err := SetCursorSecret(secret)
+	if err != nil {
+		t.Fatalf("set cursor secret: %v", err)
+	}
+}
+
+func mustInvalidCursor(t *testing.T, what string, err error) {
+	t.Helper()
+	if !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("%s: got %v, want %v", what, err, ErrInvalidCursor)
+	}
+}
+
+func TestSetCursorSecretRejectsEmpty(t *testing.T) {
+	if SetCursorSecret("") == nil {
+		t.Fatalf("empty secret accepted")
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	setTestCursorSecret(t, "secret")
+
+	createdAt := time.Date(2025, 3, 1, 12, 30, 0, 123456000, time.FixedZone("UTC+3", 3*60*60))
+	id := uuid.New()
+
+	for _, direction := range []string{cursorNext, cursorPrev} {
+		cursor, err := encodeCursor(direction, testSortKeys, []interface{}{createdAt, id})
+		if err != nil {
+			t.Fatalf("%s: encode: %v", direction, err)
+		}
+
+		decodedDirection, values, err := decodeCursor(cursor, testSortKeys)
+		if err != nil {
+			t.Fatalf("%s: decode: %v", direction, err)
+		}
+		if decodedDirection != direction {
+			t.Fatalf("direction: got %q, want %q", decodedDirection, direction)
+		}
+		if decoded := values[0].(time.Time); !decoded.Equal(createdAt) {
+			t.Fatalf("%s: created_at: got %v, want %v", direction, decoded, createdAt)
+		}
+		if decoded := values[1].(uuid.UUID); decoded != id {
+			t.Fatalf("%s: id: got %v, want %v", direction, decoded, id)
+		}
+	}
+}
+
+func TestCursorValueTypes(t *testing.T) {
+	setTestCursorSecret(t, "secret")
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  interface{}
+	}{
+		{"string", "name with . and \"quotes\"", "name with . and \"quotes\""},
+		{"int", 42, int64(42)},
+		{"int32", int32(-7), int64(-7)},
+		{"int64", int64(1) << 40, int64(1) << 40},
+		{"float64", 1.5, 1.5},
+		{"float32", float32(0.25), 0.25},
+		{"bool", true, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			keys := []SortKey{{Column: "value"}}
+			cursor, err := encodeCursor(cursorNext, keys, []interface{}{test.value})
+			if err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+
+			_, values, err := decodeCursor(cursor, keys)
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if values[0] != test.want {
+				t.Fatalf("got %#v, want %#v", values[0], test.want)
+			}
+		})
+	}
+
+	_, err := encodeCursor(cursorNext, []SortKey{{Column: "value"}}, []interface{}{[]byte("x")})
+	if err == nil {
+		t.Fatalf("unsupported type encoded")
+	}
+}
+
+func TestCursorTampering(t *testing.T) {
+	setTestCursorSecret(t, "secret")
+
+	cursor, err := encodeCursor(cursorNext, testSortKeys, []interface{}{time.Now(), uuid.New()})
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	encodedData, encodedSignature, _ := strings.Cut(cursor, ".")
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		t.Fatalf("decode signature: %v", err)
+	}
+	for i := range signature {
+		flipped := slices.Clone(signature)
+		flipped[i] ^= 0x01
+		_, _, err = decodeCursor(encodedData+"."+base64.RawURLEncoding.EncodeToString(flipped), testSortKeys)
+		mustInvalidCursor(t, "flipped signature byte", err)
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encodedData)
+	if err != nil {
+		t.Fatalf("decode data: %v", err)
+	}
+	data[len(data)/2] ^= 0x01
+	_, _, err = decodeCursor(base64.RawURLEncoding.EncodeToString(data)+"."+encodedSignature, testSortKeys)
+	mustInvalidCursor(t, "flipped payload byte", err)
+
+	for _, malformed := range []string{"", ".", encodedData, encodedData + ".", "!!!." + encodedSignature, encodedData + ".!!!"} {
+		_, _, err = decodeCursor(malformed, testSortKeys)
+		mustInvalidCursor(t, "malformed cursor "+malformed, err)
+	}
+}
+
+func TestCursorOtherSecret(t *testing.T) {
+	setTestCursorSecret(t, "one secret")
+
+	cursor, err := encodeCursor(cursorNext, testSortKeys, []interface{}{time.Now(), uuid.New()})
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+
+	setTestCursorSecret(t, "another secret")
+	_, _, err = decodeCursor(cursor, testSortKeys)
+	mustInvalidCursor(t, "cursor of another secret", err)
+}
+
+func TestCursorOtherSortSpec(t *testing.T) {
+	setTestCursorSecret(t, "secret")
+
+	cursor, err := encodeCursor(cursorNext, testSortKeys, []interface{}{time.Now(), uuid.New()})
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+
+	ascending := []SortKey{{Column: "created_at"}, {Column: "id"}}
+	_, _, err = decodeCursor(cursor, ascending)
+	mustInvalidCursor(t, "cursor of another order", err)
+
+	_, _, err = decodeCursor(cursor, testSortKeys[:1])
+	mustInvalidCursor(t, "cursor of fewer keys", err)
+}
+
+func TestPaginateRowsDirections(t *testing.T) {
+	setTestCursorSecret(t, "secret")
+
+	type row struct{ id int }
+	rows := []*row{{4}, {2}, {5}, {1}, {3}}
+	keys := []SortKey{{Column: "id"}}
+	values := func(row *row) []interface{} { return []interface{}{row.id} }
+	ids := func(rows []*row) []int {
+		result := make([]int, len(rows))
+		for i, row := range rows {
+			result[i] = row.id
+		}
+		return result
+	}
+
+	tests := []struct {
+		name    string
+		cursor  func(pages []PageInfo) string
+		want    []int
+		hasMore bool
+		hasNext bool
+		hasPrev bool
+	}{
+		{"first page", func([]PageInfo) string { return "" }, []int{1, 2}, true, true, false},
+		{"next page", func(pages []PageInfo) string { return pages[0].NextCursor }, []int{3, 4}, true, true, true},
+		{"last page", func(pages []PageInfo) string { return pages[1].NextCursor }, []int{5}, false, false, true},
+		{"back from the last page", func(pages []PageInfo) string { return pages[2].PrevCursor }, []int{3, 4}, true, true, true},
+		{"back to the first page", func(pages []PageInfo) string { return pages[1].PrevCursor }, []int{1, 2}, false, true, false},
+	}
+
+	var pages []PageInfo
+	for _, test := range tests {
+		page, info, err := PaginateRows(rows, keys, values, test.cursor(pages), 2)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		pages = append(pages, info)
+
+		if got := ids(page); !slices.Equal(got, test.want) {
+			t.Fatalf("%s: got %v, want %v", test.name, got, test.want)
+		}
+		if info.HasMore != test.hasMore {
+			t.Fatalf("%s: has more: got %v, want %v", test.name, info.HasMore, test.hasMore)
+		}
+		if (info.NextCursor != "") != test.hasNext {
+			t.Fatalf("%s: next cursor: got %q, want one: %v", test.name, info.NextCursor, test.hasNext)
+		}
+		if (info.PrevCursor != "") != test.hasPrev {
+			t.Fatalf("%s: prev cursor: got %q, want one: %v", test.name, info.PrevCursor, test.hasPrev)
+		}
+	}
+
+	page, _, err := PaginateRows(rows, keys, values, "", -1)
+	if err != nil {
+		t.Fatalf("whole list: %v", err)
+	}
+	if got := ids(page); !slices.Equal(got, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("whole list: got %v", got)
+	}
+}
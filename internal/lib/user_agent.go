@@ -0,0 +1,53 @@
+package lib
+
+import (
+	"strings"
+
+	"github.com/mileusna/useragent"
+)
+
+// DeviceInfo is a human readable summary of a user agent. Fields are empty
+// when unknown.
+type DeviceInfo struct {
+	Browser string // e.g. "Chrome", "Firefox"
+	OS      string // e.g. "Windows", "iOS"
+	Device  string // device model if known, otherwise desktop, mobile, tablet or bot
+}
+
+// ParseUserAgent extracts browser, operating system and device from a user
+// agent header. Versions are left out so that updates do not make a device
+// look new.
+func ParseUserAgent(userAgent string) DeviceInfo {
+	if userAgent == "" || userAgent == "unknown" {
+		return DeviceInfo{}
+	}
+
+	ua := useragent.Parse(userAgent)
+	info := DeviceInfo{
+		Browser: ua.Name,
+		OS:      ua.OS,
+		Device:  ua.Device,
+	}
+
+	if info.Device == "" {
+		switch {
+		case ua.Bot:
+			info.Device = "bot"
+		case ua.Tablet:
+			info.Device = "tablet"
+		case ua.Mobile:
+			info.Device = "mobile"
+		case ua.Desktop:
+			info.Device = "desktop"
+		}
+	}
+
+	return info
+}
+
+// DeviceFingerprint identifies a device and location combination for new
+// device alerts. Only the country is used, cities change too often with
+// mobile networks.
+func DeviceFingerprint(info DeviceInfo, country string) string {
+	return strings.ToLower(strings.Join([]string{info.Browser, info.OS, info.Device, country}, "|"))
+}
@@ -0,0 +1,136 @@
+package lib
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var ErrSlugLength = errors.New("slug must be between 3 and 32 characters")
+var ErrSlugCharset = errors.New("slug may only contain lowercase latin letters, digits, '-' and '_'")
+var ErrSlugFormat = errors.New("slug must start with a letter and must not end with or repeat '-' or '_'")
+var ErrSlugReserved = errors.New("slug is reserved")
+var ErrNameLength = errors.New("name must be between 2 and 50 characters")
+var ErrNameCharset = errors.New("name contains unsupported characters")
+var ErrNameReserved = errors.New("name is reserved")
+
+const USER_SLUG_MIN_LENGTH = 3
+const USER_SLUG_MAX_LENGTH = 32
+const USER_NAME_MIN_LENGTH = 2
+const USER_NAME_MAX_LENGTH = 50
+
+// reservedUserSlugs are route names and identities users must not be able
+// to impersonate. Compared by skeleton, so "adm1n" is reserved as well.
+var reservedUserSlugs = []string{
+	"about", "account", "admin", "administrator", "api", "auth", "community",
+	"communities", "deleted", "help", "login", "logout", "me", "moderator", "null",
+	"official", "platform", "post", "posts", "register", "root", "security",
+	"settings", "staff", "stormhead", "support", "system", "undefined", "user",
+	"users", "www",
+}
+
+// NormalizeUserSlug applies NFKC (so fullwidth and other compatibility
+// forms become plain ASCII), trims spaces and lowercases.
+func NormalizeUserSlug(slug string) string {
+	return strings.ToLower(strings.TrimSpace(norm.NFKC.String(slug)))
+}
+
+// ValidateUserSlug checks an already normalized slug.
+func ValidateUserSlug(slug string) error {
+	if len(slug) < USER_SLUG_MIN_LENGTH || len(slug) > USER_SLUG_MAX_LENGTH {
+		return ErrSlugLength
+	}
+
+	for _, r := range slug {
+		if !(r >= 'a' && r <= 'z') && !(r >= '0' && r <= '9') && r != '-' && r != '_' {
+			return ErrSlugCharset
+		}
+	}
+
+	if slug[0] < 'a' || slug[0] > 'z' {
+		return ErrSlugFormat
+	}
+	last := slug[len(slug)-1]
+	if last == '-' || last == '_' {
+		return ErrSlugFormat
+	}
+	for i := 1; i < len(slug); i++ {
+		if isSlugSeparator(slug[i]) && isSlugSeparator(slug[i-1]) {
+			return ErrSlugFormat
+		}
+	}
+
+	if isReservedSkeleton(UserSlugSkeleton(slug)) {
+		return ErrSlugReserved
+	}
+
+	return nil
+}
+
+// UserSlugSkeleton maps visually confusable slugs to the same string, so
+// that "j0hn", "john" and "jo-hn" cannot belong to different users. The
+// user.slug_skeleton column stores it; keep in sync with migration 000011.
+func UserSlugSkeleton(slug string) string {
+	skeleton := strings.Map(func(r rune) rune {
+		switch r {
+		case '0':
+			return 'o'
+		case '1', 'i':
+			return 'l'
+		case '-', '_':
+			return -1
+		}
+		return r
+	}, strings.ToLower(slug))
+
+	skeleton = strings.ReplaceAll(skeleton, "rn", "m")
+	skeleton = strings.ReplaceAll(skeleton, "vv", "w")
+	return skeleton
+}
+
+// NormalizeUserName applies NFKC, trims and collapses runs of whitespace.
+func NormalizeUserName(name string) string {
+	return strings.Join(strings.Fields(norm.NFKC.String(name)), " ")
+}
+
+// ValidateUserName checks an already normalized display name. Letters,
+// marks and digits of any script are allowed, plus spaces and a little
+// punctuation; control and invisible format characters are not.
+func ValidateUserName(name string) error {
+	length := utf8.RuneCountInString(name)
+	if length < USER_NAME_MIN_LENGTH || length > USER_NAME_MAX_LENGTH {
+		return ErrNameLength
+	}
+
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) || r == ' ' {
+			continue
+		}
+		if strings.ContainsRune(".-_'", r) {
+			continue
+		}
+		return ErrNameCharset
+	}
+
+	if isReservedSkeleton(UserSlugSkeleton(strings.ReplaceAll(name, " ", ""))) {
+		return ErrNameReserved
+	}
+
+	return nil
+}
+
+func isSlugSeparator(c byte) bool {
+	return c == '-' || c == '_'
+}
+
+func isReservedSkeleton(skeleton string) bool {
+	for _, reserved := range reservedUserSlugs {
+		if skeleton == UserSlugSkeleton(reserved) {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package metric
 
 import (
 	"os"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
@@ -17,9 +18,80 @@ var requestCounter = prometheus.NewCounter(
 	},
 )
 
+var counterDriftRows = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "counter_drift_rows",
+		Help: "Rows with a wrong counter value found by the last reconciliation",
+		ConstLabels: prometheus.Labels{
+			"name": os.Getenv("NAME"),
+		},
+	},
+	[]string{"counter"},
+)
+
+var counterDrift = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "counter_drift",
+		Help: "Sum of absolute counter errors found by the last reconciliation",
+		ConstLabels: prometheus.Labels{
+			"name": os.Getenv("NAME"),
+		},
+	},
+	[]string{"counter"},
+)
+
+var queryDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name: "postgres_query_duration_seconds",
+		Help: "Latency of database statements",
+		ConstLabels: prometheus.Labels{
+			"name": os.Getenv("NAME"),
+		},
+		Buckets: prometheus.ExponentialBuckets(0.0005, 2, 16),
+	},
+	[]string{"operation", "table", "target"},
+)
+
+var replicaLag = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "postgres_replica_lag_seconds",
+		Help: "Replication lag of read replicas, -1 if the last check failed",
+		ConstLabels: prometheus.Labels{
+			"name": os.Getenv("NAME"),
+		},
+	},
+	[]string{"replica"},
+)
+
+// ObserveQuery records the latency of a statement. target is the pool it
+// ran on, primary or replica.
+func ObserveQuery(operation string, table string, target string, duration time.Duration) {
+	queryDuration.WithLabelValues(operation, table, target).Observe(duration.Seconds())
+}
+
+// ReportReplicaLag records the lag of a replica, negative if it couldn't be
+// measured.
+func ReportReplicaLag(replica string, lag time.Duration) {
+	if lag < 0 {
+		replicaLag.WithLabelValues(replica).Set(-1)
+		return
+	}
+	replicaLag.WithLabelValues(replica).Set(lag.Seconds())
+}
+
+// ReportCounterDrift records the result of a counter reconciliation.
+func ReportCounterDrift(counter string, rows int64, delta int64) {
+	counterDriftRows.WithLabelValues(counter).Set(float64(rows))
+	counterDrift.WithLabelValues(counter).Set(float64(delta))
+}
+
 func CreateRegistry() *prometheus.Registry {
 	register := prometheus.NewRegistry()
 	register.MustRegister(requestCounter)
+	register.MustRegister(counterDriftRows)
+	register.MustRegister(counterDrift)
+	register.MustRegister(queryDuration)
+	register.MustRegister(replicaLag)
 	register.MustRegister(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
 	register.MustRegister(collectors.NewGoCollector())
 	return register
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"go.uber.org/zap"
@@ -9,12 +10,13 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 
 	jwtpkg "github.com/stormhead-org/backend/internal/jwt"
 	ormpkg "github.com/stormhead-org/backend/internal/orm"
 )
 
-func NewAuthorizationMiddleware(logger *zap.Logger, jwt *jwtpkg.JWT, database *ormpkg.PostgresClient) grpc.UnaryServerInterceptor {
+func NewAuthorizationMiddleware(logger *zap.Logger, jwt *jwtpkg.JWT, database *ormpkg.PostgresClient, sessions *SessionCache) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		request interface{},
@@ -23,32 +25,44 @@ func NewAuthorizationMiddleware(logger *zap.Logger, jwt *jwtpkg.JWT, database *o
 	) (interface{}, error) {
 		bypassCheck := map[string]bool{
 			// Authorization
-			"/proto.AuthorizationService/ValidateUserSlug":     true,
-			"/proto.AuthorizationService/ValidateUserName":     true,
-			"/proto.AuthorizationService/ValidateUserEmail":    true,
-			"/proto.AuthorizationService/Register":             true,
-			"/proto.AuthorizationService/Login":                true,
-			"/proto.AuthorizationService/VerifyEmail":          true,
-			"/proto.AuthorizationService/RequestPasswordReset": true,
-			"/proto.AuthorizationService/ConfirmPasswordReset": true,
+			"/proto.AuthorizationService/ValidateUserSlug":        true,
+			"/proto.AuthorizationService/ValidateUserName":        true,
+			"/proto.AuthorizationService/ValidateUserEmail":       true,
+			"/proto.AuthorizationService/Register":                true,
+			"/proto.AuthorizationService/Login":                   true,
+			"/proto.AuthorizationService/VerifyEmail":             true,
+			"/proto.AuthorizationService/RequestPasswordReset":    true,
+			"/proto.AuthorizationService/ConfirmPasswordReset":    true,
+			"/proto.AuthorizationService/UnlockAccount":           true,
+			"/proto.AuthorizationService/RequestMagicLink":        true,
+			"/proto.AuthorizationService/ConsumeMagicLink":        true,
+			"/proto.AuthorizationService/ResendVerificationEmail": true,
+			"/proto.AuthorizationService/ConfirmEmailChange":      true,
+			"/proto.AuthorizationService/RevertEmailChange":       true,
+			"/proto.AuthorizationService/GetChallenge":            true,
 
 			// Community
 			"/proto.CommunityService/Get":             true,
 			"/proto.CommunityService/ListCommunities": true,
 
 			// Post
-			"/proto.PostService/Get":                 true,
+			"/proto.PostService/Get":                true,
 			"/proto.PostService/ListCommunityPosts": true,
+			"/proto.PostService/ListComments":       true,
 
 			// Comment
 			"/proto.CommentService/List": true,
 
 			// User
-			"/proto.UserService/Get":             true,
-			"/proto.UserService/GetStatistics":   true,
-			"/proto.UserService/ListCommunities": true,
-			"/proto.UserService/ListPosts":       true,
-			"/proto.UserService/ListComments":    true,
+			"/proto.UserService/Get":                   true,
+			"/proto.UserService/GetStatistics":         true,
+			"/proto.UserService/ListReputationHistory": true,
+			"/proto.UserService/ListCommunities":       true,
+			"/proto.UserService/ListPosts":             true,
+			"/proto.UserService/ListComments":          true,
+
+			// Platform
+			"/proto.PlatformService/GetRegistrationSettings": true,
 
 			// Health
 			"/grpc.health.v1.Health/Check": true,
@@ -75,26 +89,34 @@ func NewAuthorizationMiddleware(logger *zap.Logger, jwt *jwtpkg.JWT, database *o
 
 		token := strings.TrimPrefix(header[0], "Bearer ")
 
+		if strings.HasPrefix(token, PERSONAL_ACCESS_TOKEN_PREFIX) {
+			pat, err := authorizePersonalAccessToken(logger, database, information.FullMethod, request, token)
+			if err != nil {
+				return nil, err
+			}
+
+			ctx = SetPersonalAccessTokenID(ctx, pat.ID.String())
+			ctx = SetUserID(ctx, pat.UserID.String())
+
+			return handler(
+				ctx,
+				request,
+			)
+		}
+
 		id, err := jwt.ParseAccessToken(token)
 		if err != nil {
 			logger.Error("invalid access token", zap.Error(err))
 			return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
 		}
 
-		session, err := database.SelectSessionByID(id)
+		userID, err := authorizeSession(logger, database, sessions, id)
 		if err != nil {
-			logger.Error("database error", zap.Error(err))
-			return nil, status.Errorf(codes.Internal, "internal error")
-		}
-
-		err = database.UpdateSession(session)
-		if err != nil {
-			logger.Error("database error", zap.Error(err))
-			return nil, status.Errorf(codes.Internal, "internal error")
+			return nil, err
 		}
 
 		ctx = SetSessionID(ctx, id)
-		ctx = SetUserID(ctx, session.UserID.String())
+		ctx = SetUserID(ctx, userID)
 
 		return handler(
 			ctx,
@@ -102,3 +124,35 @@ func NewAuthorizationMiddleware(logger *zap.Logger, jwt *jwtpkg.JWT, database *o
 		)
 	}
 }
+
+// authorizeSession checks that the session of an access token still exists
+// and returns its owner. Recently seen sessions come from the cache, others
+// are loaded from the database, which also refreshes their activity time.
+func authorizeSession(logger *zap.Logger, database *ormpkg.PostgresClient, sessions *SessionCache, sessionID string) (string, error) {
+	if sessions.IsRevoked(sessionID) {
+		return "", status.Errorf(codes.Unauthenticated, "session revoked")
+	}
+
+	userID, ok := sessions.Get(sessionID)
+	if ok {
+		return userID, nil
+	}
+
+	session, err := database.SelectSessionByID(sessionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", status.Errorf(codes.Unauthenticated, "session revoked")
+	}
+	if err != nil {
+		logger.Error("database error", zap.Error(err))
+		return "", status.Errorf(codes.Internal, "internal error")
+	}
+
+	err = database.UpdateSession(session)
+	if err != nil {
+		logger.Error("database error", zap.Error(err))
+		return "", status.Errorf(codes.Internal, "internal error")
+	}
+
+	sessions.Put(sessionID, session.UserID.String())
+	return session.UserID.String(), nil
+}
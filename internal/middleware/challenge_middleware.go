@@ -0,0 +1,202 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
+)
+
+// CHALLENGE_METADATA_KEY carries the solved challenge. The gateway forwards
+// the X-Challenge-Response HTTP header into it.
+const CHALLENGE_METADATA_KEY = "x-challenge-response"
+
+// DefaultChallengeMethods are the anonymous methods protected unless
+// CHALLENGE_METHODS says otherwise.
+var DefaultChallengeMethods = []string{
+	"/proto.AuthorizationService/Register",
+	"/proto.AuthorizationService/Login",
+	"/proto.AuthorizationService/RequestPasswordReset",
+}
+
+// ChallengeGuard requires a solved proof of work or CAPTCHA challenge for
+// the configured methods. Failed calls from an ip address raise the proof
+// of work difficulty for it, see securitypkg.ChallengeDifficulty.
+type ChallengeGuard struct {
+	logger   *zap.Logger
+	database *ormpkg.PostgresClient
+	verifier securitypkg.ChallengeVerifier
+	methods  map[string]bool
+}
+
+// NewChallengeGuard creates a guard for methods. A nil verifier disables
+// challenges.
+func NewChallengeGuard(logger *zap.Logger, database *ormpkg.PostgresClient, verifier securitypkg.ChallengeVerifier, methods []string) *ChallengeGuard {
+	protected := map[string]bool{}
+	for _, method := range methods {
+		protected[method] = true
+	}
+
+	return &ChallengeGuard{
+		logger:   logger,
+		database: database,
+		verifier: verifier,
+		methods:  protected,
+	}
+}
+
+// Methods returns the protected methods, or none if challenges are disabled.
+func (this *ChallengeGuard) Methods() []string {
+	if this.verifier == nil {
+		return nil
+	}
+
+	methods := make([]string, 0, len(this.methods))
+	for method := range this.methods {
+		methods = append(methods, method)
+	}
+	return methods
+}
+
+// Issue returns a new challenge for the caller at the difficulty its
+// recent failures call for.
+func (this *ChallengeGuard) Issue(ctx context.Context) (*securitypkg.Challenge, error) {
+	if this.verifier == nil {
+		return &securitypkg.Challenge{
+			Kind: securitypkg.CHALLENGE_KIND_NONE,
+		}, nil
+	}
+
+	ipAddress := peerAddress(ctx)
+	difficulty, err := this.difficulty(ipAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return this.verifier.Issue(ipAddress, difficulty)
+}
+
+func (this *ChallengeGuard) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		request interface{},
+		information *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if this.verifier == nil || !this.methods[information.FullMethod] {
+			return handler(ctx, request)
+		}
+
+		ipAddress := peerAddress(ctx)
+		err := this.check(ctx, ipAddress)
+		if err != nil {
+			this.recordFailure(ipAddress)
+			return nil, err
+		}
+
+		response, err := handler(ctx, request)
+		if err != nil && isClientFailure(err) {
+			this.recordFailure(ipAddress)
+		}
+		return response, err
+	}
+}
+
+func (this *ChallengeGuard) check(ctx context.Context, ipAddress string) error {
+	var response string
+	meta, ok := metadata.FromIncomingContext(ctx)
+	if ok && len(meta[CHALLENGE_METADATA_KEY]) > 0 {
+		response = meta[CHALLENGE_METADATA_KEY][0]
+	}
+	if response == "" {
+		return status.Errorf(codes.FailedPrecondition, "%s", securitypkg.ErrChallengeRequired)
+	}
+
+	difficulty, err := this.difficulty(ipAddress)
+	if err != nil {
+		this.logger.Error("database error", zap.Error(err))
+		return status.Errorf(codes.Internal, "internal error")
+	}
+
+	verified, err := this.verifier.Verify(ctx, response, ipAddress, difficulty)
+	if err != nil {
+		if errors.Is(err, securitypkg.ErrChallengeInvalid) ||
+			errors.Is(err, securitypkg.ErrChallengeExpired) ||
+			errors.Is(err, securitypkg.ErrChallengeTooEasy) {
+			return status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		this.logger.Error("challenge verification failed", zap.Error(err))
+		return status.Errorf(codes.Unavailable, "failed to verify challenge, try again later")
+	}
+
+	if verified.Nonce == "" {
+		return nil
+	}
+
+	fresh, err := this.database.RedeemChallenge(verified.Nonce, verified.ExpiresAt)
+	if err != nil {
+		this.logger.Error("database error", zap.Error(err))
+		return status.Errorf(codes.Internal, "internal error")
+	}
+	if !fresh {
+		return status.Errorf(codes.FailedPrecondition, "challenge already used")
+	}
+
+	return nil
+}
+
+func (this *ChallengeGuard) difficulty(ipAddress string) (int, error) {
+	throttle, err := this.database.SelectAuthThrottle(securitypkg.CHALLENGE_FAILURE_IP, ipAddress)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return securitypkg.ChallengeDifficulty(0), nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if time.Since(throttle.WindowStartedAt) > securitypkg.CHALLENGE_FAILURE_WINDOW {
+		return securitypkg.ChallengeDifficulty(0), nil
+	}
+	return securitypkg.ChallengeDifficulty(throttle.Count), nil
+}
+
+func (this *ChallengeGuard) recordFailure(ipAddress string) {
+	_, err := this.database.IncrementAuthThrottle(securitypkg.CHALLENGE_FAILURE_IP, ipAddress, securitypkg.CHALLENGE_FAILURE_WINDOW)
+	if err != nil {
+		this.logger.Error("failed to record challenge failure", zap.Error(err))
+	}
+}
+
+// isClientFailure reports whether the call failed because of what the
+// client sent, as opposed to a server problem.
+func isClientFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Internal, codes.Unavailable, codes.Unknown, codes.DeadlineExceeded, codes.Canceled:
+		return false
+	}
+	return true
+}
+
+func peerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return "unknown"
+	}
+	return host
+}
@@ -25,6 +25,17 @@ func GetSessionID(context contextpkg.Context) (string, error) {
 	return "", ErrSessionIDNotSet
 }
 
+func SetPersonalAccessTokenID(context contextpkg.Context, id string) contextpkg.Context {
+	return contextpkg.WithValue(context, "personalAccessTokenID", id)
+}
+
+// GetPersonalAccessTokenID returns the token the request was authenticated
+// with, if it used a personal access token instead of a session.
+func GetPersonalAccessTokenID(context contextpkg.Context) (string, bool) {
+	id, ok := context.Value("personalAccessTokenID").(string)
+	return id, ok
+}
+
 func SetUserID(context contextpkg.Context, id string) contextpkg.Context {
 	return contextpkg.WithValue(context, "userID", id)
 }
@@ -0,0 +1,149 @@
+package middleware
+
+import (
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
+)
+
+var ErrCommunityNotResolved = errors.New("community not resolved")
+
+const PERSONAL_ACCESS_TOKEN_PREFIX = "pat_"
+
+const SCOPE_POSTS_READ = "posts:read"
+const SCOPE_POSTS_WRITE = "posts:write"
+const SCOPE_COMMENTS_READ = "comments:read"
+const SCOPE_COMMENTS_WRITE = "comments:write"
+const SCOPE_PROFILE_READ = "profile:read"
+
+var PersonalAccessTokenScopes = []string{
+	SCOPE_POSTS_READ,
+	SCOPE_POSTS_WRITE,
+	SCOPE_COMMENTS_READ,
+	SCOPE_COMMENTS_WRITE,
+	SCOPE_PROFILE_READ,
+}
+
+// personalAccessTokenMethods maps every method callable with a personal
+// access token to the scope it requires. Methods missing here, including
+// token and session management, are refused for personal access tokens.
+var personalAccessTokenMethods = map[string]string{
+	// Post
+	"/proto.PostService/Create":       SCOPE_POSTS_WRITE,
+	"/proto.PostService/Update":       SCOPE_POSTS_WRITE,
+	"/proto.PostService/Delete":       SCOPE_POSTS_WRITE,
+	"/proto.PostService/Publish":      SCOPE_POSTS_WRITE,
+	"/proto.PostService/Unpublish":    SCOPE_POSTS_WRITE,
+	"/proto.PostService/ListComments": SCOPE_POSTS_READ,
+
+	// Comment
+	"/proto.CommentService/Get":    SCOPE_COMMENTS_READ,
+	"/proto.CommentService/Create": SCOPE_COMMENTS_WRITE,
+	"/proto.CommentService/Update": SCOPE_COMMENTS_WRITE,
+	"/proto.CommentService/Delete": SCOPE_COMMENTS_WRITE,
+
+	// User
+	"/proto.UserService/GetCurrent": SCOPE_PROFILE_READ,
+}
+
+func IsPersonalAccessTokenScope(scope string) bool {
+	for _, s := range PersonalAccessTokenScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+type communityRequest interface {
+	GetCommunityId() string
+}
+
+type postRequest interface {
+	GetPostId() string
+}
+
+type commentRequest interface {
+	GetCommentId() string
+}
+
+// authorizePersonalAccessToken resolves a pat_ token, checks its scope and
+// community restriction for the called method and returns its owner.
+func authorizePersonalAccessToken(
+	logger *zap.Logger,
+	database *ormpkg.PostgresClient,
+	method string,
+	request interface{},
+	token string,
+) (*ormpkg.PersonalAccessToken, error) {
+	pat, err := database.SelectPersonalAccessTokenByHash(securitypkg.HashToken(token))
+	if err != nil {
+		logger.Error("invalid personal access token", zap.Error(err))
+		return nil, status.Errorf(codes.Unauthenticated, "missing or invalid token")
+	}
+
+	now := time.Now()
+	if !pat.IsActive(now) {
+		return nil, status.Errorf(codes.Unauthenticated, "token revoked or expired")
+	}
+
+	scope, ok := personalAccessTokenMethods[method]
+	if !ok || !pat.HasScope(scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "token lacks required scope")
+	}
+
+	// Profile access is not tied to any community
+	if pat.CommunityID != nil && scope != SCOPE_PROFILE_READ {
+		communityID, err := requestCommunityID(database, request)
+		if err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "token is restricted to another community")
+		}
+		if communityID != pat.CommunityID.String() {
+			return nil, status.Errorf(codes.PermissionDenied, "token is restricted to another community")
+		}
+	}
+
+	err = database.UpdatePersonalAccessTokenLastUsed(pat.ID.String(), now)
+	if err != nil {
+		logger.Error("database error", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	return pat, nil
+}
+
+// requestCommunityID finds the community a request operates on, directly or
+// through the post or comment it references.
+func requestCommunityID(database *ormpkg.PostgresClient, request interface{}) (string, error) {
+	if r, ok := request.(communityRequest); ok && r.GetCommunityId() != "" {
+		return r.GetCommunityId(), nil
+	}
+
+	postID := ""
+	if r, ok := request.(postRequest); ok {
+		postID = r.GetPostId()
+	}
+	if r, ok := request.(commentRequest); ok && postID == "" && r.GetCommentId() != "" {
+		comment, err := database.SelectCommentByID(r.GetCommentId())
+		if err != nil {
+			return "", err
+		}
+		postID = comment.PostID.String()
+	}
+	if postID == "" {
+		return "", ErrCommunityNotResolved
+	}
+
+	post, err := database.SelectPostByID(postID)
+	if err != nil {
+		return "", err
+	}
+
+	return post.CommunityID.String(), nil
+}
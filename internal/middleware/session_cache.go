@@ -0,0 +1,189 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	eventpkg "github.com/stormhead-org/backend/internal/event"
+	jwtpkg "github.com/stormhead-org/backend/internal/jwt"
+)
+
+// SESSION_CACHE_TTL bounds how long a session is trusted without looking at
+// the database. Revocations normally arrive through the broker within
+// seconds; the TTL only matters if one is lost.
+const SESSION_CACHE_TTL = time.Minute
+
+type cachedSession struct {
+	userID   string
+	loadedAt time.Time
+}
+
+// SessionCache remembers sessions the middleware has recently validated, so
+// access tokens are not checked against the database on every request, and
+// sessions revoked on any server instance. Revocations are broadcast with a
+// SESSION_REVOKED event to every instance.
+type SessionCache struct {
+	logger    *zap.Logger
+	broker    *eventpkg.KafkaClient
+	context   context.Context
+	cancel    func()
+	waitGroup sync.WaitGroup
+
+	mutex    sync.Mutex
+	sessions map[string]cachedSession
+	revoked  map[string]time.Time
+	sweptAt  time.Time
+}
+
+// NewSessionCache creates a cache fed by broker, which must be a broadcast
+// client so that every instance sees every revocation.
+func NewSessionCache(logger *zap.Logger, broker *eventpkg.KafkaClient) *SessionCache {
+	context, cancel := context.WithCancel(context.Background())
+	return &SessionCache{
+		logger:   logger,
+		broker:   broker,
+		context:  context,
+		cancel:   cancel,
+		sessions: map[string]cachedSession{},
+		revoked:  map[string]time.Time{},
+	}
+}
+
+func (this *SessionCache) Start() error {
+	this.waitGroup.Add(1)
+	go this.listen()
+	return nil
+}
+
+func (this *SessionCache) Stop() error {
+	this.cancel()
+	this.waitGroup.Wait()
+	return this.broker.Close()
+}
+
+// Get returns the owner of a cached session. ok is false when the session
+// has to be looked up in the database.
+func (this *SessionCache) Get(sessionID string) (string, bool) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	session, ok := this.sessions[sessionID]
+	if !ok || time.Since(session.loadedAt) > SESSION_CACHE_TTL {
+		return "", false
+	}
+	return session.userID, true
+}
+
+// Put caches a session just loaded from the database, unless it has been
+// revoked in the meantime.
+func (this *SessionCache) Put(sessionID string, userID string) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	if _, ok := this.revoked[sessionID]; ok {
+		return
+	}
+	now := time.Now()
+	this.sessions[sessionID] = cachedSession{
+		userID:   userID,
+		loadedAt: now,
+	}
+	if now.Sub(this.sweptAt) > SESSION_CACHE_TTL {
+		this.sweep(now)
+	}
+}
+
+func (this *SessionCache) IsRevoked(sessionID string) bool {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	_, ok := this.revoked[sessionID]
+	return ok
+}
+
+// Revoke rejects the sessions on this instance immediately and broadcasts
+// the revocation to the others. The sessions must already be deleted from
+// the database.
+func (this *SessionCache) Revoke(ctx context.Context, sessionIDs []string) error {
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+
+	this.revokeLocally(sessionIDs)
+
+	return this.broker.WriteMessage(
+		ctx,
+		eventpkg.SESSION_REVOKED,
+		eventpkg.SessionRevokedMessage{
+			SessionIDs: sessionIDs,
+		},
+	)
+}
+
+func (this *SessionCache) revokeLocally(sessionIDs []string) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	now := time.Now()
+	for _, sessionID := range sessionIDs {
+		delete(this.sessions, sessionID)
+		this.revoked[sessionID] = now
+	}
+	this.sweep(now)
+}
+
+// sweep drops expired entries. Must be called with the mutex held.
+func (this *SessionCache) sweep(now time.Time) {
+	this.sweptAt = now
+
+	// Access tokens of a revoked session are useless once they expire
+	for sessionID, revokedAt := range this.revoked {
+		if now.Sub(revokedAt) > jwtpkg.ACCESS_TOKEN_EXPIRATION {
+			delete(this.revoked, sessionID)
+		}
+	}
+	for sessionID, session := range this.sessions {
+		if now.Sub(session.loadedAt) > SESSION_CACHE_TTL {
+			delete(this.sessions, sessionID)
+		}
+	}
+}
+
+func (this *SessionCache) listen() {
+	defer this.waitGroup.Done()
+
+	for {
+		select {
+		case <-this.context.Done():
+			return
+		default:
+		}
+
+		event, data, err := this.broker.ReadMessage(this.context)
+		if err != nil {
+			if this.context.Err() != nil {
+				return
+			}
+			this.logger.Error("error receiving session revocation", zap.Error(err))
+			time.Sleep(time.Second)
+			continue
+		}
+
+		if event != eventpkg.SESSION_REVOKED {
+			continue
+		}
+
+		var message eventpkg.SessionRevokedMessage
+		err = json.Unmarshal([]byte(data), &message)
+		if err != nil {
+			this.logger.Error("error decoding session revocation", zap.Error(err))
+			continue
+		}
+
+		this.revokeLocally(message.SessionIDs)
+	}
+}
@@ -0,0 +1,381 @@
+package orm
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const TOMBSTONE_USER_SLUG = "deleted"
+const TOMBSTONE_USER_NAME = "Deleted user"
+const TOMBSTONE_USER_EMAIL = "deleted@tombstone.invalid"
+
+// SelectUsersDueForDeletion returns accounts whose deletion grace period
+// is over.
+func (c *PostgresClient) SelectUsersDueForDeletion(now time.Time, limit int) ([]*User, error) {
+	var users []*User
+	tx := c.database.
+		Select([]string{
+			"id",
+			"deletion_scheduled_at",
+		}).
+		Where("deletion_scheduled_at <= ?", now).
+		Order("deletion_scheduled_at").
+		Limit(limit).
+		Find(&users)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return users, nil
+}
+
+// DeleteUserAccount removes an account whose deletion is due, in a single
+// transaction. Sessions, likes, bookmarks, follows, memberships and role
+// assignments are deleted. Posts and comments are reassigned to the
+// tombstone user or deleted, following the platform deleted_user_content
+// setting; comments with replies are always kept, emptied, under the
+// tombstone so threads stay intact. Owned communities go to their oldest
+// remaining member, or to the tombstone and are archived if there is none.
+// Reputation from deleted likes and content is taken back, the rest goes
+// to the tombstone.
+//
+// Returns gorm.ErrRecordNotFound if the deletion was cancelled meanwhile.
+func (c *PostgresClient) DeleteUserAccount(userID string) error {
+	return c.database.Transaction(func(tx *gorm.DB) error {
+		var user User
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select([]string{"id", "email"}).
+			Where("id = ? AND deletion_scheduled_at <= ?", userID, time.Now()).
+			First(&user).
+			Error
+		if err != nil {
+			return err
+		}
+
+		var settings PlatformSetting
+		err = tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", 1).
+			First(&settings).
+			Error
+		if err != nil {
+			return err
+		}
+
+		tombstoneID, err := tombstoneUser(tx, &settings)
+		if err != nil {
+			return err
+		}
+
+		steps := []func(*gorm.DB, uuid.UUID, uuid.UUID) error{
+			deleteUserLikes,
+			deleteUserRelations,
+			transferUserCommunities,
+		}
+		if settings.DeletedUserContent == DELETED_USER_CONTENT_DELETE {
+			steps = append(steps, deleteUserContent)
+		} else {
+			steps = append(steps, reassignUserContent)
+		}
+		steps = append(steps, transferUserReputation)
+		for _, step := range steps {
+			err = step(tx, user.ID, tombstoneID)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.Where("user_id = ?", user.ID).Delete(&LoginLockout{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("key = ?", strings.ToLower(user.Email)).Delete(&AuthThrottle{}).Error
+		if err != nil {
+			return err
+		}
+
+		// Tokens, email changes and slug redirects cascade
+		return tx.Delete(&User{}, "id = ?", user.ID).Error
+	})
+}
+
+// tombstoneUser returns the user that content of deleted accounts is
+// reassigned to, creating it on first use.
+func tombstoneUser(tx *gorm.DB, settings *PlatformSetting) (uuid.UUID, error) {
+	if settings.TombstoneUserID != nil {
+		return *settings.TombstoneUserID, nil
+	}
+
+	user := User{
+		Slug:         TOMBSTONE_USER_SLUG,
+		SlugSkeleton: TOMBSTONE_USER_SLUG,
+		Name:         TOMBSTONE_USER_NAME,
+		Email:        TOMBSTONE_USER_EMAIL,
+		IsApproved:   true,
+		LastActivity: time.Now(),
+	}
+	err := tx.Create(&user).Error
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	err = tx.
+		Model(&PlatformSetting{}).
+		Where("id = ?", settings.ID).
+		Update("tombstone_user_id", user.ID).
+		Error
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return user.ID, nil
+}
+
+func deleteUserLikes(tx *gorm.DB, userID uuid.UUID, tombstoneID uuid.UUID) error {
+	err := revertReputationEvents(tx, tx.Model(&PostLike{}).Select("id").Where("user_id = ?", userID))
+	if err != nil {
+		return err
+	}
+
+	err = revertReputationEvents(tx, tx.Model(&CommentLike{}).Select("id").Where("user_id = ?", userID))
+	if err != nil {
+		return err
+	}
+
+	err = subtractCounters(tx, &Post{}, "like_count", tx.Model(&PostLike{}).Select("post_id AS id").Where("user_id = ?", userID))
+	if err != nil {
+		return err
+	}
+
+	err = tx.Where("user_id = ?", userID).Delete(&PostLike{}).Error
+	if err != nil {
+		return err
+	}
+
+	err = subtractCounters(tx, &Comment{}, "like_count", tx.Model(&CommentLike{}).Select("comment_id AS id").Where("user_id = ?", userID))
+	if err != nil {
+		return err
+	}
+
+	return tx.Where("user_id = ?", userID).Delete(&CommentLike{}).Error
+}
+
+func deleteUserRelations(tx *gorm.DB, userID uuid.UUID, tombstoneID uuid.UUID) error {
+	err := tx.Where("user_id = ?", userID).Delete(&Session{}).Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Where("user_id = ?", userID).Delete(&Bookmark{}).Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Where("user_id = ? OR follower_id = ?", userID, userID).Delete(&Follower{}).Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Where("user_id = ?", userID).Delete(&UserRole{}).Error
+	if err != nil {
+		return err
+	}
+
+	err = subtractCounters(tx, &Community{}, "member_count", tx.Model(&CommunityUser{}).Select("community_id AS id").Where("user_id = ?", userID))
+	if err != nil {
+		return err
+	}
+
+	return tx.Where("user_id = ?", userID).Delete(&CommunityUser{}).Error
+}
+
+func transferUserCommunities(tx *gorm.DB, userID uuid.UUID, tombstoneID uuid.UUID) error {
+	var communities []Community
+	err := tx.
+		Select([]string{"id"}).
+		Where("owner_id = ?", userID).
+		Find(&communities).
+		Error
+	if err != nil {
+		return err
+	}
+
+	for _, community := range communities {
+		// The user's own membership is already gone
+		var members []CommunityUser
+		err = tx.
+			Where("community_id = ?", community.ID).
+			Order("created_at").
+			Limit(1).
+			Find(&members).
+			Error
+		if err != nil {
+			return err
+		}
+
+		updates := map[string]interface{}{
+			"owner_id":    tombstoneID,
+			"archived_at": time.Now(),
+		}
+		if len(members) > 0 {
+			updates = map[string]interface{}{
+				"owner_id": members[0].UserID,
+			}
+		}
+
+		err = tx.
+			Model(&Community{}).
+			Where("id = ?", community.ID).
+			Updates(updates).
+			Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func reassignUserContent(tx *gorm.DB, userID uuid.UUID, tombstoneID uuid.UUID) error {
+	err := tx.
+		Model(&Post{}).
+		Where("author_id = ?", userID).
+		Update("author_id", tombstoneID).
+		Error
+	if err != nil {
+		return err
+	}
+
+	return tx.
+		Model(&Comment{}).
+		Where("author_id = ?", userID).
+		Update("author_id", tombstoneID).
+		Error
+}
+
+func deleteUserContent(tx *gorm.DB, userID uuid.UUID, tombstoneID uuid.UUID) error {
+	posts := tx.Model(&Post{}).Select("id").Where("author_id = ?", userID)
+	postComments := tx.Model(&Comment{}).Select("id").Where("post_id IN (?)", posts)
+
+	sources := []*gorm.DB{
+		tx.Model(&CommentLike{}).Select("id").Where("comment_id IN (?)", postComments),
+		postComments,
+		tx.Model(&PostLike{}).Select("id").Where("post_id IN (?)", posts),
+	}
+	for _, sourceIDs := range sources {
+		err := revertReputationEvents(tx, sourceIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := tx.Where("comment_id IN (?)", postComments).Delete(&CommentLike{}).Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Where("post_id IN (?)", posts).Delete(&Comment{}).Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Where("post_id IN (?)", posts).Delete(&PostLike{}).Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Where("post_id IN (?)", posts).Delete(&Bookmark{}).Error
+	if err != nil {
+		return err
+	}
+
+	err = subtractCounters(tx, &Community{}, "post_count", tx.Model(&Post{}).Select("community_id AS id").Where("author_id = ? AND deleted_at IS NULL", userID))
+	if err != nil {
+		return err
+	}
+
+	err = tx.Where("author_id = ?", userID).Delete(&Post{}).Error
+	if err != nil {
+		return err
+	}
+
+	// Comments without replies go, the others stay as empty tombstones
+	leafComments := tx.
+		Model(&Comment{}).
+		Select("id").
+		Where("author_id = ?", userID).
+		Where("NOT EXISTS (SELECT 1 FROM comment reply WHERE reply.parent_comment_id = comment.id)")
+
+	err = revertReputationEvents(tx, tx.Model(&CommentLike{}).Select("id").Where("comment_id IN (?)", leafComments))
+	if err != nil {
+		return err
+	}
+
+	err = revertReputationEvents(tx, leafComments)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Where("comment_id IN (?)", leafComments).Delete(&CommentLike{}).Error
+	if err != nil {
+		return err
+	}
+
+	err = subtractCounters(tx, &Post{}, "comment_count", tx.Model(&Comment{}).Select("post_id AS id").Where("id IN (?) AND deleted_at IS NULL", leafComments))
+	if err != nil {
+		return err
+	}
+
+	err = tx.Where("id IN (?)", leafComments).Delete(&Comment{}).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.
+		Model(&Comment{}).
+		Where("author_id = ?", userID).
+		Updates(map[string]interface{}{
+			"author_id": tombstoneID,
+			"content":   "",
+		}).
+		Error
+}
+
+// transferUserReputation moves what is left of the user's reputation, the
+// likes on content kept under the tombstone and manual adjustments, to the
+// tombstone, so the ledger still adds up to the community ratings.
+func transferUserReputation(tx *gorm.DB, userID uuid.UUID, tombstoneID uuid.UUID) error {
+	err := tx.
+		Model(&ReputationEvent{}).
+		Where("user_id = ?", userID).
+		Update("user_id", tombstoneID).
+		Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.
+		Model(&User{}).
+		Where("id = ?", tombstoneID).
+		UpdateColumn("reputation", gorm.Expr("reputation + (SELECT reputation FROM \"user\" WHERE id = ?)", userID)).
+		Error
+	if err != nil {
+		return err
+	}
+
+	// The user's own karma rows cascade with the account
+	return tx.Exec(
+		`INSERT INTO community_karma (user_id, community_id, karma)
+		SELECT ?, community_id, karma FROM community_karma WHERE user_id = ?
+		ON CONFLICT (user_id, community_id) DO UPDATE SET karma = community_karma.karma + EXCLUDED.karma`,
+		tombstoneID,
+		userID,
+	).Error
+}
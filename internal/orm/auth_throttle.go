@@ -0,0 +1,77 @@
+package orm
+
+import (
+	"time"
+)
+
+type AuthThrottle struct {
+	Kind            string `gorm:"primaryKey"`
+	Key             string `gorm:"primaryKey"`
+	Count           int
+	WindowStartedAt time.Time
+	LockedUntil     *time.Time
+	UpdatedAt       time.Time
+}
+
+func (c *AuthThrottle) TableName() string {
+	return "auth_throttle"
+}
+
+func (c *AuthThrottle) IsLocked(now time.Time) bool {
+	return c.LockedUntil != nil && now.Before(*c.LockedUntil)
+}
+
+func (c *PostgresClient) SelectAuthThrottle(kind string, key string) (*AuthThrottle, error) {
+	var throttle AuthThrottle
+	tx := c.database.
+		Where("kind = ? AND key = ?", kind, key).
+		First(&throttle)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &throttle, nil
+}
+
+// IncrementAuthThrottle atomically counts one more hit for the given key and
+// returns the updated row. Hits older than window restart the counter.
+func (c *PostgresClient) IncrementAuthThrottle(kind string, key string, window time.Duration) (*AuthThrottle, error) {
+	now := time.Now()
+	windowStart := now.Add(-window)
+
+	var throttle AuthThrottle
+	tx := c.database.Raw(
+		`INSERT INTO auth_throttle (kind, key, count, window_started_at, updated_at)
+		VALUES (?, ?, 1, ?, ?)
+		ON CONFLICT (kind, key) DO UPDATE SET
+			count = CASE WHEN auth_throttle.window_started_at < ? THEN 1 ELSE auth_throttle.count + 1 END,
+			window_started_at = CASE WHEN auth_throttle.window_started_at < ? THEN EXCLUDED.window_started_at ELSE auth_throttle.window_started_at END,
+			locked_until = CASE WHEN auth_throttle.window_started_at < ? THEN NULL ELSE auth_throttle.locked_until END,
+			updated_at = EXCLUDED.updated_at
+		RETURNING *`,
+		kind, key, now, now,
+		windowStart, windowStart, windowStart,
+	).Scan(&throttle)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &throttle, nil
+}
+
+func (c *PostgresClient) UpdateAuthThrottleLockedUntil(kind string, key string, lockedUntil time.Time) error {
+	tx := c.database.
+		Model(&AuthThrottle{}).
+		Where("kind = ? AND key = ?", kind, key).
+		Update("locked_until", lockedUntil)
+	return tx.Error
+}
+
+func (c *PostgresClient) DeleteAuthThrottle(kind string, key string) error {
+	tx := c.database.
+		Where("kind = ? AND key = ?", kind, key).
+		Delete(&AuthThrottle{})
+	return tx.Error
+}
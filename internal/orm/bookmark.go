@@ -4,7 +4,9 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/stormhead-org/backend/internal/lib"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Bookmark struct {
@@ -42,48 +44,52 @@ func (c *PostgresClient) SelectBookmarkByID(postID string, userID string) (*Book
 	return &Bookmark, nil
 }
 
-func (c *PostgresClient) SelectBookmarksWithPagination(limit int, cursor string) ([]*Bookmark, error) {
-	var bookmarks []*Bookmark
+// SelectBookmarksWithPagination lists the user's bookmarks newest first,
+// with their posts and the posts' authors and communities.
+func (c *PostgresClient) SelectBookmarksWithPagination(userID string, limit int, cursor string) ([]*Bookmark, lib.PageInfo, error) {
 	query := c.database.
 		Select([]string{
+			"id",
 			"post_id",
 			"user_id",
+			"created_at",
 		}).
-		Preload("Post").
-		Order("created_at DESC")
+		Where("user_id = ?", userID).
+		Where("post_id NOT IN (" + hiddenPostIDs + ")").
+		Preload("Post.Author").
+		Preload("Post.Community")
+
+	return lib.Paginate(query, NewestFirst, func(bookmark *Bookmark) []interface{} {
+		return createdAtAndID(bookmark.CreatedAt, bookmark.ID)
+	}, cursor, limit)
+}
 
-	if cursor != "" {
-		var cursorBookmark Bookmark
-		tx := c.database.
-			Where("user_id = ?", cursor).
-			First(&cursorBookmark)
+// InsertBookmark stores a bookmark. Returns false, and changes nothing, if
+// the user already bookmarked the post.
+func (c *PostgresClient) InsertBookmark(bookmark *Bookmark) (bool, error) {
+	tx := c.database.Clauses(clause.OnConflict{DoNothing: true}).Create(bookmark)
+	return tx.RowsAffected == 1, tx.Error
+}
 
-		if tx.Error != nil {
-			return nil, tx.Error
-		}
+// DeleteBookmark removes a bookmark. Returns false if the user has not
+// bookmarked the post.
+func (c *PostgresClient) DeleteBookmark(postID uuid.UUID, userID uuid.UUID) (bool, error) {
+	tx := c.database.
+		Where("post_id = ? AND user_id = ?", postID, userID).
+		Delete(&Bookmark{})
+	return tx.RowsAffected == 1, tx.Error
+}
 
-		query = query.Where(
-			"(created_at < ?) OR (created_at = ? AND id < ?)",
-			cursorBookmark.CreatedAt,
-			cursorBookmark.CreatedAt,
-			cursorBookmark.ID,
-		)
-	}
+func (c *PostgresClient) SelectBookmarksByUserID(userID string) ([]*Bookmark, error) {
+	var bookmarks []*Bookmark
+	tx := c.database.
+		Where("user_id = ?", userID).
+		Order("created_at").
+		Find(&bookmarks)
 
-	tx := query.Limit(limit).Find(&bookmarks)
 	if tx.Error != nil {
 		return nil, tx.Error
 	}
 
 	return bookmarks, nil
 }
-
-func (c *PostgresClient) InsertBookmark(bookmark *Bookmark) error {
-	tx := c.database.Create(bookmark)
-	return tx.Error
-}
-
-func (c *PostgresClient) DeleteBookmark(bookmark *Bookmark) error {
-	tx := c.database.Delete(bookmark)
-	return tx.Error
-}
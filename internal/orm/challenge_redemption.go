@@ -0,0 +1,40 @@
+package orm
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+type ChallengeRedemption struct {
+	Nonce     string `gorm:"primaryKey"`
+	ExpiresAt time.Time
+}
+
+func (c *ChallengeRedemption) TableName() string {
+	return "challenge_redemption"
+}
+
+// RedeemChallenge records a solved challenge. Returns false if it has been
+// redeemed before.
+func (c *PostgresClient) RedeemChallenge(nonce string, expiresAt time.Time) (bool, error) {
+	tx := c.database.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&ChallengeRedemption{
+			Nonce:     nonce,
+			ExpiresAt: expiresAt,
+		})
+
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	return tx.RowsAffected == 1, nil
+}
+
+func (c *PostgresClient) DeleteExpiredChallengeRedemptions() error {
+	tx := c.database.
+		Where("expires_at < ?", time.Now()).
+		Delete(&ChallengeRedemption{})
+	return tx.Error
+}
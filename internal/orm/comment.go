@@ -20,6 +20,9 @@ type Comment struct {
 	LikeCount       int
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
+	DeletedAt       *time.Time
+	DeletedBy       *uuid.UUID
+	DeletionReason  string
 }
 
 func (c *Comment) TableName() string {
@@ -31,14 +34,6 @@ func (c *Comment) BeforeCreate(transaction *gorm.DB) error {
 	return nil
 }
 
-func (c Comment) GetID() uuid.UUID {
-	return c.ID
-}
-
-func (c Comment) GetCreatedAt() time.Time {
-	return c.CreatedAt
-}
-
 func (c *PostgresClient) SelectCommentByID(id string) (*Comment, error) {
 	var comment Comment
 	tx := c.database.
@@ -52,6 +47,7 @@ func (c *PostgresClient) SelectCommentByID(id string) (*Comment, error) {
 			"updated_at",
 		}).
 		Where("id = ?", id).
+		Where(visibleComment).
 		Preload("Post").
 		Preload("Author").
 		First(&comment)
@@ -63,8 +59,10 @@ func (c *PostgresClient) SelectCommentByID(id string) (*Comment, error) {
 	return &comment, nil
 }
 
-func (c *PostgresClient) SelectCommentsWithPagination(post_id string, author_id string, limit int, cursor string) ([]*Comment, error) {
-	var comments []*Comment
+// SelectCommentsWithPagination lists comments newest first. A thread, listed
+// by post only, keeps deleted comments that have replies as placeholders
+// with DELETED_COMMENT_CONTENT and no author.
+func (c *PostgresClient) SelectCommentsWithPagination(post_id string, author_id string, limit int, cursor string) ([]*Comment, lib.PageInfo, error) {
 	query := c.database.
 		Select([]string{
 			"id",
@@ -72,12 +70,14 @@ func (c *PostgresClient) SelectCommentsWithPagination(post_id string, author_id
 			"post_id",
 			"author_id",
 			"content",
+			"like_count",
 			"created_at",
 			"updated_at",
+			"deleted_at",
 		}).
+		Where("post_id NOT IN (" + hiddenPostIDs + ")").
 		Preload("Post").
-		Preload("Author").
-		Order("created_at DESC, id DESC")
+		Preload("Author")
 
 	if post_id != "" {
 		query = query.Where("post_id = ?", post_id)
@@ -87,30 +87,134 @@ func (c *PostgresClient) SelectCommentsWithPagination(post_id string, author_id
 		query = query.Where("author_id = ?", author_id)
 	}
 
-	paginatedQuery, err := lib.Paginate[Comment](c.database, query, cursor, limit)
+	if post_id != "" && author_id == "" {
+		query = query.Where("deleted_at IS NULL OR " + hasReplies)
+	} else {
+		query = query.Where("deleted_at IS NULL")
+	}
+
+	comments, page, err := lib.Paginate(query, NewestFirst, func(comment *Comment) []interface{} {
+		return createdAtAndID(comment.CreatedAt, comment.ID)
+	}, cursor, limit)
 	if err != nil {
-		return nil, err
+		return nil, lib.PageInfo{}, err
 	}
 
-	tx := paginatedQuery.Find(&comments)
-	if tx.Error != nil {
-		return nil, tx.Error
+	for _, comment := range comments {
+		if comment.DeletedAt != nil {
+			comment.AuthorID = uuid.Nil
+			comment.Author = User{}
+			comment.Content = DELETED_COMMENT_CONTENT
+		}
 	}
 
-	return comments, nil
+	return comments, page, nil
 }
 
+// InsertComment stores a comment, increments the post comment counter and
+// adds it to the community rating.
 func (c *PostgresClient) InsertComment(comment *Comment) error {
-	transaction := c.database.Create(comment)
-	return transaction.Error
+	return c.database.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(comment).Error
+		if err != nil {
+			return err
+		}
+
+		err = updateCounter(tx, &Post{}, comment.PostID, "comment_count", 1)
+		if err != nil {
+			return err
+		}
+
+		return insertCommentReputation(tx, comment)
+	})
 }
 
+// UpdateComment saves the comment content. The like counter is only ever
+// changed by InsertCommentLike and DeleteCommentLike.
 func (c *PostgresClient) UpdateComment(comment *Comment) error {
-	tx := c.database.Model(comment).Omit("Post").Omit("Author").Updates(comment)
+	tx := c.database.Model(comment).Omit("Post", "Author", "LikeCount").Updates(comment)
 	return tx.Error
 }
 
-func (c *PostgresClient) DeleteComment(comment *Comment) error {
-	tx := c.database.Delete(comment)
-	return tx.Error
+// DeleteComment marks a comment deleted, decrements the post comment counter
+// and takes back the reputation the comment and its likes gave. The comment
+// is kept for RestoreComment until PurgeDeletedComments removes it.
+func (c *PostgresClient) DeleteComment(comment *Comment, deletedBy uuid.UUID, reason string) error {
+	return c.database.Transaction(func(tx *gorm.DB) error {
+		deleted, err := softDelete(tx, &Comment{}, comment.ID, deletedBy, reason)
+		if err != nil || !deleted {
+			return err
+		}
+
+		err = updateCounter(tx, &Post{}, comment.PostID, "comment_count", -1)
+		if err != nil {
+			return err
+		}
+
+		err = revertReputationEvents(tx, []uuid.UUID{comment.ID})
+		if err != nil {
+			return err
+		}
+
+		return revertReputationEvents(tx, tx.Model(&CommentLike{}).Select("id").Where("comment_id = ?", comment.ID))
+	})
+}
+
+// SelectDeletedCommentByID finds a deleted comment that has not been purged
+// yet.
+func (c *PostgresClient) SelectDeletedCommentByID(id string) (*Comment, error) {
+	var comment Comment
+	tx := c.database.
+		Select([]string{
+			"id",
+			"parent_comment_id",
+			"post_id",
+			"author_id",
+			"deleted_at",
+			"deleted_by",
+			"deletion_reason",
+		}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		First(&comment)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &comment, nil
+}
+
+// RestoreComment undoes DeleteComment within the retention window: the
+// comment counter and the reputation of the comment and its likes come
+// back. Returns gorm.ErrRecordNotFound if the comment cannot be restored.
+func (c *PostgresClient) RestoreComment(comment *Comment) error {
+	return c.database.Transaction(func(tx *gorm.DB) error {
+		err := restoreDeleted(tx, &Comment{}, comment.ID)
+		if err != nil {
+			return err
+		}
+
+		err = updateCounter(tx, &Post{}, comment.PostID, "comment_count", 1)
+		if err != nil {
+			return err
+		}
+
+		return restoreCommentReputation(tx, comment)
+	})
+}
+
+// SelectCommentsByAuthorID returns every comment of the author, including
+// deleted ones that have not been purged yet.
+func (c *PostgresClient) SelectCommentsByAuthorID(authorID string) ([]*Comment, error) {
+	var comments []*Comment
+	tx := c.database.
+		Where("author_id = ?", authorID).
+		Order("created_at").
+		Find(&comments)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return comments, nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type CommentLike struct {
@@ -42,12 +43,64 @@ func (c *PostgresClient) SelectCommentLikeByID(commentID string, userID string)
 	return &commentLike, nil
 }
 
-func (c *PostgresClient) InsertCommentLike(commentLike *CommentLike) error {
-	tx := c.database.Create(commentLike)
-	return tx.Error
+// InsertCommentLike stores a like, increments the comment like counter
+// and credits the author's reputation.
+// Returns false, and changes nothing, if the user already liked the comment.
+func (c *PostgresClient) InsertCommentLike(commentLike *CommentLike) (bool, error) {
+	inserted := false
+	err := c.database.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(commentLike)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		inserted = true
+		err := updateCounter(tx, &Comment{}, commentLike.CommentID, "like_count", 1)
+		if err != nil {
+			return err
+		}
+
+		return insertCommentLikeReputation(tx, commentLike)
+	})
+	return inserted, err
+}
+
+// DeleteCommentLike removes a like, decrements the comment like counter
+// and takes back the reputation it gave.
+// Returns false if the user has not liked the comment.
+func (c *PostgresClient) DeleteCommentLike(commentID uuid.UUID, userID uuid.UUID) (bool, error) {
+	deleted := false
+	err := c.database.Transaction(func(tx *gorm.DB) error {
+		var commentLike CommentLike
+		result := tx.
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+			Where("comment_id = ? AND user_id = ?", commentID, userID).
+			Delete(&commentLike)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		deleted = true
+		err := updateCounter(tx, &Comment{}, commentID, "like_count", -1)
+		if err != nil {
+			return err
+		}
+
+		return revertReputationEvents(tx, []uuid.UUID{commentLike.ID})
+	})
+	return deleted, err
 }
 
-func (c *PostgresClient) DeleteCommentLike(commentLike *CommentLike) error {
-	tx := c.database.Delete(commentLike)
-	return tx.Error
+func (c *PostgresClient) SelectCommentLikesByUserID(userID string) ([]*CommentLike, error) {
+	var likes []*CommentLike
+	tx := c.database.
+		Where("user_id = ?", userID).
+		Order("created_at").
+		Find(&likes)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return likes, nil
 }
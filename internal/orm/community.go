@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/stormhead-org/backend/internal/lib"
 	"gorm.io/gorm"
 )
 
@@ -17,11 +18,18 @@ type Community struct {
 	Rules       string
 	IsBanned    bool
 	BanReason   string
-	MemberCount int       `gorm:"default:0"`
-	PostCount   int       `gorm:"default:0"`
-	Reputation  int       `gorm:"default:0"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	MemberCount int     `gorm:"default:0"`
+	PostCount   int     `gorm:"default:0"`
+	Reputation  float64 `gorm:"default:0"`
+	// ArchivedAt is set when the owner deleted their account and no member
+	// was left to take over. An archived community stays readable but is
+	// hidden from listings and takes no new members, posts or changes.
+	ArchivedAt     *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
+	DeletedBy      *uuid.UUID
+	DeletionReason string
 }
 
 func (c *Community) TableName() string {
@@ -52,10 +60,11 @@ func (c *PostgresClient) SelectCommunityByID(id string) (*Community, error) {
 			"member_count",
 			"post_count",
 			"reputation",
+			"archived_at",
 			"created_at",
 			"updated_at",
 		}).
-		Where("id = ?", id).
+		Where("id = ? AND deleted_at IS NULL", id).
 		First(&community)
 
 	if tx.Error != nil {
@@ -65,6 +74,8 @@ func (c *PostgresClient) SelectCommunityByID(id string) (*Community, error) {
 	return &community, nil
 }
 
+// SelectCommunityBySlug also finds deleted communities, their slug stays
+// taken until they are purged.
 func (c *PostgresClient) SelectCommunityBySlug(slug string) (*Community, error) {
 	var community Community
 	tx := c.database.
@@ -80,6 +91,7 @@ func (c *PostgresClient) SelectCommunityBySlug(slug string) (*Community, error)
 			"member_count",
 			"post_count",
 			"reputation",
+			"archived_at",
 			"created_at",
 			"updated_at",
 		}).
@@ -108,10 +120,11 @@ func (c *PostgresClient) SelectCommunityByName(name string) (*Community, error)
 			"member_count",
 			"post_count",
 			"reputation",
+			"archived_at",
 			"created_at",
 			"updated_at",
 		}).
-		Where("name = ?", name).
+		Where("name = ? AND deleted_at IS NULL", name).
 		First(&community)
 
 	if tx.Error != nil {
@@ -121,8 +134,9 @@ func (c *PostgresClient) SelectCommunityByName(name string) (*Community, error)
 	return &community, nil
 }
 
-func (c *PostgresClient) SelectCommunitiesWithPagination(owner_id string, limit int, cursor string) ([]*Community, error) {
-	var communities []*Community
+// SelectCommunitiesWithPagination lists communities newest first, without
+// archived ones.
+func (c *PostgresClient) SelectCommunitiesWithPagination(owner_id string, limit int, cursor string) ([]*Community, lib.PageInfo, error) {
 	query := c.database.
 		Select([]string{
 			"id",
@@ -136,39 +150,19 @@ func (c *PostgresClient) SelectCommunitiesWithPagination(owner_id string, limit
 			"member_count",
 			"post_count",
 			"reputation",
+			"archived_at",
 			"created_at",
 			"updated_at",
 		}).
-		Order("created_at DESC")
+		Where("deleted_at IS NULL AND archived_at IS NULL")
 
 	if owner_id != "" {
 		query = query.Where("owner_id = ?", owner_id)
 	}
 
-	if cursor != "" {
-		var cursorCommunity Community
-		tx := c.database.
-			Where("id = ?", cursor).
-			First(&cursorCommunity)
-
-		if tx.Error != nil {
-			return nil, tx.Error
-		}
-
-		query = query.Where(
-			"(created_at < ?) OR (created_at = ? AND id < ?)",
-			cursorCommunity.CreatedAt,
-			cursorCommunity.CreatedAt,
-			cursorCommunity.ID,
-		)
-	}
-
-	tx := query.Limit(limit).Find(&communities)
-	if tx.Error != nil {
-		return nil, tx.Error
-	}
-
-	return communities, nil
+	return lib.Paginate(query, NewestFirst, func(community *Community) []interface{} {
+		return createdAtAndID(community.CreatedAt, community.ID)
+	}, cursor, limit)
 }
 
 func (c *PostgresClient) InsertCommunity(community *Community) error {
@@ -176,21 +170,57 @@ func (c *PostgresClient) InsertCommunity(community *Community) error {
 	return transaction.Error
 }
 
+// UpdateCommunity saves the community. Counters and the rating are
+// maintained by the membership, post and reputation functions and never
+// overwritten here.
 func (c *PostgresClient) UpdateCommunity(community *Community) error {
-	tx := c.database.Model(community).Updates(community)
+	tx := c.database.Model(community).Omit("MemberCount", "PostCount", "Reputation").Updates(community)
 	return tx.Error
 }
 
-func (c *PostgresClient) DeleteCommunity(community *Community) error {
-	tx := c.database.Delete(community)
-	return tx.Error
+// DeleteCommunity marks a community deleted, which hides it with its posts.
+// Everything is kept for RestoreCommunity until PurgeDeletedCommunities
+// removes it.
+func (c *PostgresClient) DeleteCommunity(community *Community, deletedBy uuid.UUID, reason string) error {
+	_, err := softDelete(c.database, &Community{}, community.ID, deletedBy, reason)
+	return err
+}
+
+// SelectDeletedCommunityByID finds a deleted community that has not been
+// purged yet.
+func (c *PostgresClient) SelectDeletedCommunityByID(id string) (*Community, error) {
+	var community Community
+	tx := c.database.
+		Select([]string{
+			"id",
+			"owner_id",
+			"slug",
+			"name",
+			"deleted_at",
+			"deleted_by",
+			"deletion_reason",
+		}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		First(&community)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &community, nil
+}
+
+// RestoreCommunity undoes DeleteCommunity within the retention window.
+// Returns gorm.ErrRecordNotFound if the community cannot be restored.
+func (c *PostgresClient) RestoreCommunity(community *Community) error {
+	return restoreDeleted(c.database, &Community{}, community.ID)
 }
 
 func (c *PostgresClient) CountPostLikesInCommunity(communityID uuid.UUID) (int64, error) {
 	var count int64
 	tx := c.database.Model(&PostLike{}).
 		Joins("JOIN post ON post.id = post_like.post_id").
-		Where("post.community_id = ?", communityID).
+		Where("post.community_id = ? AND post.deleted_at IS NULL", communityID).
 		Count(&count)
 	return count, tx.Error
 }
@@ -199,7 +229,7 @@ func (c *PostgresClient) CountCommentsInCommunity(communityID uuid.UUID) (int64,
 	var count int64
 	tx := c.database.Model(&Comment{}).
 		Joins("JOIN post ON post.id = comment.post_id").
-		Where("post.community_id = ?", communityID).
+		Where("post.community_id = ? AND post.deleted_at IS NULL AND comment.deleted_at IS NULL", communityID).
 		Count(&count)
 	return count, tx.Error
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type CommunityUser struct {
@@ -29,7 +30,10 @@ func (c *PostgresClient) SelectCommunityUser(communityID string, userID string)
 	tx := c.database.
 		Select(
 			[]string{
-				"id",
+				"community_id",
+				"user_id",
+				"created_at",
+				"updated_at",
 			},
 		).
 		Where("community_id = ? AND user_id = ?", communityID, userID).
@@ -42,12 +46,51 @@ func (c *PostgresClient) SelectCommunityUser(communityID string, userID string)
 	return &communityUser, nil
 }
 
-func (c *PostgresClient) InsertCommunityUser(communityUser *CommunityUser) error {
-	tx := c.database.Create(communityUser)
-	return tx.Error
+// InsertCommunityUser adds a member and increments the community member
+// counter. Returns false, and changes nothing, if the user is a member
+// already.
+func (c *PostgresClient) InsertCommunityUser(communityUser *CommunityUser) (bool, error) {
+	inserted := false
+	err := c.database.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(communityUser)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		inserted = true
+		return updateCounter(tx, &Community{}, communityUser.CommunityID, "member_count", 1)
+	})
+	return inserted, err
 }
 
-func (c *PostgresClient) DeleteCommunityUser(communityUser *CommunityUser) error {
-	tx := c.database.Delete(communityUser)
-	return tx.Error
+// DeleteCommunityUser removes a member and decrements the community member
+// counter. Returns false if the user is not a member.
+func (c *PostgresClient) DeleteCommunityUser(communityID uuid.UUID, userID uuid.UUID) (bool, error) {
+	deleted := false
+	err := c.database.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("community_id = ? AND user_id = ?", communityID, userID).Delete(&CommunityUser{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		deleted = true
+		return updateCounter(tx, &Community{}, communityID, "member_count", -1)
+	})
+	return deleted, err
+}
+
+func (c *PostgresClient) SelectCommunityUsersByUserID(userID string) ([]*CommunityUser, error) {
+	var communityUsers []*CommunityUser
+	tx := c.database.
+		Preload("Community").
+		Where("user_id = ?", userID).
+		Where("community_id NOT IN (" + deletedCommunityIDs + ")").
+		Order("created_at").
+		Find(&communityUsers)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return communityUsers, nil
 }
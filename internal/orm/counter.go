@@ -0,0 +1,122 @@
+package orm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CounterDrift is how far a stored counter was from the value recomputed
+// from its source table before reconciliation fixed it.
+type CounterDrift struct {
+	Counter string // table.column
+	Rows    int64  // rows that had a wrong value
+	Delta   int64  // sum of absolute differences
+}
+
+// counterSource describes a denormalized counter: table.column counts the
+// rows of source whose key points at the counter row and match filter.
+type counterSource struct {
+	table  string
+	column string
+	source string
+	key    string
+	filter string
+}
+
+var reconciledCounters = []counterSource{
+	{"post", "like_count", "post_like", "post_id", "TRUE"},
+	{"post", "comment_count", "comment", "post_id", "s.deleted_at IS NULL"},
+	{"comment", "like_count", "comment_like", "comment_id", "TRUE"},
+	{"community", "member_count", "community_user", "community_id", "TRUE"},
+	{"community", "post_count", "post", "community_id", "s.deleted_at IS NULL"},
+}
+
+// updateCounter atomically adds delta to a counter column, so concurrent
+// requests cannot lose each other's updates. updated_at is left alone.
+func updateCounter(tx *gorm.DB, model interface{}, ID uuid.UUID, column string, delta int) error {
+	return tx.
+		Model(model).
+		Where("id = ?", ID).
+		UpdateColumn(column, gorm.Expr(column+" + ?", delta)).
+		Error
+}
+
+// subtractCounters lowers a counter column of every row by how many times
+// the row's id is returned by counted, a query selecting one "id" per
+// counted row. Each row goes through updateCounter.
+func subtractCounters(tx *gorm.DB, model interface{}, column string, counted *gorm.DB) error {
+	var rows []struct {
+		ID    uuid.UUID
+		Count int
+	}
+	err := tx.
+		Table("(?) AS counted", counted).
+		Select("id, count(*) AS count").
+		Group("id").
+		Scan(&rows).
+		Error
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		err = updateCounter(tx, model, row.ID, column, -row.Count)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReconcileCounters recomputes every denormalized counter from its source
+// table, fixes wrong values and reports the drift found.
+//
+// Each counter is fixed in its own repeatable read transaction: a row
+// changed concurrently fails the update with a serialization error and the
+// counter is recomputed, instead of overwriting the concurrent increment
+// with a stale count.
+func (c *PostgresClient) ReconcileCounters(ctx context.Context) ([]CounterDrift, error) {
+	drifts := make([]CounterDrift, 0, len(reconciledCounters))
+	for _, counter := range reconciledCounters {
+		drift := CounterDrift{
+			Counter: counter.table + "." + counter.column,
+		}
+
+		err := c.withTx(ctx, func(tx *PostgresClient) error {
+			err := tx.database.Exec("SET TRANSACTION ISOLATION LEVEL REPEATABLE READ").Error
+			if err != nil {
+				return err
+			}
+
+			query := fmt.Sprintf(
+				`WITH actual AS (
+					SELECT t.id, t.%[2]s AS stored, (SELECT count(*) FROM %[3]q s WHERE s.%[4]s = t.id AND %[5]s) AS value
+					FROM %[1]q t
+				), fixed AS (
+					UPDATE %[1]q t SET %[2]s = actual.value
+					FROM actual
+					WHERE t.id = actual.id AND t.%[2]s <> actual.value
+					RETURNING abs(actual.value - actual.stored) AS delta
+				)
+				SELECT count(*), coalesce(sum(delta), 0)::bigint FROM fixed`,
+				counter.table,
+				counter.column,
+				counter.source,
+				counter.key,
+				counter.filter,
+			)
+			return tx.database.Raw(query).Row().Scan(&drift.Rows, &drift.Delta)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		drifts = append(drifts, drift)
+	}
+
+	return drifts, nil
+}
@@ -0,0 +1,154 @@
+package orm
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrDataExportTooSoon = errors.New("data export already requested recently")
+
+const DATA_EXPORT_STATUS_PENDING = "pending"
+const DATA_EXPORT_STATUS_PROCESSING = "processing"
+const DATA_EXPORT_STATUS_READY = "ready"
+const DATA_EXPORT_STATUS_FAILED = "failed"
+
+// DataExport is an archive of everything stored about a user, assembled by
+// the worker and uploaded to S3 under ObjectKey.
+type DataExport struct {
+	ID          uuid.UUID `gorm:"primaryKey"`
+	UserID      uuid.UUID
+	Status      string
+	Progress    int // percent
+	ObjectKey   string
+	ExpiresAt   *time.Time // when the download link stops working
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (e *DataExport) TableName() string {
+	return "data_export"
+}
+
+func (e *DataExport) BeforeCreate(transaction *gorm.DB) error {
+	e.ID = uuid.New()
+	return nil
+}
+
+func (c *PostgresClient) SelectDataExportByID(ID string) (*DataExport, error) {
+	var export DataExport
+	tx := c.database.
+		Where("id = ?", ID).
+		First(&export)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &export, nil
+}
+
+func (c *PostgresClient) SelectLatestDataExportByUserID(userID string) (*DataExport, error) {
+	var export DataExport
+	tx := c.database.
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		First(&export)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &export, nil
+}
+
+// InsertDataExport stores a new pending export unless the user has another
+// one, not failed, created less than interval ago; then it returns
+// ErrDataExportTooSoon. The user row is locked so concurrent requests
+// cannot both pass the check.
+func (c *PostgresClient) InsertDataExport(export *DataExport, interval time.Duration) error {
+	return c.database.Transaction(func(tx *gorm.DB) error {
+		var user User
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select([]string{"id"}).
+			Where("id = ?", export.UserID).
+			First(&user).
+			Error
+		if err != nil {
+			return err
+		}
+
+		var count int64
+		err = tx.
+			Model(&DataExport{}).
+			Where("user_id = ? AND status <> ? AND created_at > ?", export.UserID, DATA_EXPORT_STATUS_FAILED, time.Now().Add(-interval)).
+			Count(&count).
+			Error
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrDataExportTooSoon
+		}
+
+		export.Status = DATA_EXPORT_STATUS_PENDING
+		return tx.Create(export).Error
+	})
+}
+
+// StartDataExport moves a pending export to processing. Returns
+// gorm.ErrRecordNotFound if it is not pending anymore, e.g. when the event
+// is delivered twice.
+func (c *PostgresClient) StartDataExport(ID string) error {
+	tx := c.database.
+		Model(&DataExport{}).
+		Where("id = ? AND status = ?", ID, DATA_EXPORT_STATUS_PENDING).
+		Update("status", DATA_EXPORT_STATUS_PROCESSING)
+
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (c *PostgresClient) UpdateDataExportProgress(ID string, progress int) error {
+	tx := c.database.
+		Model(&DataExport{}).
+		Where("id = ?", ID).
+		Update("progress", progress)
+	return tx.Error
+}
+
+func (c *PostgresClient) CompleteDataExport(ID string, objectKey string, expiresAt time.Time) error {
+	tx := c.database.
+		Model(&DataExport{}).
+		Where("id = ?", ID).
+		Updates(map[string]interface{}{
+			"status":       DATA_EXPORT_STATUS_READY,
+			"progress":     100,
+			"object_key":   objectKey,
+			"expires_at":   expiresAt,
+			"completed_at": time.Now(),
+		})
+	return tx.Error
+}
+
+func (c *PostgresClient) FailDataExport(ID string) error {
+	tx := c.database.
+		Model(&DataExport{}).
+		Where("id = ?", ID).
+		Updates(map[string]interface{}{
+			"status":       DATA_EXPORT_STATUS_FAILED,
+			"completed_at": time.Now(),
+		})
+	return tx.Error
+}
@@ -0,0 +1,184 @@
+package orm
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrEmailTaken = errors.New("email already taken")
+
+// EmailChange is a pending or finished change of a user's email address.
+// The address is only switched once the TOKEN_PURPOSE_EMAIL_CHANGE token
+// sent to the new address is consumed; the TOKEN_PURPOSE_EMAIL_CHANGE_REVERT
+// token sent to the old address cancels the change or switches it back.
+type EmailChange struct {
+	ID          uuid.UUID `gorm:"primaryKey"`
+	UserID      uuid.UUID
+	OldEmail    string
+	NewEmail    string
+	ConfirmedAt *time.Time
+	RevertedAt  *time.Time
+	CreatedAt   time.Time
+}
+
+func (c *EmailChange) TableName() string {
+	return "email_change"
+}
+
+func (c *EmailChange) BeforeCreate(transaction *gorm.DB) error {
+	c.ID = uuid.New()
+	return nil
+}
+
+func (c *PostgresClient) SelectEmailChangeByID(ID string) (*EmailChange, error) {
+	var change EmailChange
+	tx := c.database.
+		Where("id = ?", ID).
+		First(&change)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &change, nil
+}
+
+// InsertEmailChange stores a new email change with its confirmation and
+// revert tokens and drops the user's other unconfirmed changes, so only the
+// latest confirmation link works. Revert tokens of confirmed changes are
+// kept until they expire.
+func (c *PostgresClient) InsertEmailChange(change *EmailChange, token *OneTimeToken, revertToken *OneTimeToken) error {
+	return c.database.Transaction(func(tx *gorm.DB) error {
+		pending := tx.
+			Model(&EmailChange{}).
+			Select("id").
+			Where("user_id = ? AND confirmed_at IS NULL AND reverted_at IS NULL", change.UserID)
+
+		err := tx.
+			Where("subject_id IN (?)", pending).
+			Delete(&OneTimeToken{}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.
+			Where("user_id = ? AND confirmed_at IS NULL AND reverted_at IS NULL", change.UserID).
+			Delete(&EmailChange{}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(change).Error
+		if err != nil {
+			return err
+		}
+
+		token.SubjectID = &change.ID
+		revertToken.SubjectID = &change.ID
+		return tx.Create([]*OneTimeToken{token, revertToken}).Error
+	})
+}
+
+// ConfirmEmailChange consumes a confirmation token and switches the user to
+// the new address. The user's address must still be the one the change was
+// requested from; on failure the token stays usable.
+func (c *PostgresClient) ConfirmEmailChange(tokenHash string) (*EmailChange, error) {
+	var change EmailChange
+	err := c.database.Transaction(func(tx *gorm.DB) error {
+		token, err := consumeOneTimeToken(tx, TOKEN_PURPOSE_EMAIL_CHANGE, tokenHash)
+		if err != nil {
+			return err
+		}
+
+		result := tx.
+			Model(&change).
+			Clauses(clause.Returning{}).
+			Where("id = ? AND confirmed_at IS NULL AND reverted_at IS NULL", token.SubjectID).
+			Update("confirmed_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return updateUserEmail(tx, change.UserID, change.OldEmail, change.NewEmail)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &change, nil
+}
+
+// RevertEmailChange consumes a revert token and cancels the email change,
+// switching the user back to the old address if it was already confirmed.
+func (c *PostgresClient) RevertEmailChange(revertTokenHash string) (*EmailChange, error) {
+	var change EmailChange
+	err := c.database.Transaction(func(tx *gorm.DB) error {
+		token, err := consumeOneTimeToken(tx, TOKEN_PURPOSE_EMAIL_CHANGE_REVERT, revertTokenHash)
+		if err != nil {
+			return err
+		}
+
+		result := tx.
+			Model(&change).
+			Clauses(clause.Returning{}).
+			Where("id = ? AND reverted_at IS NULL", token.SubjectID).
+			Update("reverted_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if change.ConfirmedAt == nil {
+			return nil
+		}
+
+		return updateUserEmail(tx, change.UserID, change.NewEmail, change.OldEmail)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &change, nil
+}
+
+// updateUserEmail switches the user's email from one address to another,
+// failing if the address changed in the meantime or the new one has been
+// taken by another account.
+func updateUserEmail(tx *gorm.DB, userID uuid.UUID, from string, to string) error {
+	var count int64
+	err := tx.
+		Model(&User{}).
+		Where("email = ? AND id <> ?", to, userID).
+		Count(&count).
+		Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrEmailTaken
+	}
+
+	result := tx.
+		Model(&User{}).
+		Where("id = ? AND email = ?", userID, from).
+		Update("email", to)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
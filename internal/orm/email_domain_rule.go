@@ -0,0 +1,65 @@
+package orm
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const EMAIL_DOMAIN_RULE_ALLOW = "allow"
+const EMAIL_DOMAIN_RULE_DENY = "deny"
+
+// EmailDomainRule allows or denies registration with addresses at Domain
+// and its subdomains, see lib.CheckEmailDomain.
+type EmailDomainRule struct {
+	Domain    string `gorm:"primaryKey"`
+	Kind      string
+	CreatedBy *uuid.UUID
+	CreatedAt time.Time
+}
+
+func (r *EmailDomainRule) TableName() string {
+	return "email_domain_rule"
+}
+
+func (c *PostgresClient) SelectEmailDomainRules() ([]*EmailDomainRule, error) {
+	var rules []*EmailDomainRule
+	tx := c.database.
+		Order("domain ASC").
+		Find(&rules)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return rules, nil
+}
+
+// UpsertEmailDomainRule creates the rule or changes the kind of an existing
+// rule for the same domain.
+func (c *PostgresClient) UpsertEmailDomainRule(rule *EmailDomainRule) error {
+	tx := c.database.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "domain"}},
+			DoUpdates: clause.AssignmentColumns([]string{"kind", "created_by", "created_at"}),
+		}).
+		Create(rule)
+	return tx.Error
+}
+
+func (c *PostgresClient) DeleteEmailDomainRule(domain string) error {
+	tx := c.database.
+		Where("domain = ?", domain).
+		Delete(&EmailDomainRule{})
+
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
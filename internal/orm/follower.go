@@ -4,7 +4,9 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/stormhead-org/backend/internal/lib"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Follower struct {
@@ -42,16 +44,17 @@ func (c *PostgresClient) SelectFollowerByID(userID string, followerID string) (*
 	return &Follower, nil
 }
 
-func (c *PostgresClient) SelectFollowersWithPagination(userID string, followerID string, limit int, cursor string) ([]*Follower, error) {
-	var followers []*Follower
+// SelectFollowersWithPagination lists follows newest first.
+func (c *PostgresClient) SelectFollowersWithPagination(userID string, followerID string, limit int, cursor string) ([]*Follower, lib.PageInfo, error) {
 	query := c.database.
 		Select([]string{
+			"id",
 			"user_id",
 			"follower_id",
+			"created_at",
 		}).
 		Preload("User").
-		Preload("Follower").
-		Order("created_at DESC")
+		Preload("Follower")
 
 	if userID != "" {
 		query = query.Where("user_id = ?", userID)
@@ -61,38 +64,39 @@ func (c *PostgresClient) SelectFollowersWithPagination(userID string, followerID
 		query = query.Where("follower_id = ?", followerID)
 	}
 
-	if cursor != "" {
-		var cursorFollower Follower
-		tx := c.database.
-			Where("user_id = ?", cursor).
-			First(&cursorFollower)
-
-		if tx.Error != nil {
-			return nil, tx.Error
-		}
-
-		query = query.Where(
-			"(created_at < ?) OR (created_at = ? AND id < ?)",
-			cursorFollower.CreatedAt,
-			cursorFollower.CreatedAt,
-			cursorFollower.ID,
-		)
-	}
-
-	tx := query.Limit(limit).Find(&followers)
-	if tx.Error != nil {
-		return nil, tx.Error
-	}
+	return lib.Paginate(query, NewestFirst, func(follower *Follower) []interface{} {
+		return createdAtAndID(follower.CreatedAt, follower.ID)
+	}, cursor, limit)
+}
 
-	return followers, nil
+// InsertFollower stores a follow. Returns false, and changes nothing, if
+// the same user_id and follower_id pair is already stored.
+func (c *PostgresClient) InsertFollower(follower *Follower) (bool, error) {
+	tx := c.database.Clauses(clause.OnConflict{DoNothing: true}).Create(follower)
+	return tx.RowsAffected == 1, tx.Error
 }
 
-func (c *PostgresClient) InsertFollower(follower *Follower) error {
-	tx := c.database.Create(follower)
-	return tx.Error
+// DeleteFollower removes the follow with the given user_id and
+// follower_id. Returns false if there is none.
+func (c *PostgresClient) DeleteFollower(userID uuid.UUID, followerID uuid.UUID) (bool, error) {
+	tx := c.database.
+		Where("user_id = ? AND follower_id = ?", userID, followerID).
+		Delete(&Follower{})
+	return tx.RowsAffected == 1, tx.Error
 }
 
-func (c *PostgresClient) DeleteFollower(follower *Follower) error {
-	tx := c.database.Delete(follower)
-	return tx.Error
+// SelectFollowsByUserID returns follows in both directions: users following
+// userID and users userID follows.
+func (c *PostgresClient) SelectFollowsByUserID(userID string) ([]*Follower, error) {
+	var follows []*Follower
+	tx := c.database.
+		Where("user_id = ? OR follower_id = ?", userID, userID).
+		Order("created_at").
+		Find(&follows)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return follows, nil
 }
@@ -0,0 +1,121 @@
+package orm
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stormhead-org/backend/internal/lib"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrInviteCodeInvalid = errors.New("invite code is invalid, expired or used up")
+
+type InviteCode struct {
+	ID         uuid.UUID `gorm:"primaryKey"`
+	CodeHash   string
+	CodePrefix string
+	CreatedBy  *uuid.UUID
+	MaxUses    *int // nil means unlimited
+	UseCount   int
+	ExpiresAt  *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+func (c *InviteCode) TableName() string {
+	return "invite_code"
+}
+
+func (c *InviteCode) BeforeCreate(transaction *gorm.DB) error {
+	c.ID = uuid.New()
+	return nil
+}
+
+// IsActive reports whether the code can still be used.
+func (c *InviteCode) IsActive(now time.Time) bool {
+	if c.RevokedAt != nil {
+		return false
+	}
+	if c.ExpiresAt != nil && !now.Before(*c.ExpiresAt) {
+		return false
+	}
+	return c.MaxUses == nil || c.UseCount < *c.MaxUses
+}
+
+func (c *PostgresClient) SelectInviteCodeByID(ID string) (*InviteCode, error) {
+	var code InviteCode
+	tx := c.database.
+		Where("id = ?", ID).
+		First(&code)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &code, nil
+}
+
+// SelectInviteCodesWithPagination lists invite codes newest first.
+func (c *PostgresClient) SelectInviteCodesWithPagination(limit int, cursor string) ([]*InviteCode, lib.PageInfo, error) {
+	return lib.Paginate(c.database, NewestFirst, func(code *InviteCode) []interface{} {
+		return createdAtAndID(code.CreatedAt, code.ID)
+	}, cursor, limit)
+}
+
+func (c *PostgresClient) InsertInviteCode(code *InviteCode) error {
+	tx := c.database.Create(code)
+	return tx.Error
+}
+
+// RevokeInviteCode stops a code from being used. Returns
+// gorm.ErrRecordNotFound if there is no such code or it is already revoked.
+func (c *PostgresClient) RevokeInviteCode(ID string) error {
+	tx := c.database.
+		Model(&InviteCode{}).
+		Where("id = ? AND revoked_at IS NULL", ID).
+		Update("revoked_at", time.Now())
+
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// InsertUserWithInviteCode creates the user and spends one use of the invite
+// code in the same transaction, so a failed registration does not waste it
+// and concurrent registrations cannot exceed max_uses. Returns
+// ErrInviteCodeInvalid if the code cannot be used and ErrUserSlugTaken like
+// InsertUser.
+func (c *PostgresClient) InsertUserWithInviteCode(user *User, codeHash string) error {
+	return c.database.Transaction(func(tx *gorm.DB) error {
+		err := claimUserSlug(tx, user.SlugSkeleton, "")
+		if err != nil {
+			return err
+		}
+
+		var code InviteCode
+		result := tx.
+			Model(&code).
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+			Where("code_hash = ?", codeHash).
+			Where("revoked_at IS NULL").
+			Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+			Where("max_uses IS NULL OR use_count < max_uses").
+			Update("use_count", gorm.Expr("use_count + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrInviteCodeInvalid
+		}
+
+		user.InviteCodeID = &code.ID
+		return tx.Create(user).Error
+	})
+}
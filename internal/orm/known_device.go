@@ -0,0 +1,57 @@
+package orm
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+)
+
+// KnownDevice is a device and location combination a user has logged in
+// from, see lib.DeviceFingerprint.
+type KnownDevice struct {
+	UserID      uuid.UUID `gorm:"primaryKey"`
+	Fingerprint string    `gorm:"primaryKey"`
+	CreatedAt   time.Time
+	LastSeenAt  time.Time
+}
+
+func (d *KnownDevice) TableName() string {
+	return "known_device"
+}
+
+// RecordKnownDevice remembers the fingerprint for the user. It reports
+// whether the fingerprint is new and whether it is the first one recorded
+// for the user at all.
+func (c *PostgresClient) RecordKnownDevice(userID uuid.UUID, fingerprint string) (bool, bool, error) {
+	var count int64
+	tx := c.database.
+		Model(&KnownDevice{}).
+		Where("user_id = ?", userID).
+		Count(&count)
+	if tx.Error != nil {
+		return false, false, tx.Error
+	}
+
+	now := time.Now()
+	tx = c.database.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&KnownDevice{
+			UserID:      userID,
+			Fingerprint: fingerprint,
+			CreatedAt:   now,
+			LastSeenAt:  now,
+		})
+	if tx.Error != nil {
+		return false, false, tx.Error
+	}
+	if tx.RowsAffected > 0 {
+		return true, count == 0, nil
+	}
+
+	tx = c.database.
+		Model(&KnownDevice{}).
+		Where("user_id = ? AND fingerprint = ?", userID, fingerprint).
+		Update("last_seen_at", now)
+	return false, false, tx.Error
+}
@@ -0,0 +1,74 @@
+package orm
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stormhead-org/backend/internal/lib"
+	"gorm.io/gorm"
+)
+
+type LoginLockout struct {
+	ID           uuid.UUID `gorm:"primaryKey"`
+	Kind         string
+	UserID       *uuid.UUID
+	Email        string
+	IpAddress    string
+	Key          string // the throttle key, Email or IpAddress by kind
+	FailureCount int
+	LockedUntil  time.Time
+	CreatedAt    time.Time
+}
+
+func (c *LoginLockout) TableName() string {
+	return "login_lockout"
+}
+
+func (c *LoginLockout) BeforeCreate(transaction *gorm.DB) error {
+	c.ID = uuid.New()
+	return nil
+}
+
+// SelectLoginLockoutsWithPagination lists lockouts newest first.
+func (c *PostgresClient) SelectLoginLockoutsWithPagination(limit int, cursor string) ([]*LoginLockout, lib.PageInfo, error) {
+	return lib.Paginate(c.database, NewestFirst, func(lockout *LoginLockout) []interface{} {
+		return createdAtAndID(lockout.CreatedAt, lockout.ID)
+	}, cursor, limit)
+}
+
+func (c *PostgresClient) InsertLoginLockout(lockout *LoginLockout) error {
+	tx := c.database.Create(lockout)
+	return tx.Error
+}
+
+// InsertLoginLockoutUnlessActive records the lockout unless one of the same
+// kind and key is still active, and reports whether it did. The check and
+// the insert hold an advisory lock on the kind and key, so concurrent
+// failures crossing the threshold record a single lockout.
+func (c *PostgresClient) InsertLoginLockoutUnlessActive(lockout *LoginLockout) (bool, error) {
+	inserted := false
+	err := c.database.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?), hashtext(?))", lockout.Kind, lockout.Key).Error
+		if err != nil {
+			return err
+		}
+
+		var active int64
+		err = tx.
+			Model(&LoginLockout{}).
+			Where("kind = ? AND key = ? AND locked_until > ?", lockout.Kind, lockout.Key, time.Now()).
+			Count(&active).
+			Error
+		if err != nil || active > 0 {
+			return err
+		}
+
+		inserted = true
+		return tx.Create(lockout).Error
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return inserted, nil
+}
@@ -0,0 +1,272 @@
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// MIGRATION_TABLE records the applied version. It has the layout of
+// golang-migrate, which applied the migrations before the migrate command,
+// so existing databases carry on from their version.
+const MIGRATION_TABLE = "schema_migrations"
+
+// MIGRATION_LOCK_ID is the key of the advisory lock held while migrating, so
+// pods starting together apply migrations one at a time.
+const MIGRATION_LOCK_ID = 4200314159
+
+var ErrMigrationDirty = errors.New("database is dirty")
+var ErrMigrationUnknown = errors.New("unknown migration version")
+
+var migrationFileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationState is the recorded version, 0 if nothing was applied. Dirty
+// is set by golang-migrate when a migration failed halfway.
+type MigrationState struct {
+	Version uint64
+	Dirty   bool
+}
+
+// LoadMigrations reads NNNNNN_name.up.sql and NNNNNN_name.down.sql pairs
+// from files, ordered by version.
+func LoadMigrations(files fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[uint64]*Migration{}
+	for _, entry := range entries {
+		match := migrationFileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil || version == 0 {
+			return nil, fmt.Errorf("invalid migration version in %s", entry.Name())
+		}
+
+		content, err := fs.ReadFile(files, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has two names, %s and %s", version, migration.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both an up and a down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// SelectMigrationState returns the recorded version, without waiting for a
+// migration in progress.
+func (c *PostgresClient) SelectMigrationState(ctx context.Context) (MigrationState, error) {
+	var state MigrationState
+
+	var table sql.NullString
+	err := c.database.WithContext(ctx).Raw("SELECT to_regclass(?)::text", MIGRATION_TABLE).Row().Scan(&table)
+	if err != nil || !table.Valid {
+		return state, err
+	}
+
+	return selectMigrationState(c.database.WithContext(ctx))
+}
+
+// MigrateUp applies every migration newer than the recorded version and
+// returns the ones applied. Each migration runs in its own transaction
+// together with the version update, a failed one changes nothing.
+func (c *PostgresClient) MigrateUp(ctx context.Context, migrations []Migration) ([]Migration, error) {
+	var applied []Migration
+	err := c.withMigrationLock(ctx, func(conn *gorm.DB, state MigrationState) error {
+		for _, migration := range migrations {
+			if migration.Version <= state.Version {
+				continue
+			}
+
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				err := tx.Exec(migration.Up).Error
+				if err != nil {
+					return err
+				}
+
+				return setMigrationVersion(tx, migration.Version)
+			})
+			if err != nil {
+				return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			applied = append(applied, migration)
+		}
+
+		return nil
+	})
+	return applied, err
+}
+
+// MigrateDown reverts up to steps migrations, newest first, and returns the
+// ones reverted.
+func (c *PostgresClient) MigrateDown(ctx context.Context, migrations []Migration, steps int) ([]Migration, error) {
+	var reverted []Migration
+	err := c.withMigrationLock(ctx, func(conn *gorm.DB, state MigrationState) error {
+		current := len(migrations) - 1
+		for current >= 0 && migrations[current].Version > state.Version {
+			current--
+		}
+		if state.Version != 0 && (current < 0 || migrations[current].Version != state.Version) {
+			return fmt.Errorf("%w %d", ErrMigrationUnknown, state.Version)
+		}
+
+		for ; current >= 0 && len(reverted) < steps; current-- {
+			migration := migrations[current]
+
+			var previous uint64
+			if current > 0 {
+				previous = migrations[current-1].Version
+			}
+
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				err := tx.Exec(migration.Down).Error
+				if err != nil {
+					return err
+				}
+
+				return setMigrationVersion(tx, previous)
+			})
+			if err != nil {
+				return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			reverted = append(reverted, migration)
+		}
+
+		return nil
+	})
+	return reverted, err
+}
+
+// ForceMigrationVersion records version as applied and clears the dirty
+// flag, without running anything. It is the way out of a dirty database once
+// its schema was fixed by hand.
+func (c *PostgresClient) ForceMigrationVersion(ctx context.Context, migrations []Migration, version uint64) error {
+	known := version == 0
+	for _, migration := range migrations {
+		known = known || migration.Version == version
+	}
+	if !known {
+		return fmt.Errorf("%w %d", ErrMigrationUnknown, version)
+	}
+
+	return c.database.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		err := lockMigrations(conn)
+		if err != nil {
+			return err
+		}
+		defer unlockMigrations(conn)
+
+		return setMigrationVersion(conn, version)
+	})
+}
+
+// withMigrationLock runs fn on a single connection holding the migration
+// lock. fn is not called if a failed golang-migrate run left the database
+// dirty.
+func (c *PostgresClient) withMigrationLock(ctx context.Context, fn func(conn *gorm.DB, state MigrationState) error) error {
+	return c.database.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		err := lockMigrations(conn)
+		if err != nil {
+			return err
+		}
+		defer unlockMigrations(conn)
+
+		state, err := selectMigrationState(conn)
+		if err != nil {
+			return err
+		}
+		if state.Dirty {
+			return fmt.Errorf("%w at version %d, fix the schema and run migrate force", ErrMigrationDirty, state.Version)
+		}
+
+		return fn(conn, state)
+	})
+}
+
+func lockMigrations(conn *gorm.DB) error {
+	err := conn.Exec("SELECT pg_advisory_lock(?)", MIGRATION_LOCK_ID).Error
+	if err != nil {
+		return err
+	}
+
+	return conn.Exec(fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %q (version BIGINT NOT NULL PRIMARY KEY, dirty BOOLEAN NOT NULL)",
+		MIGRATION_TABLE,
+	)).Error
+}
+
+// unlockMigrations releases the lock even if the request context is done.
+// Closing the connection would release it too.
+func unlockMigrations(conn *gorm.DB) {
+	conn.WithContext(context.Background()).Exec("SELECT pg_advisory_unlock(?)", MIGRATION_LOCK_ID)
+}
+
+func selectMigrationState(conn *gorm.DB) (MigrationState, error) {
+	var state MigrationState
+	err := conn.
+		Raw(fmt.Sprintf("SELECT version, dirty FROM %q LIMIT 1", MIGRATION_TABLE)).
+		Row().
+		Scan(&state.Version, &state.Dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return MigrationState{}, nil
+	}
+
+	return state, err
+}
+
+func setMigrationVersion(tx *gorm.DB, version uint64) error {
+	err := tx.Exec(fmt.Sprintf("DELETE FROM %q", MIGRATION_TABLE)).Error
+	if err != nil || version == 0 {
+		return err
+	}
+
+	return tx.Exec(
+		fmt.Sprintf("INSERT INTO %q (version, dirty) VALUES (?, FALSE)", MIGRATION_TABLE),
+		version,
+	).Error
+}
@@ -0,0 +1,96 @@
+package orm
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Purposes of one time tokens. A token only ever works for the purpose it
+// was issued for.
+const TOKEN_PURPOSE_VERIFICATION = "verification"
+const TOKEN_PURPOSE_PASSWORD_RESET = "password_reset"
+const TOKEN_PURPOSE_UNLOCK = "unlock"
+const TOKEN_PURPOSE_MAGIC_LINK = "magic_link"
+const TOKEN_PURPOSE_EMAIL_CHANGE = "email_change"
+const TOKEN_PURPOSE_EMAIL_CHANGE_REVERT = "email_change_revert"
+
+// OneTimeToken is a single use secret sent to a user, such as an email
+// verification or password reset link. Only the SHA-256 of the token is
+// stored. SubjectID optionally points at what the token acts on, e.g. an
+// email change.
+type OneTimeToken struct {
+	ID         uuid.UUID `gorm:"primaryKey"`
+	UserID     uuid.UUID
+	Purpose    string
+	SubjectID  *uuid.UUID
+	TokenHash  string
+	ExpiresAt  time.Time
+	ConsumedAt *time.Time
+	CreatedAt  time.Time
+}
+
+func (c *OneTimeToken) TableName() string {
+	return "one_time_token"
+}
+
+func (c *OneTimeToken) BeforeCreate(transaction *gorm.DB) error {
+	c.ID = uuid.New()
+	return nil
+}
+
+// InsertOneTimeToken stores a new token and drops the user's unconsumed
+// tokens of the same purpose, so only the most recently sent link works.
+func (c *PostgresClient) InsertOneTimeToken(token *OneTimeToken) error {
+	return c.database.Transaction(func(tx *gorm.DB) error {
+		return insertOneTimeToken(tx, token)
+	})
+}
+
+// ConsumeOneTimeToken marks an unexpired, unconsumed token as consumed and
+// returns it in a single statement, so a token can only be used once even
+// under concurrent requests.
+func (c *PostgresClient) ConsumeOneTimeToken(purpose string, tokenHash string) (*OneTimeToken, error) {
+	return consumeOneTimeToken(c.database, purpose, tokenHash)
+}
+
+// DeleteOneTimeTokensByUserID drops the user's unconsumed tokens of the
+// given purpose.
+func (c *PostgresClient) DeleteOneTimeTokensByUserID(userID string, purpose string) error {
+	tx := c.database.
+		Where("user_id = ? AND purpose = ? AND consumed_at IS NULL", userID, purpose).
+		Delete(&OneTimeToken{})
+	return tx.Error
+}
+
+func insertOneTimeToken(tx *gorm.DB, token *OneTimeToken) error {
+	err := tx.
+		Where("user_id = ? AND purpose = ? AND consumed_at IS NULL", token.UserID, token.Purpose).
+		Delete(&OneTimeToken{}).
+		Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(token).Error
+}
+
+func consumeOneTimeToken(tx *gorm.DB, purpose string, tokenHash string) (*OneTimeToken, error) {
+	var tokens []OneTimeToken
+	result := tx.
+		Model(&tokens).
+		Clauses(clause.Returning{}).
+		Where("token_hash = ? AND purpose = ? AND consumed_at IS NULL AND expires_at > ?", tokenHash, purpose, time.Now()).
+		Update("consumed_at", time.Now())
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(tokens) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &tokens[0], nil
+}
@@ -0,0 +1,220 @@
+package ormtest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+// epoch is the creation time of rows whose order a test checks, later rows
+// are offset from it by whole minutes.
+var epoch = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// RunStoreConformance checks that a store behaves like the schema and
+// queries of PostgresClient: constraints, cascades, counters, reputation,
+// the order and cursors of lists and transactions. newStore is called for
+// every test and must return an empty store.
+func RunStoreConformance(t *testing.T, newStore func(t *testing.T) ormpkg.Store) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, store ormpkg.Store)
+	}{
+		{"UserLookup", testUserLookup},
+		{"UserUniqueness", testUserUniqueness},
+		{"UserUpdate", testUserUpdate},
+		{"UserSlug", testUserSlug},
+		{"PendingUsers", testPendingUsers},
+		{"Sessions", testSessions},
+		{"SessionPagination", testSessionPagination},
+		{"Communities", testCommunities},
+		{"CommunityPagination", testCommunityPagination},
+		{"CommunitySoftDelete", testCommunitySoftDelete},
+		{"Membership", testMembership},
+		{"Roles", testRoles},
+		{"PlatformPermission", testPlatformPermission},
+		{"Posts", testPosts},
+		{"PostPagination", testPostPagination},
+		{"PostSortTop", testPostSortTop},
+		{"Comments", testComments},
+		{"PostLikes", testPostLikes},
+		{"CommentLikes", testCommentLikes},
+		{"PostDeleteReputation", testPostDeleteReputation},
+		{"CommentThread", testCommentThread},
+		{"Bookmarks", testBookmarks},
+		{"Followers", testFollowers},
+		{"OneTimeTokens", testOneTimeTokens},
+		{"AuthThrottles", testAuthThrottles},
+		{"LoginLockouts", testLoginLockouts},
+		{"LoginLockoutUnlessActive", testLoginLockoutUnlessActive},
+		{"InviteCodes", testInviteCodes},
+		{"Transactions", testTransactions},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.run(t, newStore(t))
+		})
+	}
+}
+
+func mustNil(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func mustNotFound(t *testing.T, err error) {
+	t.Helper()
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("expected gorm.ErrRecordNotFound, got %v", err)
+	}
+}
+
+func mustInvalidCursor(t *testing.T, err error) {
+	t.Helper()
+	if !errors.Is(err, lib.ErrInvalidCursor) {
+		t.Fatalf("expected lib.ErrInvalidCursor, got %v", err)
+	}
+}
+
+// mustSQLState checks err is a Postgres error with the given SQLSTATE code.
+func mustSQLState(t *testing.T, err error, code string) {
+	t.Helper()
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != code {
+		t.Fatalf("expected SQLSTATE %s, got %v", code, err)
+	}
+}
+
+func mustEqual[T comparable](t *testing.T, what string, got T, want T) {
+	t.Helper()
+	if got != want {
+		t.Fatalf("%s: got %v, want %v", what, got, want)
+	}
+}
+
+func mustRating(t *testing.T, what string, got float64, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 1e-9 {
+		t.Fatalf("%s: got %v, want %v", what, got, want)
+	}
+}
+
+// mustIDs checks the ids of rows, in order.
+func mustIDs(t *testing.T, what string, got []uuid.UUID, want []uuid.UUID) {
+	t.Helper()
+	if !slices.Equal(got, want) {
+		t.Fatalf("%s: got %v, want %v", what, got, want)
+	}
+}
+
+func idsOf[T any](rows []*T, id func(row *T) uuid.UUID) []uuid.UUID {
+	ids := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ids[i] = id(row)
+	}
+	return ids
+}
+
+// sortedNewestFirst orders ids by created_at DESC, id DESC.
+func sortedNewestFirst(createdAt map[uuid.UUID]time.Time) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(createdAt))
+	for ID := range createdAt {
+		ids = append(ids, ID)
+	}
+	slices.SortFunc(ids, func(a uuid.UUID, b uuid.UUID) int {
+		return newestFirst(createdAt[a], a, createdAt[b], b)
+	})
+	return ids
+}
+
+func insertUser(t *testing.T, store ormpkg.Store, name string) *ormpkg.User {
+	t.Helper()
+	user := &ormpkg.User{
+		Slug:         name,
+		SlugSkeleton: name,
+		Name:         name,
+		Description:  "about " + name,
+		Email:        name + "@example.com",
+		Password:     "hash",
+		IsVerified:   true,
+		IsApproved:   true,
+		LastActivity: epoch,
+	}
+	mustNil(t, store.InsertUser(user))
+	return user
+}
+
+func insertCommunity(t *testing.T, store ormpkg.Store, owner *ormpkg.User, slug string, createdAt time.Time) *ormpkg.Community {
+	t.Helper()
+	community := &ormpkg.Community{
+		OwnerID:     owner.ID,
+		Slug:        slug,
+		Name:        "Community " + slug,
+		Description: "about " + slug,
+		Rules:       "be nice",
+		CreatedAt:   createdAt,
+	}
+	mustNil(t, store.InsertCommunity(community))
+	return community
+}
+
+func insertPost(t *testing.T, store ormpkg.Store, community *ormpkg.Community, author *ormpkg.User, createdAt time.Time) *ormpkg.Post {
+	t.Helper()
+	post := &ormpkg.Post{
+		CommunityID: community.ID,
+		AuthorID:    author.ID,
+		Title:       fmt.Sprintf("Post at %s", createdAt.Format(time.TimeOnly)),
+		Content:     json.RawMessage(`{"blocks":[]}`),
+		Status:      int(ormpkg.PostStatusPublished),
+		CreatedAt:   createdAt,
+		PublishedAt: createdAt,
+	}
+	mustNil(t, store.InsertPost(post))
+	return post
+}
+
+func insertComment(t *testing.T, store ormpkg.Store, post *ormpkg.Post, author *ormpkg.User, createdAt time.Time) *ormpkg.Comment {
+	t.Helper()
+	comment := &ormpkg.Comment{
+		PostID:    post.ID,
+		AuthorID:  author.ID,
+		Content:   "comment",
+		CreatedAt: createdAt,
+	}
+	mustNil(t, store.InsertComment(comment))
+	return comment
+}
+
+func selectUser(t *testing.T, store ormpkg.Store, user *ormpkg.User) *ormpkg.User {
+	t.Helper()
+	selected, err := store.SelectUserByID(user.ID.String())
+	mustNil(t, err)
+	return selected
+}
+
+func selectCommunity(t *testing.T, store ormpkg.Store, community *ormpkg.Community) *ormpkg.Community {
+	t.Helper()
+	selected, err := store.SelectCommunityByID(community.ID.String())
+	mustNil(t, err)
+	return selected
+}
+
+func selectPost(t *testing.T, store ormpkg.Store, post *ormpkg.Post) *ormpkg.Post {
+	t.Helper()
+	selected, err := store.SelectPostByID(post.ID.String())
+	mustNil(t, err)
+	return selected
+}
@@ -0,0 +1,250 @@
+package ormtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+func testOneTimeTokens(t *testing.T, store ormpkg.Store) {
+	alice := insertUser(t, store, "alice")
+	bob := insertUser(t, store, "bob")
+
+	insertToken := func(user *ormpkg.User, purpose string, hash string, expiresAt time.Time) error {
+		return store.InsertOneTimeToken(&ormpkg.OneTimeToken{
+			UserID:    user.ID,
+			Purpose:   purpose,
+			TokenHash: hash,
+			ExpiresAt: expiresAt,
+		})
+	}
+	later := time.Now().Add(time.Hour)
+
+	// Only the most recently sent token of a purpose works
+	mustNil(t, insertToken(alice, ormpkg.TOKEN_PURPOSE_VERIFICATION, "hash1", later))
+	mustNil(t, insertToken(alice, ormpkg.TOKEN_PURPOSE_VERIFICATION, "hash2", later))
+	_, err := store.ConsumeOneTimeToken(ormpkg.TOKEN_PURPOSE_VERIFICATION, "hash1")
+	mustNotFound(t, err)
+
+	token, err := store.ConsumeOneTimeToken(ormpkg.TOKEN_PURPOSE_VERIFICATION, "hash2")
+	mustNil(t, err)
+	mustEqual(t, "token user", token.UserID, alice.ID)
+	mustEqual(t, "token consumed", token.ConsumedAt != nil, true)
+
+	_, err = store.ConsumeOneTimeToken(ormpkg.TOKEN_PURPOSE_VERIFICATION, "hash2")
+	mustNotFound(t, err)
+
+	// A token only works for its purpose and until it expires
+	mustNil(t, insertToken(alice, ormpkg.TOKEN_PURPOSE_PASSWORD_RESET, "hash3", later))
+	_, err = store.ConsumeOneTimeToken(ormpkg.TOKEN_PURPOSE_VERIFICATION, "hash3")
+	mustNotFound(t, err)
+
+	mustNil(t, insertToken(alice, ormpkg.TOKEN_PURPOSE_UNLOCK, "hash4", time.Now().Add(-time.Minute)))
+	_, err = store.ConsumeOneTimeToken(ormpkg.TOKEN_PURPOSE_UNLOCK, "hash4")
+	mustNotFound(t, err)
+
+	err = insertToken(bob, ormpkg.TOKEN_PURPOSE_UNLOCK, "hash3", later)
+	mustSQLState(t, err, "23505")
+
+	err = insertToken(&ormpkg.User{ID: uuid.New()}, ormpkg.TOKEN_PURPOSE_UNLOCK, "hash5", later)
+	mustSQLState(t, err, "23503")
+
+	mustNil(t, store.DeleteOneTimeTokensByUserID(alice.ID.String(), ormpkg.TOKEN_PURPOSE_PASSWORD_RESET))
+	_, err = store.ConsumeOneTimeToken(ormpkg.TOKEN_PURPOSE_PASSWORD_RESET, "hash3")
+	mustNotFound(t, err)
+}
+
+func testAuthThrottles(t *testing.T, store ormpkg.Store) {
+	_, err := store.SelectAuthThrottle("account", "alice@example.com")
+	mustNotFound(t, err)
+
+	var first *ormpkg.AuthThrottle
+	for count := 1; count <= 3; count++ {
+		throttle, err := store.IncrementAuthThrottle("account", "alice@example.com", time.Hour)
+		mustNil(t, err)
+		mustEqual(t, "count", throttle.Count, count)
+		if first == nil {
+			first = throttle
+		}
+		mustEqual(t, "window start", throttle.WindowStartedAt.Equal(first.WindowStartedAt), true)
+	}
+
+	throttle, err := store.IncrementAuthThrottle("ip", "192.0.2.1", time.Hour)
+	mustNil(t, err)
+	mustEqual(t, "count of another key", throttle.Count, 1)
+
+	mustNil(t, store.UpdateAuthThrottleLockedUntil("account", "alice@example.com", time.Now().Add(time.Hour)))
+	throttle, err = store.SelectAuthThrottle("account", "alice@example.com")
+	mustNil(t, err)
+	mustEqual(t, "count", throttle.Count, 3)
+	mustEqual(t, "locked", throttle.IsLocked(time.Now()), true)
+
+	// A hit after the window restarts the counter and lifts the lock
+	throttle, err = store.IncrementAuthThrottle("account", "alice@example.com", 0)
+	mustNil(t, err)
+	mustEqual(t, "count after the window", throttle.Count, 1)
+	mustEqual(t, "locked after the window", throttle.IsLocked(time.Now()), false)
+
+	mustNil(t, store.DeleteAuthThrottle("account", "alice@example.com"))
+	_, err = store.SelectAuthThrottle("account", "alice@example.com")
+	mustNotFound(t, err)
+}
+
+func testLoginLockouts(t *testing.T, store ormpkg.Store) {
+	createdAt := map[uuid.UUID]time.Time{}
+	for i := 0; i < 3; i++ {
+		lockout := &ormpkg.LoginLockout{
+			Kind:         "account",
+			Email:        "alice@example.com",
+			IpAddress:    "192.0.2.1",
+			FailureCount: 10,
+			LockedUntil:  epoch.Add(time.Hour),
+			CreatedAt:    epoch.Add(time.Duration(i) * time.Minute),
+		}
+		mustNil(t, store.InsertLoginLockout(lockout))
+		createdAt[lockout.ID] = lockout.CreatedAt
+	}
+	want := sortedNewestFirst(createdAt)
+
+	lockouts, page, err := store.SelectLoginLockoutsWithPagination(2, "")
+	mustNil(t, err)
+	mustIDs(t, "first page", idsOf(lockouts, func(lockout *ormpkg.LoginLockout) uuid.UUID { return lockout.ID }), want[:2])
+	mustEqual(t, "has more", page.HasMore, true)
+
+	lockouts, _, err = store.SelectLoginLockoutsWithPagination(2, page.NextCursor)
+	mustNil(t, err)
+	mustIDs(t, "second page", idsOf(lockouts, func(lockout *ormpkg.LoginLockout) uuid.UUID { return lockout.ID }), want[2:])
+
+	_, _, err = store.SelectLoginLockoutsWithPagination(2, "garbage")
+	mustInvalidCursor(t, err)
+}
+
+func testLoginLockoutUnlessActive(t *testing.T, store ormpkg.Store) {
+	lockout := func(kind string, key string, lockedUntil time.Time) *ormpkg.LoginLockout {
+		return &ormpkg.LoginLockout{
+			Kind:         kind,
+			Email:        "alice@example.com",
+			IpAddress:    "192.0.2.1",
+			Key:          key,
+			FailureCount: 10,
+			LockedUntil:  lockedUntil,
+		}
+	}
+	later := time.Now().Add(time.Hour)
+
+	// A lockout that is over does not count
+	mustNil(t, store.InsertLoginLockout(lockout("account", "alice@example.com", time.Now().Add(-time.Minute))))
+
+	inserted, err := store.InsertLoginLockoutUnlessActive(lockout("account", "alice@example.com", later))
+	mustNil(t, err)
+	mustEqual(t, "first lockout", inserted, true)
+
+	inserted, err = store.InsertLoginLockoutUnlessActive(lockout("account", "alice@example.com", later))
+	mustNil(t, err)
+	mustEqual(t, "lockout while active", inserted, false)
+
+	// Other kinds and keys are locked out separately
+	inserted, err = store.InsertLoginLockoutUnlessActive(lockout("ip", "192.0.2.1", later))
+	mustNil(t, err)
+	mustEqual(t, "lockout of another kind", inserted, true)
+
+	inserted, err = store.InsertLoginLockoutUnlessActive(lockout("account", "bob@example.com", later))
+	mustNil(t, err)
+	mustEqual(t, "lockout of another key", inserted, true)
+
+	lockouts, _, err := store.SelectLoginLockoutsWithPagination(10, "")
+	mustNil(t, err)
+	mustEqual(t, "lockouts", len(lockouts), 4)
+}
+
+func testInviteCodes(t *testing.T, store ormpkg.Store) {
+	alice := insertUser(t, store, "alice")
+
+	once := 1
+	single := &ormpkg.InviteCode{CodeHash: "code1", CodePrefix: "c1", CreatedBy: &alice.ID, MaxUses: &once, CreatedAt: epoch}
+	mustNil(t, store.InsertInviteCode(single))
+	unlimited := &ormpkg.InviteCode{CodeHash: "code2", CodePrefix: "c2", CreatedAt: epoch.Add(time.Minute)}
+	mustNil(t, store.InsertInviteCode(unlimited))
+
+	err := store.InsertInviteCode(&ormpkg.InviteCode{CodeHash: "code1", CodePrefix: "c1"})
+	mustSQLState(t, err, "23505")
+
+	codes, _, err := store.SelectInviteCodesWithPagination(10, "")
+	mustNil(t, err)
+	mustIDs(t, "codes", idsOf(codes, func(code *ormpkg.InviteCode) uuid.UUID { return code.ID }), []uuid.UUID{unlimited.ID, single.ID})
+
+	newUser := func(name string) *ormpkg.User {
+		return &ormpkg.User{Slug: name, SlugSkeleton: name, Name: name, Email: name + "@example.com", LastActivity: epoch}
+	}
+	useCount := func(code *ormpkg.InviteCode) int {
+		t.Helper()
+		selected, err := store.SelectInviteCodeByID(code.ID.String())
+		mustNil(t, err)
+		return selected.UseCount
+	}
+
+	bob := newUser("bob")
+	mustNil(t, store.InsertUserWithInviteCode(bob, "code1"))
+	mustEqual(t, "invite code of the user", *selectUser(t, store, bob).InviteCodeID, single.ID)
+	mustEqual(t, "uses", useCount(single), 1)
+
+	err = store.InsertUserWithInviteCode(newUser("carol"), "code1")
+	mustEqual(t, "used up code", err, ormpkg.ErrInviteCodeInvalid)
+	_, err = store.SelectUserBySlug("carol")
+	mustNotFound(t, err)
+
+	// A failed registration does not spend a use
+	err = store.InsertUserWithInviteCode(newUser("alice"), "code2")
+	mustEqual(t, "taken slug", err, ormpkg.ErrUserSlugTaken)
+	duplicate := newUser("dave")
+	duplicate.Email = alice.Email
+	err = store.InsertUserWithInviteCode(duplicate, "code2")
+	mustSQLState(t, err, "23505")
+	mustEqual(t, "uses after failures", useCount(unlimited), 0)
+
+	mustNil(t, store.RevokeInviteCode(unlimited.ID.String()))
+	mustNotFound(t, store.RevokeInviteCode(unlimited.ID.String()))
+	err = store.InsertUserWithInviteCode(newUser("erin"), "code2")
+	mustEqual(t, "revoked code", err, ormpkg.ErrInviteCodeInvalid)
+
+	_, err = store.SelectInviteCodeByID(uuid.NewString())
+	mustNotFound(t, err)
+}
+
+func testTransactions(t *testing.T, store ormpkg.Store) {
+	ctx := context.Background()
+	errRollback := errors.New("rollback")
+
+	err := store.WithTx(ctx, func(tx ormpkg.Store) error {
+		insertUser(t, tx, "alice")
+		return errRollback
+	})
+	mustEqual(t, "rolled back", err, errRollback)
+	_, err = store.SelectUserBySlug("alice")
+	mustNotFound(t, err)
+
+	// A nested transaction is rolled back alone
+	var bob *ormpkg.User
+	err = store.WithTx(ctx, func(tx ormpkg.Store) error {
+		bob = insertUser(t, tx, "bob")
+
+		err := tx.WithTx(ctx, func(tx ormpkg.Store) error {
+			insertUser(t, tx, "carol")
+			return errRollback
+		})
+		mustEqual(t, "nested rolled back", err, errRollback)
+
+		_, err = tx.SelectUserByID(bob.ID.String())
+		return err
+	})
+	mustNil(t, err)
+
+	selectUser(t, store, bob)
+	_, err = store.SelectUserBySlug("carol")
+	mustNotFound(t, err)
+}
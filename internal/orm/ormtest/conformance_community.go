@@ -0,0 +1,336 @@
+package ormtest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+func testCommunities(t *testing.T, store ormpkg.Store) {
+	owner := insertUser(t, store, "owner")
+	community := insertCommunity(t, store, owner, "go", epoch)
+
+	selected := selectCommunity(t, store, community)
+	mustEqual(t, "owner", selected.OwnerID, owner.ID)
+	mustEqual(t, "member count", selected.MemberCount, 0)
+	mustEqual(t, "post count", selected.PostCount, 0)
+
+	selected, err := store.SelectCommunityBySlug("go")
+	mustNil(t, err)
+	mustEqual(t, "id by slug", selected.ID, community.ID)
+
+	selected, err = store.SelectCommunityByName("Community go")
+	mustNil(t, err)
+	mustEqual(t, "id by name", selected.ID, community.ID)
+
+	_, err = store.SelectCommunityByID(uuid.NewString())
+	mustNotFound(t, err)
+
+	err = store.InsertCommunity(&ormpkg.Community{OwnerID: owner.ID, Slug: "go", Name: "Another go"})
+	mustSQLState(t, err, "23505")
+
+	// Counters are maintained by their own functions and never overwritten
+	err = store.UpdateCommunity(&ormpkg.Community{ID: community.ID, Description: "changed", MemberCount: 50, PostCount: 50})
+	mustNil(t, err)
+
+	selected = selectCommunity(t, store, community)
+	mustEqual(t, "description", selected.Description, "changed")
+	mustEqual(t, "name", selected.Name, "Community go")
+	mustEqual(t, "member count", selected.MemberCount, 0)
+	mustEqual(t, "post count", selected.PostCount, 0)
+
+	err = store.DeleteCommunity(community, uuid.New(), "")
+	mustSQLState(t, err, "23503")
+
+	mustNil(t, store.DeleteCommunity(community, owner.ID, "closed"))
+	_, err = store.SelectCommunityByID(community.ID.String())
+	mustNotFound(t, err)
+	_, err = store.SelectCommunityByName("Community go")
+	mustNotFound(t, err)
+
+	// The slug stays taken until the community is purged
+	selected, err = store.SelectCommunityBySlug("go")
+	mustNil(t, err)
+	mustEqual(t, "id by slug", selected.ID, community.ID)
+
+	selected, err = store.SelectDeletedCommunityByID(community.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "deleted by", *selected.DeletedBy, owner.ID)
+	mustEqual(t, "deletion reason", selected.DeletionReason, "closed")
+
+	mustNil(t, store.RestoreCommunity(selected))
+	selected = selectCommunity(t, store, community)
+	mustEqual(t, "restored", selected.DeletedAt == nil, true)
+	mustNotFound(t, store.RestoreCommunity(selected))
+}
+
+func testCommunityPagination(t *testing.T, store ormpkg.Store) {
+	owner := insertUser(t, store, "owner")
+	other := insertUser(t, store, "other")
+
+	var owned []uuid.UUID
+	for i, slug := range []string{"first", "second", "third"} {
+		community := insertCommunity(t, store, owner, slug, epoch.Add(time.Duration(i)*time.Minute))
+		owned = append(owned, community.ID)
+	}
+	foreign := insertCommunity(t, store, other, "foreign", epoch.Add(time.Hour))
+
+	id := func(community *ormpkg.Community) uuid.UUID { return community.ID }
+
+	communities, _, err := store.SelectCommunitiesWithPagination("", 10, "")
+	mustNil(t, err)
+	mustIDs(t, "all communities", idsOf(communities, id), []uuid.UUID{foreign.ID, owned[2], owned[1], owned[0]})
+
+	communities, first, err := store.SelectCommunitiesWithPagination(owner.ID.String(), 2, "")
+	mustNil(t, err)
+	mustIDs(t, "first page", idsOf(communities, id), []uuid.UUID{owned[2], owned[1]})
+	mustEqual(t, "first page has more", first.HasMore, true)
+	mustEqual(t, "first page prev cursor", first.PrevCursor, "")
+
+	communities, second, err := store.SelectCommunitiesWithPagination(owner.ID.String(), 2, first.NextCursor)
+	mustNil(t, err)
+	mustIDs(t, "second page", idsOf(communities, id), []uuid.UUID{owned[0]})
+	mustEqual(t, "second page has more", second.HasMore, false)
+	mustEqual(t, "second page next cursor", second.NextCursor, "")
+
+	communities, back, err := store.SelectCommunitiesWithPagination(owner.ID.String(), 2, second.PrevCursor)
+	mustNil(t, err)
+	mustIDs(t, "back to first page", idsOf(communities, id), []uuid.UUID{owned[2], owned[1]})
+	mustEqual(t, "back has more", back.HasMore, false)
+	mustEqual(t, "back prev cursor", back.PrevCursor, "")
+
+	// The cursor holds the sort keys, so a deleted row still works as one
+	mustNil(t, store.DeleteCommunity(&ormpkg.Community{ID: owned[1]}, owner.ID, ""))
+	communities, _, err = store.SelectCommunitiesWithPagination(owner.ID.String(), 2, first.NextCursor)
+	mustNil(t, err)
+	mustIDs(t, "after deleted cursor", idsOf(communities, id), []uuid.UUID{owned[0]})
+
+	communities, _, err = store.SelectCommunitiesWithPagination(owner.ID.String(), 10, "")
+	mustNil(t, err)
+	mustIDs(t, "without deleted", idsOf(communities, id), []uuid.UUID{owned[2], owned[0]})
+
+	// Archived communities are left out of listings but stay readable
+	archivedAt := epoch.Add(2 * time.Hour)
+	mustNil(t, store.UpdateCommunity(&ormpkg.Community{ID: foreign.ID, ArchivedAt: &archivedAt}))
+	communities, _, err = store.SelectCommunitiesWithPagination("", 10, "")
+	mustNil(t, err)
+	mustIDs(t, "without archived", idsOf(communities, id), []uuid.UUID{owned[2], owned[0]})
+	selected := selectCommunity(t, store, foreign)
+	mustEqual(t, "archived at", selected.ArchivedAt != nil && selected.ArchivedAt.Equal(archivedAt), true)
+
+	_, _, err = store.SelectCommunitiesWithPagination("", 2, owned[0].String())
+	mustInvalidCursor(t, err)
+	_, _, err = store.SelectCommunitiesWithPagination("", 2, first.NextCursor[:len(first.NextCursor)-2]+"AA")
+	mustInvalidCursor(t, err)
+}
+
+func testCommunitySoftDelete(t *testing.T, store ormpkg.Store) {
+	owner := insertUser(t, store, "owner")
+	member := insertUser(t, store, "member")
+	community := insertCommunity(t, store, owner, "go", epoch)
+	_, err := store.InsertCommunityUser(&ormpkg.CommunityUser{CommunityID: community.ID, UserID: member.ID})
+	mustNil(t, err)
+
+	communityRole := &ormpkg.Role{Name: "moderator", CommunityID: &community.ID, Type: "community"}
+	mustNil(t, store.InsertRole(communityRole))
+	platformRole := &ormpkg.Role{Name: "moderator", Type: "platform"}
+	mustNil(t, store.InsertRole(platformRole))
+	mustNil(t, store.InsertUserRole(&ormpkg.UserRole{UserID: member.ID, RoleID: communityRole.ID}))
+	mustNil(t, store.InsertUserRole(&ormpkg.UserRole{UserID: member.ID, RoleID: platformRole.ID}))
+
+	post := insertPost(t, store, community, owner, epoch)
+	comment := insertComment(t, store, post, member, epoch)
+	_, err = store.InsertPostLike(&ormpkg.PostLike{PostID: post.ID, UserID: member.ID})
+	mustNil(t, err)
+	mustRating(t, "community reputation", selectCommunity(t, store, community).Reputation, 1.1)
+	mustEqual(t, "owner reputation", selectUser(t, store, owner).Reputation, int64(1))
+
+	// Deleting hides the community with its posts and memberships, nothing
+	// else changes until it is purged
+	mustNil(t, store.DeleteCommunity(community, owner.ID, ""))
+
+	_, err = store.SelectPostByID(post.ID.String())
+	mustNotFound(t, err)
+	_, err = store.SelectCommentByID(comment.ID.String())
+	mustNotFound(t, err)
+	posts, _, err := store.SelectPostsWithPagination("", ormpkg.POST_SORT_NEWEST, 10, "")
+	mustNil(t, err)
+	mustEqual(t, "visible posts", len(posts), 0)
+	memberships, err := store.SelectCommunityUsersByUserID(member.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "visible memberships", len(memberships), 0)
+
+	_, err = store.SelectRoleByName("moderator", &community.ID)
+	mustNil(t, err)
+	mustEqual(t, "owner reputation", selectUser(t, store, owner).Reputation, int64(1))
+
+	mustNil(t, store.RestoreCommunity(community))
+	selectPost(t, store, post)
+	memberships, err = store.SelectCommunityUsersByUserID(member.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "restored memberships", len(memberships), 1)
+
+	// Purging takes back the reputation, roles and memberships cascade
+	mustNil(t, store.DeleteCommunity(community, owner.ID, ""))
+	purged, err := store.PurgeDeletedCommunities(time.Now().Add(-time.Hour), 10)
+	mustNil(t, err)
+	mustEqual(t, "purged before the deletion", purged, 0)
+
+	purged, err = store.PurgeDeletedCommunities(time.Now().Add(time.Minute), 10)
+	mustNil(t, err)
+	mustEqual(t, "purged", purged, 1)
+
+	_, err = store.SelectDeletedCommunityByID(community.ID.String())
+	mustNotFound(t, err)
+	_, err = store.SelectCommunityBySlug("go")
+	mustNotFound(t, err)
+	_, err = store.SelectDeletedPostByID(post.ID.String())
+	mustNotFound(t, err)
+	_, err = store.SelectCommunityUser(community.ID.String(), member.ID.String())
+	mustNotFound(t, err)
+	mustEqual(t, "owner reputation", selectUser(t, store, owner).Reputation, int64(0))
+
+	_, err = store.SelectRoleByName("moderator", &community.ID)
+	mustNotFound(t, err)
+
+	role, err := store.SelectRoleByName("moderator", nil)
+	mustNil(t, err)
+	mustEqual(t, "platform role", role.ID, platformRole.ID)
+
+	roles, err := store.SelectRolesByUserID(member.ID.String())
+	mustNil(t, err)
+	mustIDs(t, "member roles", idsOf(roles, func(role *ormpkg.Role) uuid.UUID { return role.ID }), []uuid.UUID{platformRole.ID})
+}
+
+func testMembership(t *testing.T, store ormpkg.Store) {
+	owner := insertUser(t, store, "owner")
+	member := insertUser(t, store, "member")
+	community := insertCommunity(t, store, owner, "go", epoch)
+
+	inserted, err := store.InsertCommunityUser(&ormpkg.CommunityUser{CommunityID: community.ID, UserID: member.ID})
+	mustNil(t, err)
+	mustEqual(t, "first join", inserted, true)
+
+	inserted, err = store.InsertCommunityUser(&ormpkg.CommunityUser{CommunityID: community.ID, UserID: member.ID})
+	mustNil(t, err)
+	mustEqual(t, "second join", inserted, false)
+	mustEqual(t, "member count", selectCommunity(t, store, community).MemberCount, 1)
+
+	communityUser, err := store.SelectCommunityUser(community.ID.String(), member.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "member", communityUser.UserID, member.ID)
+
+	_, err = store.SelectCommunityUser(community.ID.String(), owner.ID.String())
+	mustNotFound(t, err)
+
+	communityUsers, err := store.SelectCommunityUsersByUserID(member.ID.String())
+	mustNil(t, err)
+	if len(communityUsers) != 1 {
+		t.Fatalf("memberships: got %d, want 1", len(communityUsers))
+	}
+	mustEqual(t, "community name", communityUsers[0].Community.Name, "Community go")
+
+	deleted, err := store.DeleteCommunityUser(community.ID, member.ID)
+	mustNil(t, err)
+	mustEqual(t, "first leave", deleted, true)
+
+	deleted, err = store.DeleteCommunityUser(community.ID, member.ID)
+	mustNil(t, err)
+	mustEqual(t, "second leave", deleted, false)
+	mustEqual(t, "member count", selectCommunity(t, store, community).MemberCount, 0)
+}
+
+func testRoles(t *testing.T, store ormpkg.Store) {
+	owner := insertUser(t, store, "owner")
+	member := insertUser(t, store, "member")
+	community := insertCommunity(t, store, owner, "go", epoch)
+
+	role := &ormpkg.Role{Name: "moderator", CommunityID: &community.ID, Type: "community"}
+	mustNil(t, store.InsertRole(role))
+	mustEqual(t, "default color", role.Color, ROLE_DEFAULT_COLOR)
+
+	err := store.InsertRole(&ormpkg.Role{Name: "moderator", CommunityID: &community.ID, Type: "community"})
+	mustSQLState(t, err, "23505")
+
+	missing := uuid.New()
+	err = store.InsertRole(&ormpkg.Role{Name: "moderator", CommunityID: &missing, Type: "community"})
+	mustSQLState(t, err, "23503")
+
+	// Platform roles have a NULL community id, which never collides
+	mustNil(t, store.InsertRole(&ormpkg.Role{Name: "moderator", Type: "platform"}))
+	mustNil(t, store.InsertRole(&ormpkg.Role{Name: "moderator", Type: "platform"}))
+
+	selected, err := store.SelectRoleByName("moderator", &community.ID)
+	mustNil(t, err)
+	mustEqual(t, "role by name", selected.ID, role.ID)
+	mustEqual(t, "stored color", selected.Color, ROLE_DEFAULT_COLOR)
+
+	userRole := &ormpkg.UserRole{UserID: member.ID, RoleID: role.ID}
+	mustNil(t, store.InsertUserRole(userRole))
+	mustSQLState(t, store.InsertUserRole(userRole), "23505")
+	mustSQLState(t, store.InsertUserRole(&ormpkg.UserRole{UserID: member.ID, RoleID: uuid.New()}), "23503")
+	mustSQLState(t, store.InsertUserRole(&ormpkg.UserRole{UserID: uuid.New(), RoleID: role.ID}), "23503")
+
+	roles, err := store.SelectRolesByUserID(member.ID.String())
+	mustNil(t, err)
+	mustIDs(t, "member roles", idsOf(roles, func(role *ormpkg.Role) uuid.UUID { return role.ID }), []uuid.UUID{role.ID})
+
+	mustNil(t, store.DeleteUserRole(userRole))
+	roles, err = store.SelectRolesByUserID(member.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "member roles", len(roles), 0)
+}
+
+func testPlatformPermission(t *testing.T, store ormpkg.Store) {
+	owner := insertUser(t, store, "owner")
+	moderator := insertUser(t, store, "moderator")
+	member := insertUser(t, store, "member")
+	community := insertCommunity(t, store, owner, "go", epoch)
+
+	platformRole := &ormpkg.Role{
+		Name:        "moderator",
+		Type:        "platform",
+		Permissions: json.RawMessage(`{"ban_users": true, "delete_posts": "true", "manage_roles": false}`),
+	}
+	mustNil(t, store.InsertRole(platformRole))
+	mustNil(t, store.InsertUserRole(&ormpkg.UserRole{UserID: moderator.ID, RoleID: platformRole.ID}))
+
+	// Community roles never grant platform permissions
+	communityRole := &ormpkg.Role{
+		Name:        "moderator",
+		CommunityID: &community.ID,
+		Type:        "community",
+		Permissions: json.RawMessage(`{"ban_users": true}`),
+	}
+	mustNil(t, store.InsertRole(communityRole))
+	mustNil(t, store.InsertUserRole(&ormpkg.UserRole{UserID: member.ID, RoleID: communityRole.ID}))
+
+	for _, test := range []struct {
+		user       *ormpkg.User
+		permission string
+		granted    bool
+	}{
+		{moderator, "ban_users", true},
+		{moderator, "delete_posts", true},
+		{moderator, "manage_roles", false},
+		{moderator, "unknown", false},
+		{member, "ban_users", false},
+		{owner, "ban_users", false},
+	} {
+		granted, err := store.SelectUserHasPlatformPermission(test.user.ID.String(), test.permission)
+		mustNil(t, err)
+		mustEqual(t, test.user.Name+" "+test.permission, granted, test.granted)
+	}
+
+	// The platform owner holds every permission
+	mustNil(t, store.UpdatePlatformOwner(owner.ID))
+	granted, err := store.SelectUserHasPlatformPermission(owner.ID.String(), "manage_roles")
+	mustNil(t, err)
+	mustEqual(t, "owner manage_roles", granted, true)
+
+	mustSQLState(t, store.UpdatePlatformOwner(uuid.New()), "23503")
+}
@@ -0,0 +1,571 @@
+package ormtest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+func testPosts(t *testing.T, store ormpkg.Store) {
+	author := insertUser(t, store, "author")
+	community := insertCommunity(t, store, author, "go", epoch)
+	post := insertPost(t, store, community, author, epoch)
+	mustEqual(t, "post count", selectCommunity(t, store, community).PostCount, 1)
+
+	selected := selectPost(t, store, post)
+	mustEqual(t, "title", selected.Title, post.Title)
+	mustEqual(t, "community name", selected.Community.Name, "Community go")
+	mustEqual(t, "author name", selected.Author.Name, "author")
+
+	_, err := store.SelectPostByID(uuid.NewString())
+	mustNotFound(t, err)
+
+	// Counters are maintained by likes and comments and never overwritten
+	err = store.UpdatePost(&ormpkg.Post{ID: post.ID, Title: "changed", LikeCount: 10, CommentCount: 10})
+	mustNil(t, err)
+
+	selected = selectPost(t, store, post)
+	mustEqual(t, "title", selected.Title, "changed")
+	mustEqual(t, "like count", selected.LikeCount, 0)
+	mustEqual(t, "comment count", selected.CommentCount, 0)
+
+	posts, err := store.SelectPostsByAuthorID(author.ID.String())
+	mustNil(t, err)
+	mustIDs(t, "author posts", idsOf(posts, func(post *ormpkg.Post) uuid.UUID { return post.ID }), []uuid.UUID{post.ID})
+
+	err = store.DeletePost(post, uuid.New(), "")
+	mustSQLState(t, err, "23503")
+
+	// Deleting twice decrements the counter once
+	mustNil(t, store.DeletePost(post, author.ID, "spam"))
+	mustNil(t, store.DeletePost(post, author.ID, "again"))
+	mustEqual(t, "post count", selectCommunity(t, store, community).PostCount, 0)
+
+	_, err = store.SelectPostByID(post.ID.String())
+	mustNotFound(t, err)
+
+	posts, err = store.SelectPostsByAuthorID(author.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "deleted author posts", len(posts), 1)
+
+	deleted, err := store.SelectDeletedPostByID(post.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "deleted by", *deleted.DeletedBy, author.ID)
+	mustEqual(t, "deletion reason", deleted.DeletionReason, "spam")
+
+	mustNil(t, store.RestorePost(deleted))
+	mustNotFound(t, store.RestorePost(deleted))
+	mustEqual(t, "post count", selectCommunity(t, store, community).PostCount, 1)
+	mustEqual(t, "restored title", selectPost(t, store, post).Title, "changed")
+
+	_, err = store.SelectDeletedPostByID(post.ID.String())
+	mustNotFound(t, err)
+}
+
+func testPostPagination(t *testing.T, store ormpkg.Store) {
+	author := insertUser(t, store, "author")
+	other := insertUser(t, store, "other")
+	community := insertCommunity(t, store, author, "go", epoch)
+
+	// Posts created at the same time are ordered by id
+	createdAt := map[uuid.UUID]time.Time{}
+	for i := range 5 {
+		post := insertPost(t, store, community, author, epoch.Add(time.Duration(i/3)*time.Minute))
+		createdAt[post.ID] = post.CreatedAt
+	}
+	insertPost(t, store, community, other, epoch)
+	expected := sortedNewestFirst(createdAt)
+
+	id := func(post *ormpkg.Post) uuid.UUID { return post.ID }
+
+	var got []uuid.UUID
+	cursor := ""
+	for range 3 {
+		posts, page, err := store.SelectPostsWithPagination(author.ID.String(), ormpkg.POST_SORT_NEWEST, 2, cursor)
+		mustNil(t, err)
+		got = append(got, idsOf(posts, id)...)
+		cursor = page.NextCursor
+	}
+	mustIDs(t, "pages", got, expected)
+	mustEqual(t, "last page next cursor", cursor, "")
+
+	// Paging back from the last page
+	posts, last, err := store.SelectPostsWithPagination(author.ID.String(), ormpkg.POST_SORT_NEWEST, 2, "")
+	mustNil(t, err)
+	for last.HasMore {
+		posts, last, err = store.SelectPostsWithPagination(author.ID.String(), ormpkg.POST_SORT_NEWEST, 2, last.NextCursor)
+		mustNil(t, err)
+	}
+	mustIDs(t, "last page", idsOf(posts, id), expected[4:])
+
+	got = nil
+	cursor = last.PrevCursor
+	for cursor != "" {
+		posts, page, err := store.SelectPostsWithPagination(author.ID.String(), ormpkg.POST_SORT_NEWEST, 2, cursor)
+		mustNil(t, err)
+		got = append(idsOf(posts, id), got...)
+		cursor = page.PrevCursor
+	}
+	mustIDs(t, "pages back", got, expected[:4])
+
+	posts, _, err = store.SelectPostsWithPagination("", ormpkg.POST_SORT_NEWEST, 10, "")
+	mustNil(t, err)
+	mustEqual(t, "all posts", len(posts), 6)
+	mustEqual(t, "preloaded author", posts[0].Author.ID, posts[0].AuthorID)
+
+	_, _, err = store.SelectPostsWithPagination("", ormpkg.POST_SORT_NEWEST, 10, uuid.NewString())
+	mustInvalidCursor(t, err)
+}
+
+func testPostSortTop(t *testing.T, store ormpkg.Store) {
+	author := insertUser(t, store, "author")
+	community := insertCommunity(t, store, author, "go", epoch)
+	likers := []*ormpkg.User{}
+	for _, name := range []string{"first", "second", "third"} {
+		likers = append(likers, insertUser(t, store, name))
+	}
+
+	// Likes per post, the posts with as many likes go newest first
+	likes := []int{1, 3, 0, 1, 2}
+	posts := []*ormpkg.Post{}
+	for i, count := range likes {
+		post := insertPost(t, store, community, author, epoch.Add(time.Duration(i)*time.Minute))
+		for _, liker := range likers[:count] {
+			_, err := store.InsertPostLike(&ormpkg.PostLike{PostID: post.ID, UserID: liker.ID})
+			mustNil(t, err)
+		}
+		posts = append(posts, post)
+	}
+	expected := []uuid.UUID{posts[1].ID, posts[4].ID, posts[3].ID, posts[0].ID, posts[2].ID}
+
+	id := func(post *ormpkg.Post) uuid.UUID { return post.ID }
+
+	var got []uuid.UUID
+	cursor := ""
+	var last lib.PageInfo
+	for range 3 {
+		page, info, err := store.SelectPostsWithPagination(author.ID.String(), ormpkg.POST_SORT_TOP, 2, cursor)
+		mustNil(t, err)
+		got = append(got, idsOf(page, id)...)
+		cursor, last = info.NextCursor, info
+	}
+	mustIDs(t, "pages", got, expected)
+	mustEqual(t, "last page next cursor", cursor, "")
+
+	page, _, err := store.SelectPostsWithPagination(author.ID.String(), ormpkg.POST_SORT_TOP, 2, last.PrevCursor)
+	mustNil(t, err)
+	mustIDs(t, "page back", idsOf(page, id), expected[2:4])
+
+	// A cursor continues only the order it came from
+	_, info, err := store.SelectPostsWithPagination(author.ID.String(), ormpkg.POST_SORT_TOP, 2, "")
+	mustNil(t, err)
+	_, _, err = store.SelectPostsWithPagination(author.ID.String(), ormpkg.POST_SORT_NEWEST, 2, info.NextCursor)
+	mustInvalidCursor(t, err)
+
+	_, _, err = store.SelectPostsWithPagination(author.ID.String(), "oldest", 2, "")
+	if !errors.Is(err, ormpkg.ErrUnknownPostSort) {
+		t.Fatalf("unknown sort: got %v, want %v", err, ormpkg.ErrUnknownPostSort)
+	}
+}
+
+func testComments(t *testing.T, store ormpkg.Store) {
+	author := insertUser(t, store, "author")
+	commenter := insertUser(t, store, "commenter")
+	community := insertCommunity(t, store, author, "go", epoch)
+	post := insertPost(t, store, community, author, epoch)
+
+	err := store.InsertComment(&ormpkg.Comment{PostID: uuid.New(), AuthorID: commenter.ID, Content: "lost"})
+	mustNotFound(t, err)
+	comments, err := store.SelectCommentsByAuthorID(commenter.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "comments after failed insert", len(comments), 0)
+
+	var ids []uuid.UUID
+	for i := range 3 {
+		comment := insertComment(t, store, post, commenter, epoch.Add(time.Duration(i)*time.Minute))
+		ids = append(ids, comment.ID)
+	}
+	mustEqual(t, "comment count", selectPost(t, store, post).CommentCount, 3)
+	mustRating(t, "community reputation", selectCommunity(t, store, community).Reputation, 0.3)
+	mustEqual(t, "commenter reputation", selectUser(t, store, commenter).Reputation, int64(0))
+
+	comment, err := store.SelectCommentByID(ids[0].String())
+	mustNil(t, err)
+	mustEqual(t, "post title", comment.Post.Title, post.Title)
+	mustEqual(t, "author name", comment.Author.Name, "commenter")
+
+	id := func(comment *ormpkg.Comment) uuid.UUID { return comment.ID }
+
+	comments, page, err := store.SelectCommentsWithPagination(post.ID.String(), "", 2, "")
+	mustNil(t, err)
+	mustIDs(t, "first page", idsOf(comments, id), []uuid.UUID{ids[2], ids[1]})
+
+	comments, _, err = store.SelectCommentsWithPagination(post.ID.String(), commenter.ID.String(), 2, page.NextCursor)
+	mustNil(t, err)
+	mustIDs(t, "second page", idsOf(comments, id), []uuid.UUID{ids[0]})
+
+	comments, _, err = store.SelectCommentsWithPagination("", author.ID.String(), 10, "")
+	mustNil(t, err)
+	mustEqual(t, "author comments", len(comments), 0)
+
+	mustNil(t, store.UpdateComment(&ormpkg.Comment{ID: ids[0], Content: "edited"}))
+	comment, err = store.SelectCommentByID(ids[0].String())
+	mustNil(t, err)
+	mustEqual(t, "content", comment.Content, "edited")
+
+	mustNil(t, store.DeleteComment(&ormpkg.Comment{ID: ids[0], PostID: post.ID}, commenter.ID, ""))
+	_, err = store.SelectCommentByID(ids[0].String())
+	mustNotFound(t, err)
+	mustEqual(t, "comment count", selectPost(t, store, post).CommentCount, 2)
+	mustRating(t, "community reputation", selectCommunity(t, store, community).Reputation, 0.2)
+}
+
+func testPostLikes(t *testing.T, store ormpkg.Store) {
+	author := insertUser(t, store, "author")
+	liker := insertUser(t, store, "liker")
+	community := insertCommunity(t, store, author, "go", epoch)
+	post := insertPost(t, store, community, author, epoch)
+
+	inserted, err := store.InsertPostLike(&ormpkg.PostLike{PostID: post.ID, UserID: liker.ID})
+	mustNil(t, err)
+	mustEqual(t, "first like", inserted, true)
+
+	inserted, err = store.InsertPostLike(&ormpkg.PostLike{PostID: post.ID, UserID: liker.ID})
+	mustNil(t, err)
+	mustEqual(t, "second like", inserted, false)
+
+	mustEqual(t, "like count", selectPost(t, store, post).LikeCount, 1)
+	mustEqual(t, "author reputation", selectUser(t, store, author).Reputation, int64(1))
+	mustRating(t, "community reputation", selectCommunity(t, store, community).Reputation, 1)
+
+	_, err = store.SelectPostLikeByID(post.ID.String(), liker.ID.String())
+	mustNil(t, err)
+	likes, err := store.SelectPostLikesByUserID(liker.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "liker likes", len(likes), 1)
+
+	_, err = store.InsertPostLike(&ormpkg.PostLike{PostID: uuid.New(), UserID: liker.ID})
+	mustNotFound(t, err)
+
+	deleted, err := store.DeletePostLike(post.ID, liker.ID)
+	mustNil(t, err)
+	mustEqual(t, "first unlike", deleted, true)
+
+	deleted, err = store.DeletePostLike(post.ID, liker.ID)
+	mustNil(t, err)
+	mustEqual(t, "second unlike", deleted, false)
+
+	mustEqual(t, "like count", selectPost(t, store, post).LikeCount, 0)
+	mustEqual(t, "author reputation", selectUser(t, store, author).Reputation, int64(0))
+	mustRating(t, "community reputation", selectCommunity(t, store, community).Reputation, 0)
+
+	_, err = store.SelectPostLikeByID(post.ID.String(), liker.ID.String())
+	mustNotFound(t, err)
+	likes, err = store.SelectPostLikesByUserID(liker.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "liker likes", len(likes), 0)
+}
+
+func testCommentLikes(t *testing.T, store ormpkg.Store) {
+	author := insertUser(t, store, "author")
+	liker := insertUser(t, store, "liker")
+	community := insertCommunity(t, store, author, "go", epoch)
+	post := insertPost(t, store, community, author, epoch)
+	comment := insertComment(t, store, post, author, epoch)
+
+	inserted, err := store.InsertCommentLike(&ormpkg.CommentLike{CommentID: comment.ID, UserID: liker.ID})
+	mustNil(t, err)
+	mustEqual(t, "first like", inserted, true)
+
+	inserted, err = store.InsertCommentLike(&ormpkg.CommentLike{CommentID: comment.ID, UserID: liker.ID})
+	mustNil(t, err)
+	mustEqual(t, "second like", inserted, false)
+
+	// Comment likes reward the author only
+	mustEqual(t, "author reputation", selectUser(t, store, author).Reputation, int64(1))
+	mustRating(t, "community reputation", selectCommunity(t, store, community).Reputation, 0.1)
+
+	_, err = store.SelectCommentLikeByID(comment.ID.String(), liker.ID.String())
+	mustNil(t, err)
+	likes, err := store.SelectCommentLikesByUserID(liker.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "liker likes", len(likes), 1)
+
+	_, err = store.InsertCommentLike(&ormpkg.CommentLike{CommentID: uuid.New(), UserID: liker.ID})
+	mustNotFound(t, err)
+
+	deleted, err := store.DeleteCommentLike(comment.ID, liker.ID)
+	mustNil(t, err)
+	mustEqual(t, "first unlike", deleted, true)
+
+	deleted, err = store.DeleteCommentLike(comment.ID, liker.ID)
+	mustNil(t, err)
+	mustEqual(t, "second unlike", deleted, false)
+
+	mustEqual(t, "author reputation", selectUser(t, store, author).Reputation, int64(0))
+	_, err = store.SelectCommentLikeByID(comment.ID.String(), liker.ID.String())
+	mustNotFound(t, err)
+}
+
+func testPostDeleteReputation(t *testing.T, store ormpkg.Store) {
+	author := insertUser(t, store, "author")
+	reader := insertUser(t, store, "reader")
+	community := insertCommunity(t, store, author, "go", epoch)
+	post := insertPost(t, store, community, author, epoch)
+	kept := insertPost(t, store, community, author, epoch)
+
+	_, err := store.InsertPostLike(&ormpkg.PostLike{PostID: post.ID, UserID: reader.ID})
+	mustNil(t, err)
+	_, err = store.InsertPostLike(&ormpkg.PostLike{PostID: kept.ID, UserID: reader.ID})
+	mustNil(t, err)
+
+	comment := insertComment(t, store, post, reader, epoch)
+	_, err = store.InsertCommentLike(&ormpkg.CommentLike{CommentID: comment.ID, UserID: author.ID})
+	mustNil(t, err)
+
+	mustEqual(t, "author reputation", selectUser(t, store, author).Reputation, int64(2))
+	mustEqual(t, "reader reputation", selectUser(t, store, reader).Reputation, int64(1))
+	mustRating(t, "community reputation", selectCommunity(t, store, community).Reputation, 2.1)
+
+	// Deleting the post takes back what its likes and comments gave and
+	// restoring it gives it again
+	mustNil(t, store.DeletePost(post, author.ID, ""))
+
+	mustEqual(t, "author reputation", selectUser(t, store, author).Reputation, int64(1))
+	mustEqual(t, "reader reputation", selectUser(t, store, reader).Reputation, int64(0))
+	mustRating(t, "community reputation", selectCommunity(t, store, community).Reputation, 1)
+	mustEqual(t, "post count", selectCommunity(t, store, community).PostCount, 1)
+
+	mustNil(t, store.RestorePost(post))
+
+	mustEqual(t, "author reputation", selectUser(t, store, author).Reputation, int64(2))
+	mustEqual(t, "reader reputation", selectUser(t, store, reader).Reputation, int64(1))
+	mustRating(t, "community reputation", selectCommunity(t, store, community).Reputation, 2.1)
+	mustEqual(t, "post count", selectCommunity(t, store, community).PostCount, 2)
+
+	// Purging removes the post with what it holds and leaves the rest
+	mustNil(t, store.DeletePost(post, author.ID, ""))
+	purged, err := store.PurgeDeletedPosts(time.Now().Add(time.Minute), 10)
+	mustNil(t, err)
+	mustEqual(t, "purged", purged, 1)
+
+	_, err = store.SelectDeletedPostByID(post.ID.String())
+	mustNotFound(t, err)
+	_, err = store.SelectPostLikeByID(post.ID.String(), reader.ID.String())
+	mustNotFound(t, err)
+	comments, err := store.SelectCommentsByAuthorID(reader.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "reader comments", len(comments), 0)
+
+	mustEqual(t, "author reputation", selectUser(t, store, author).Reputation, int64(1))
+	mustEqual(t, "reader reputation", selectUser(t, store, reader).Reputation, int64(0))
+	mustRating(t, "community reputation", selectCommunity(t, store, community).Reputation, 1)
+	selectPost(t, store, kept)
+}
+
+func testCommentThread(t *testing.T, store ormpkg.Store) {
+	author := insertUser(t, store, "author")
+	replier := insertUser(t, store, "replier")
+	community := insertCommunity(t, store, author, "go", epoch)
+	post := insertPost(t, store, community, author, epoch)
+
+	parent := insertComment(t, store, post, author, epoch)
+	reply := &ormpkg.Comment{
+		ParentCommentID: &parent.ID,
+		PostID:          post.ID,
+		AuthorID:        replier.ID,
+		Content:         "reply",
+		CreatedAt:       epoch.Add(time.Minute),
+	}
+	mustNil(t, store.InsertComment(reply))
+	leaf := insertComment(t, store, post, author, epoch.Add(2*time.Minute))
+
+	_, err := store.InsertCommentLike(&ormpkg.CommentLike{CommentID: leaf.ID, UserID: replier.ID})
+	mustNil(t, err)
+	mustEqual(t, "author reputation", selectUser(t, store, author).Reputation, int64(1))
+
+	mustNil(t, store.DeleteComment(parent, author.ID, ""))
+	mustNil(t, store.DeleteComment(leaf, author.ID, ""))
+	mustEqual(t, "comment count", selectPost(t, store, post).CommentCount, 1)
+	mustEqual(t, "author reputation", selectUser(t, store, author).Reputation, int64(0))
+
+	// A deleted comment with replies stays in the thread as a placeholder
+	id := func(comment *ormpkg.Comment) uuid.UUID { return comment.ID }
+	comments, _, err := store.SelectCommentsWithPagination(post.ID.String(), "", 10, "")
+	mustNil(t, err)
+	mustIDs(t, "thread", idsOf(comments, id), []uuid.UUID{reply.ID, parent.ID})
+	mustEqual(t, "placeholder content", comments[1].Content, ormpkg.DELETED_COMMENT_CONTENT)
+	mustEqual(t, "placeholder author", comments[1].AuthorID, uuid.Nil)
+	mustEqual(t, "reply content", comments[0].Content, "reply")
+
+	comments, _, err = store.SelectCommentsWithPagination("", author.ID.String(), 10, "")
+	mustNil(t, err)
+	mustEqual(t, "author comments", len(comments), 0)
+
+	_, err = store.SelectCommentByID(parent.ID.String())
+	mustNotFound(t, err)
+
+	deleted, err := store.SelectDeletedCommentByID(leaf.ID.String())
+	mustNil(t, err)
+	mustNil(t, store.RestoreComment(deleted))
+	mustEqual(t, "comment count", selectPost(t, store, post).CommentCount, 2)
+	mustEqual(t, "author reputation", selectUser(t, store, author).Reputation, int64(1))
+	mustRating(t, "community reputation", selectCommunity(t, store, community).Reputation, 0.2)
+
+	// Only comments without replies are purged
+	mustNil(t, store.DeleteComment(leaf, author.ID, ""))
+	purged, err := store.PurgeDeletedComments(time.Now().Add(time.Minute), 10)
+	mustNil(t, err)
+	mustEqual(t, "purged", purged, 1)
+
+	_, err = store.SelectDeletedCommentByID(leaf.ID.String())
+	mustNotFound(t, err)
+	_, err = store.SelectDeletedCommentByID(parent.ID.String())
+	mustNil(t, err)
+	_, err = store.SelectCommentLikeByID(leaf.ID.String(), replier.ID.String())
+	mustNotFound(t, err)
+
+	// A deleted post hides its whole thread
+	mustNil(t, store.DeletePost(post, author.ID, ""))
+	_, err = store.SelectCommentByID(reply.ID.String())
+	mustNotFound(t, err)
+	comments, _, err = store.SelectCommentsWithPagination(post.ID.String(), "", 10, "")
+	mustNil(t, err)
+	mustEqual(t, "hidden thread", len(comments), 0)
+}
+
+func testBookmarks(t *testing.T, store ormpkg.Store) {
+	author := insertUser(t, store, "author")
+	reader := insertUser(t, store, "reader")
+	community := insertCommunity(t, store, author, "go", epoch)
+
+	var posts []uuid.UUID
+	for i := range 2 {
+		post := insertPost(t, store, community, author, epoch)
+		inserted, err := store.InsertBookmark(&ormpkg.Bookmark{
+			PostID:    post.ID,
+			UserID:    reader.ID,
+			CreatedAt: epoch.Add(time.Duration(i) * time.Minute),
+		})
+		mustNil(t, err)
+		mustEqual(t, "first bookmark", inserted, true)
+		posts = append(posts, post.ID)
+	}
+
+	// A repeated bookmark changes nothing
+	inserted, err := store.InsertBookmark(&ormpkg.Bookmark{PostID: posts[0], UserID: reader.ID})
+	mustNil(t, err)
+	mustEqual(t, "second bookmark", inserted, false)
+
+	// The author's own bookmark is not in the reader's list
+	_, err = store.InsertBookmark(&ormpkg.Bookmark{
+		PostID:    posts[0],
+		UserID:    author.ID,
+		CreatedAt: epoch.Add(time.Hour),
+	})
+	mustNil(t, err)
+
+	bookmark, err := store.SelectBookmarkByID(posts[0].String(), reader.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "bookmarked post", bookmark.PostID, posts[0])
+
+	_, err = store.SelectBookmarkByID(posts[1].String(), author.ID.String())
+	mustNotFound(t, err)
+
+	postID := func(bookmark *ormpkg.Bookmark) uuid.UUID { return bookmark.PostID }
+
+	bookmarks, _, err := store.SelectBookmarksWithPagination(reader.ID.String(), 10, "")
+	mustNil(t, err)
+	mustIDs(t, "newest first", idsOf(bookmarks, postID), []uuid.UUID{posts[1], posts[0]})
+	mustEqual(t, "preloaded post", bookmarks[0].Post.ID, posts[1])
+	mustEqual(t, "preloaded author", bookmarks[0].Post.Author.Name, "author")
+	mustEqual(t, "preloaded community", bookmarks[0].Post.Community.ID, community.ID)
+
+	bookmarks, err = store.SelectBookmarksByUserID(reader.ID.String())
+	mustNil(t, err)
+	mustIDs(t, "oldest first", idsOf(bookmarks, postID), []uuid.UUID{posts[0], posts[1]})
+
+	// Bookmarks of deleted posts are left out of the list
+	mustNil(t, store.DeletePost(&ormpkg.Post{ID: posts[1], CommunityID: community.ID}, author.ID, ""))
+	bookmarks, _, err = store.SelectBookmarksWithPagination(reader.ID.String(), 10, "")
+	mustNil(t, err)
+	mustIDs(t, "without deleted", idsOf(bookmarks, postID), []uuid.UUID{posts[0]})
+
+	deleted, err := store.DeleteBookmark(posts[0], reader.ID)
+	mustNil(t, err)
+	mustEqual(t, "first delete", deleted, true)
+
+	deleted, err = store.DeleteBookmark(posts[0], reader.ID)
+	mustNil(t, err)
+	mustEqual(t, "second delete", deleted, false)
+
+	_, err = store.SelectBookmarkByID(posts[0].String(), reader.ID.String())
+	mustNotFound(t, err)
+	_, err = store.SelectBookmarkByID(posts[0].String(), author.ID.String())
+	mustNil(t, err)
+}
+
+func testFollowers(t *testing.T, store ormpkg.Store) {
+	alice := insertUser(t, store, "alice")
+	bob := insertUser(t, store, "bob")
+	carol := insertUser(t, store, "carol")
+
+	// Bob is followed by Alice and Carol and follows Alice
+	for i, follow := range [][2]*ormpkg.User{{bob, alice}, {bob, carol}, {alice, bob}} {
+		inserted, err := store.InsertFollower(&ormpkg.Follower{
+			UserID:     follow[0].ID,
+			FollowerID: follow[1].ID,
+			CreatedAt:  epoch.Add(time.Duration(i) * time.Minute),
+		})
+		mustNil(t, err)
+		mustEqual(t, "first follow", inserted, true)
+	}
+
+	// A repeated follow changes nothing
+	inserted, err := store.InsertFollower(&ormpkg.Follower{UserID: bob.ID, FollowerID: alice.ID})
+	mustNil(t, err)
+	mustEqual(t, "second follow", inserted, false)
+
+	follow, err := store.SelectFollowerByID(bob.ID.String(), alice.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "followed user", follow.UserID, bob.ID)
+
+	_, err = store.SelectFollowerByID(alice.ID.String(), carol.ID.String())
+	mustNotFound(t, err)
+
+	followerID := func(follow *ormpkg.Follower) uuid.UUID { return follow.FollowerID }
+
+	followers, _, err := store.SelectFollowersWithPagination(bob.ID.String(), "", 10, "")
+	mustNil(t, err)
+	mustIDs(t, "bob's followers", idsOf(followers, followerID), []uuid.UUID{carol.ID, alice.ID})
+	mustEqual(t, "preloaded follower", followers[0].Follower.Name, "carol")
+	mustEqual(t, "preloaded user", followers[0].User.Name, "bob")
+
+	followers, _, err = store.SelectFollowersWithPagination("", bob.ID.String(), 10, "")
+	mustNil(t, err)
+	mustIDs(t, "followed by bob", idsOf(followers, func(follow *ormpkg.Follower) uuid.UUID { return follow.UserID }), []uuid.UUID{alice.ID})
+
+	follows, err := store.SelectFollowsByUserID(bob.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "bob's follows", len(follows), 3)
+
+	deleted, err := store.DeleteFollower(bob.ID, alice.ID)
+	mustNil(t, err)
+	mustEqual(t, "first unfollow", deleted, true)
+
+	deleted, err = store.DeleteFollower(bob.ID, alice.ID)
+	mustNil(t, err)
+	mustEqual(t, "second unfollow", deleted, false)
+
+	_, err = store.SelectFollowerByID(bob.ID.String(), alice.ID.String())
+	mustNotFound(t, err)
+
+	// Only the follow in that direction is gone
+	_, err = store.SelectFollowerByID(alice.ID.String(), bob.ID.String())
+	mustNil(t, err)
+}
@@ -0,0 +1,297 @@
+package ormtest
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+func testUserLookup(t *testing.T, store ormpkg.Store) {
+	alice := insertUser(t, store, "alice")
+	insertUser(t, store, "bob")
+
+	user := selectUser(t, store, alice)
+	mustEqual(t, "slug", user.Slug, "alice")
+	mustEqual(t, "email", user.Email, "alice@example.com")
+	mustEqual(t, "approved", user.IsApproved, true)
+	mustEqual(t, "reputation", user.Reputation, int64(0))
+
+	user, err := store.SelectUserBySlug("alice")
+	mustNil(t, err)
+	mustEqual(t, "id by slug", user.ID, alice.ID)
+
+	user, err = store.SelectUserByName("alice")
+	mustNil(t, err)
+	mustEqual(t, "id by name", user.ID, alice.ID)
+
+	user, err = store.SelectUserByEmail("ALICE@Example.com")
+	mustNil(t, err)
+	mustEqual(t, "id by email", user.ID, alice.ID)
+
+	_, err = store.SelectUserByID(uuid.NewString())
+	mustNotFound(t, err)
+
+	_, err = store.SelectUserBySlug("carol")
+	mustNotFound(t, err)
+
+	_, err = store.SelectUserByID("not-a-uuid")
+	mustSQLState(t, err, "22P02")
+
+	count, err := store.CountUsers()
+	mustNil(t, err)
+	mustEqual(t, "user count", count, int64(2))
+}
+
+func testUserUniqueness(t *testing.T, store ormpkg.Store) {
+	alice := insertUser(t, store, "alice")
+	bob := insertUser(t, store, "bob")
+
+	err := store.InsertUser(&ormpkg.User{Slug: "alice", SlugSkeleton: "alice", Email: "other@example.com"})
+	mustEqual(t, "same slug", err, ormpkg.ErrUserSlugTaken)
+
+	// Confusable slugs share the skeleton
+	err = store.InsertUser(&ormpkg.User{Slug: "al1ce", SlugSkeleton: "alice", Email: "other@example.com"})
+	mustEqual(t, "same skeleton", err, ormpkg.ErrUserSlugTaken)
+
+	err = store.InsertUser(&ormpkg.User{Slug: "other", SlugSkeleton: "other", Email: alice.Email})
+	mustSQLState(t, err, "23505")
+
+	err = store.UpdateUser(&ormpkg.User{ID: bob.ID, Email: alice.Email})
+	mustSQLState(t, err, "23505")
+
+	count, err := store.CountUsers()
+	mustNil(t, err)
+	mustEqual(t, "user count", count, int64(2))
+	mustEqual(t, "unchanged email", selectUser(t, store, bob).Email, "bob@example.com")
+}
+
+func testUserUpdate(t *testing.T, store ormpkg.Store) {
+	alice := insertUser(t, store, "alice")
+
+	err := store.UpdateUser(&ormpkg.User{ID: alice.ID, Description: "changed", Reputation: 100})
+	mustNil(t, err)
+
+	user := selectUser(t, store, alice)
+	mustEqual(t, "description", user.Description, "changed")
+	mustEqual(t, "name", user.Name, "alice")
+	mustEqual(t, "reputation", user.Reputation, int64(0))
+
+	mustNil(t, store.UpdateUserPassword(alice.ID.String(), "new hash"))
+	mustEqual(t, "password", selectUser(t, store, alice).Password, "new hash")
+
+	unverified := &ormpkg.User{Slug: "bob", SlugSkeleton: "bob", Name: "bob", Email: "bob@example.com"}
+	mustNil(t, store.InsertUser(unverified))
+	mustEqual(t, "verified", selectUser(t, store, unverified).IsVerified, false)
+	mustNil(t, store.UpdateUserVerified(unverified.ID.String()))
+	mustEqual(t, "verified", selectUser(t, store, unverified).IsVerified, true)
+
+	scheduledAt := epoch.Add(30 * 24 * time.Hour)
+	mustNil(t, store.ScheduleUserDeletion(alice.ID.String(), scheduledAt))
+	user = selectUser(t, store, alice)
+	if user.DeletionScheduledAt == nil || !user.DeletionScheduledAt.Equal(scheduledAt) {
+		t.Fatalf("deletion scheduled at: got %v, want %v", user.DeletionScheduledAt, scheduledAt)
+	}
+
+	mustNil(t, store.CancelUserDeletion(alice.ID.String()))
+	if user = selectUser(t, store, alice); user.DeletionScheduledAt != nil {
+		t.Fatalf("deletion scheduled at: got %v, want nil", user.DeletionScheduledAt)
+	}
+}
+
+func testUserSlug(t *testing.T, store ormpkg.Store) {
+	alice := insertUser(t, store, "alice")
+	bob := insertUser(t, store, "bob")
+
+	mustNil(t, store.UpdateUserSlug(alice, "alice2", "alice2"))
+
+	user, err := store.SelectUserBySlug("alice2")
+	mustNil(t, err)
+	mustEqual(t, "id by new slug", user.ID, alice.ID)
+
+	_, err = store.SelectUserBySlug("alice")
+	mustNotFound(t, err)
+
+	redirect, err := store.SelectUserSlugRedirect("alice")
+	mustNil(t, err)
+	mustEqual(t, "redirect user", redirect.UserID, alice.ID)
+
+	// The old slug stays reserved for everybody but its former owner
+	taken, err := store.SelectUserSlugTaken("alice", bob.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "old slug taken by another user", taken, true)
+
+	taken, err = store.SelectUserSlugTaken("alice", alice.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "old slug taken by its owner", taken, false)
+
+	taken, err = store.SelectUserSlugTaken("alice2", "")
+	mustNil(t, err)
+	mustEqual(t, "current slug taken", taken, true)
+
+	// Taking the old slug back drops its redirect and redirects the new one
+	mustNil(t, store.UpdateUserSlug(selectUser(t, store, alice), "alice", "alice"))
+
+	_, err = store.SelectUserSlugRedirect("alice")
+	mustNotFound(t, err)
+
+	redirect, err = store.SelectUserSlugRedirect("alice2")
+	mustNil(t, err)
+	mustEqual(t, "redirect user", redirect.UserID, alice.ID)
+
+	err = store.UpdateUserSlug(selectUser(t, store, bob), "alice", "alice")
+	mustEqual(t, "current slug of another user", err, ormpkg.ErrUserSlugTaken)
+
+	// Former slugs stay claimed by their owner
+	err = store.UpdateUserSlug(selectUser(t, store, bob), "al1ce2", "alice2")
+	mustEqual(t, "former slug of another user", err, ormpkg.ErrUserSlugTaken)
+	err = store.InsertUser(&ormpkg.User{Slug: "alice2", SlugSkeleton: "alice2", Email: "other@example.com"})
+	mustEqual(t, "former slug taken at insert", err, ormpkg.ErrUserSlugTaken)
+	mustEqual(t, "unchanged slug", selectUser(t, store, bob).Slug, "bob")
+}
+
+func testPendingUsers(t *testing.T, store ormpkg.Store) {
+	insertUser(t, store, "approved")
+
+	var pending []*ormpkg.User
+	for i := range 3 {
+		name := "pending" + strings.Repeat("x", i)
+		user := &ormpkg.User{
+			Slug:         name,
+			SlugSkeleton: name,
+			Name:         name,
+			Email:        name + "@example.com",
+			CreatedAt:    epoch.Add(time.Duration(i) * time.Minute),
+		}
+		mustNil(t, store.InsertUser(user))
+		pending = append(pending, user)
+	}
+
+	id := func(user *ormpkg.User) uuid.UUID { return user.ID }
+
+	users, first, err := store.SelectPendingUsersWithPagination(2, "")
+	mustNil(t, err)
+	mustIDs(t, "first page", idsOf(users, id), []uuid.UUID{pending[0].ID, pending[1].ID})
+
+	users, _, err = store.SelectPendingUsersWithPagination(2, first.NextCursor)
+	mustNil(t, err)
+	mustIDs(t, "second page", idsOf(users, id), []uuid.UUID{pending[2].ID})
+
+	// A cursor only works with the order it was issued for
+	_, _, err = store.SelectCommunitiesWithPagination("", 2, first.NextCursor)
+	mustInvalidCursor(t, err)
+
+	mustNil(t, store.ApproveUser(pending[0].ID.String()))
+	mustEqual(t, "approved", selectUser(t, store, pending[0]).IsApproved, true)
+	mustNotFound(t, store.ApproveUser(pending[0].ID.String()))
+
+	mustNotFound(t, store.DeletePendingUser(pending[0].ID.String()))
+	mustNil(t, store.DeletePendingUser(pending[1].ID.String()))
+	_, err = store.SelectUserByID(pending[1].ID.String())
+	mustNotFound(t, err)
+
+	users, _, err = store.SelectPendingUsersWithPagination(10, "")
+	mustNil(t, err)
+	mustIDs(t, "remaining", idsOf(users, id), []uuid.UUID{pending[2].ID})
+}
+
+func testSessions(t *testing.T, store ormpkg.Store) {
+	userID := uuid.New()
+	otherID := uuid.New()
+
+	session := &ormpkg.Session{UserID: userID, Name: "laptop", RefreshTokenHash: "hash0"}
+	mustNil(t, store.InsertSession(session))
+
+	var others []string
+	for range 2 {
+		other := &ormpkg.Session{UserID: userID, RefreshTokenHash: "other"}
+		mustNil(t, store.InsertSession(other))
+		others = append(others, other.ID.String())
+	}
+	foreign := &ormpkg.Session{UserID: otherID, RefreshTokenHash: "foreign"}
+	mustNil(t, store.InsertSession(foreign))
+
+	selected, err := store.SelectSessionByID(session.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "refresh token hash", selected.RefreshTokenHash, "hash0")
+
+	rotated, err := store.RotateSessionRefreshToken(session.ID.String(), "hash0", "hash1")
+	mustNil(t, err)
+	mustEqual(t, "first rotation", rotated, true)
+
+	rotated, err = store.RotateSessionRefreshToken(session.ID.String(), "hash0", "hash2")
+	mustNil(t, err)
+	mustEqual(t, "replayed rotation", rotated, false)
+
+	mustNotFound(t, store.UpdateSessionName(session.ID.String(), otherID.String(), "stolen"))
+	mustNil(t, store.UpdateSessionName(session.ID.String(), userID.String(), "desktop"))
+
+	selected, err = store.SelectSessionByID(session.ID.String())
+	mustNil(t, err)
+	mustEqual(t, "name", selected.Name, "desktop")
+	mustEqual(t, "refresh token hash", selected.RefreshTokenHash, "hash1")
+
+	deleted, err := store.DeleteOtherSessionsByUserID(userID.String(), session.ID.String())
+	mustNil(t, err)
+	slices.Sort(deleted)
+	slices.Sort(others)
+	if !slices.Equal(deleted, others) {
+		t.Fatalf("deleted sessions: got %v, want %v", deleted, others)
+	}
+
+	_, err = store.SelectSessionByID(foreign.ID.String())
+	mustNil(t, err)
+
+	// Sessions idle for more than thirty days expire
+	stale := &ormpkg.Session{UserID: userID, UpdatedAt: time.Now().Add(-31 * 24 * time.Hour)}
+	mustNil(t, store.InsertSession(stale))
+	mustNil(t, store.DeleteSessions())
+
+	_, err = store.SelectSessionByID(stale.ID.String())
+	mustNotFound(t, err)
+	_, err = store.SelectSessionByID(session.ID.String())
+	mustNil(t, err)
+
+	deleted, err = store.DeleteSessionsByUserID(userID.String())
+	mustNil(t, err)
+	if !slices.Equal(deleted, []string{session.ID.String()}) {
+		t.Fatalf("deleted sessions: got %v, want %v", deleted, []string{session.ID.String()})
+	}
+
+	mustNil(t, store.DeleteSession(foreign))
+	_, err = store.SelectSessionByID(foreign.ID.String())
+	mustNotFound(t, err)
+}
+
+func testSessionPagination(t *testing.T, store ormpkg.Store) {
+	userID := uuid.New()
+
+	var sessions []uuid.UUID
+	for i := range 3 {
+		session := &ormpkg.Session{UserID: userID, CreatedAt: epoch.Add(time.Duration(i) * time.Minute)}
+		mustNil(t, store.InsertSession(session))
+		sessions = append(sessions, session.ID)
+	}
+	mustNil(t, store.InsertSession(&ormpkg.Session{UserID: uuid.New(), CreatedAt: epoch}))
+
+	id := func(session *ormpkg.Session) uuid.UUID { return session.ID }
+
+	// A negative limit returns every session
+	selected, all, err := store.SelectSessionsByUserID(userID.String(), "", -1)
+	mustNil(t, err)
+	mustIDs(t, "all sessions", idsOf(selected, id), []uuid.UUID{sessions[2], sessions[1], sessions[0]})
+	mustEqual(t, "all has more", all.HasMore, false)
+	mustEqual(t, "all next cursor", all.NextCursor, "")
+
+	selected, first, err := store.SelectSessionsByUserID(userID.String(), "", 2)
+	mustNil(t, err)
+	mustIDs(t, "first page", idsOf(selected, id), []uuid.UUID{sessions[2], sessions[1]})
+
+	selected, _, err = store.SelectSessionsByUserID(userID.String(), first.NextCursor, 2)
+	mustNil(t, err)
+	mustIDs(t, "second page", idsOf(selected, id), []uuid.UUID{sessions[0]})
+}
@@ -0,0 +1,125 @@
+package ormtest
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+// authThrottleKey is the primary key of auth_throttle.
+type authThrottleKey struct {
+	kind string
+	key  string
+}
+
+func (s *MemoryStore) SelectAuthThrottle(kind string, key string) (*ormpkg.AuthThrottle, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	throttle, ok := s.authThrottles[authThrottleKey{kind, key}]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return cloneRow(throttle), nil
+}
+
+// IncrementAuthThrottle counts one more hit, restarting the counter and
+// clearing the lock when the window has passed, see
+// PostgresClient.IncrementAuthThrottle.
+func (s *MemoryStore) IncrementAuthThrottle(kind string, key string, window time.Duration) (*ormpkg.AuthThrottle, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	throttle := &ormpkg.AuthThrottle{
+		Kind:            kind,
+		Key:             key,
+		Count:           1,
+		WindowStartedAt: now,
+	}
+	if stored, ok := s.authThrottles[authThrottleKey{kind, key}]; ok && !stored.WindowStartedAt.Before(now.Add(-window)) {
+		throttle = cloneRow(stored)
+		throttle.Count++
+	}
+	throttle.UpdatedAt = now
+	truncateTimes(throttle)
+
+	s.authThrottles[authThrottleKey{kind, key}] = throttle
+	return cloneRow(throttle), nil
+}
+
+func (s *MemoryStore) UpdateAuthThrottleLockedUntil(kind string, key string, lockedUntil time.Time) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.authThrottles[authThrottleKey{kind, key}]
+	if !ok {
+		return nil
+	}
+
+	updated := cloneRow(stored)
+	updated.LockedUntil = &lockedUntil
+	setUpdated(updated)
+	truncateTimes(updated)
+
+	s.authThrottles[authThrottleKey{kind, key}] = updated
+	return nil
+}
+
+func (s *MemoryStore) DeleteAuthThrottle(kind string, key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.authThrottles, authThrottleKey{kind, key})
+	return nil
+}
+
+// SelectLoginLockoutsWithPagination lists lockouts newest first.
+func (s *MemoryStore) SelectLoginLockoutsWithPagination(limit int, cursor string) ([]*ormpkg.LoginLockout, lib.PageInfo, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return pageRows(s.loginLockouts, func(lockout *ormpkg.LoginLockout) bool {
+		return true
+	}, ormpkg.NewestFirst, func(lockout *ormpkg.LoginLockout) []interface{} {
+		return []interface{}{lockout.CreatedAt, lockout.ID}
+	}, cursor, limit)
+}
+
+func (s *MemoryStore) InsertLoginLockout(lockout *ormpkg.LoginLockout) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.insertLoginLockout(lockout)
+	return nil
+}
+
+// InsertLoginLockoutUnlessActive records the lockout unless one of the same
+// kind and key is still active, and reports whether it did.
+func (s *MemoryStore) InsertLoginLockoutUnlessActive(lockout *ormpkg.LoginLockout) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	for _, other := range s.loginLockouts {
+		if other.Kind == lockout.Kind && other.Key == lockout.Key && other.LockedUntil.After(now) {
+			return false, nil
+		}
+	}
+
+	s.insertLoginLockout(lockout)
+	return true, nil
+}
+
+func (s *MemoryStore) insertLoginLockout(lockout *ormpkg.LoginLockout) {
+	lockout.BeforeCreate(nil)
+	setCreated(lockout)
+
+	stored := cloneRow(lockout)
+	truncateTimes(stored)
+
+	s.loginLockouts[stored.ID] = stored
+}
@@ -0,0 +1,119 @@
+package ormtest
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+func (s *MemoryStore) SelectBookmarkByID(postID string, userID string) (*ormpkg.Bookmark, error) {
+	post, err := parseID(postID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	bookmark, err := firstByID(s.bookmarks, func(bookmark *ormpkg.Bookmark) bool {
+		return bookmark.PostID == post && bookmark.UserID == user
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cloneRow(bookmark), nil
+}
+
+// SelectBookmarksWithPagination lists the user's bookmarks newest first
+// with their posts, leaving out hidden posts.
+func (s *MemoryStore) SelectBookmarksWithPagination(userID string, limit int, cursor string) ([]*ormpkg.Bookmark, lib.PageInfo, error) {
+	user, err := parseID(userID)
+	if err != nil {
+		return nil, lib.PageInfo{}, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	bookmarks, page, err := pageRows(s.bookmarks, func(bookmark *ormpkg.Bookmark) bool {
+		return bookmark.UserID == user && !s.postHidden(bookmark.PostID)
+	}, ormpkg.NewestFirst, func(bookmark *ormpkg.Bookmark) []interface{} {
+		return []interface{}{bookmark.CreatedAt, bookmark.ID}
+	}, cursor, limit)
+	if err != nil {
+		return nil, lib.PageInfo{}, err
+	}
+
+	for _, bookmark := range bookmarks {
+		post, ok := s.posts[bookmark.PostID]
+		if !ok {
+			continue
+		}
+		bookmark.Post = *cloneRow(post)
+		if author, ok := s.users[post.AuthorID]; ok {
+			bookmark.Post.Author = *cloneRow(author)
+		}
+		if community, ok := s.communities[post.CommunityID]; ok {
+			bookmark.Post.Community = *cloneRow(community)
+		}
+	}
+	return bookmarks, page, nil
+}
+
+// SelectBookmarksByUserID returns the user's bookmarks oldest first.
+func (s *MemoryStore) SelectBookmarksByUserID(userID string) ([]*ormpkg.Bookmark, error) {
+	ID, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return selectRows(
+		s.bookmarks,
+		func(bookmark *ormpkg.Bookmark) bool { return bookmark.UserID == ID },
+		func(a *ormpkg.Bookmark, b *ormpkg.Bookmark) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	), nil
+}
+
+// InsertBookmark stores a bookmark unless it exists, like ON CONFLICT DO
+// NOTHING on bookmark_post_id_user_id_key.
+func (s *MemoryStore) InsertBookmark(bookmark *ormpkg.Bookmark) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	bookmark.BeforeCreate(nil)
+	setCreated(bookmark)
+
+	_, err := firstByID(s.bookmarks, func(other *ormpkg.Bookmark) bool {
+		return other.PostID == bookmark.PostID && other.UserID == bookmark.UserID
+	})
+	if err == nil {
+		return false, nil
+	}
+
+	stored := cloneRow(bookmark)
+	truncateTimes(stored)
+
+	s.bookmarks[stored.ID] = stored
+	return true, nil
+}
+
+func (s *MemoryStore) DeleteBookmark(postID uuid.UUID, userID uuid.UUID) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for ID, bookmark := range s.bookmarks {
+		if bookmark.PostID == postID && bookmark.UserID == userID {
+			delete(s.bookmarks, ID)
+			return true, nil
+		}
+	}
+	return false, nil
+}
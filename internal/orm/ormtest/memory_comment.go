@@ -0,0 +1,221 @@
+package ormtest
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+// SelectCommentByID returns the comment with its post and author.
+func (s *MemoryStore) SelectCommentByID(id string) (*ormpkg.Comment, error) {
+	commentID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	comment, ok := s.comments[commentID]
+	if !ok || !s.commentVisible(comment) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return s.preloadComment(cloneRow(comment)), nil
+}
+
+// SelectCommentsWithPagination lists comments newest first with their post
+// and author. A thread, listed by post only, keeps deleted comments that
+// have replies as placeholders.
+func (s *MemoryStore) SelectCommentsWithPagination(post_id string, author_id string, limit int, cursor string) ([]*ormpkg.Comment, lib.PageInfo, error) {
+	var postID, authorID uuid.UUID
+	var err error
+	if post_id != "" {
+		postID, err = parseID(post_id)
+		if err != nil {
+			return nil, lib.PageInfo{}, err
+		}
+	}
+	if author_id != "" {
+		authorID, err = parseID(author_id)
+		if err != nil {
+			return nil, lib.PageInfo{}, err
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	thread := post_id != "" && author_id == ""
+	comments, page, err := pageRows(s.comments, func(comment *ormpkg.Comment) bool {
+		return (post_id == "" || comment.PostID == postID) && (author_id == "" || comment.AuthorID == authorID) &&
+			!s.postHidden(comment.PostID) && (comment.DeletedAt == nil || thread && s.hasReplies(comment.ID))
+	}, ormpkg.NewestFirst, func(comment *ormpkg.Comment) []interface{} {
+		return []interface{}{comment.CreatedAt, comment.ID}
+	}, cursor, limit)
+	if err != nil {
+		return nil, lib.PageInfo{}, err
+	}
+
+	for _, comment := range comments {
+		s.preloadComment(comment)
+		if comment.DeletedAt != nil {
+			comment.AuthorID = uuid.Nil
+			comment.Author = ormpkg.User{}
+			comment.Content = ormpkg.DELETED_COMMENT_CONTENT
+		}
+	}
+	return comments, page, nil
+}
+
+func (s *MemoryStore) preloadComment(comment *ormpkg.Comment) *ormpkg.Comment {
+	if post, ok := s.posts[comment.PostID]; ok {
+		comment.Post = *cloneRow(post)
+	}
+	if author, ok := s.users[comment.AuthorID]; ok {
+		comment.Author = *cloneRow(author)
+	}
+	return comment
+}
+
+// SelectCommentsByAuthorID returns the author's comments oldest first,
+// including deleted ones.
+func (s *MemoryStore) SelectCommentsByAuthorID(authorID string) ([]*ormpkg.Comment, error) {
+	ID, err := parseID(authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return selectRows(
+		s.comments,
+		func(comment *ormpkg.Comment) bool { return comment.AuthorID == ID },
+		func(a *ormpkg.Comment, b *ormpkg.Comment) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	), nil
+}
+
+// InsertComment stores a comment, increments the post comment counter and
+// adds it to the community rating. Fails with gorm.ErrRecordNotFound if the
+// post does not exist.
+func (s *MemoryStore) InsertComment(comment *ormpkg.Comment) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	comment.BeforeCreate(nil)
+	setCreated(comment)
+
+	post, ok := s.posts[comment.PostID]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+
+	event := commentEvent(post, comment)
+	err := s.checkReputationEvent(event)
+	if err != nil {
+		return err
+	}
+
+	stored := cloneRow(comment)
+	truncateTimes(stored)
+
+	s.comments[stored.ID] = stored
+	post.CommentCount++
+	s.insertReputationEvent(event)
+	return nil
+}
+
+// UpdateComment saves the non-zero fields of comment, never the like
+// counter.
+func (s *MemoryStore) UpdateComment(comment *ormpkg.Comment) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.comments[comment.ID]
+	if !ok {
+		return nil
+	}
+
+	updated := cloneRow(stored)
+	updateRow(updated, comment, "LikeCount")
+
+	s.comments[updated.ID] = updated
+	return nil
+}
+
+// DeleteComment marks a comment deleted, decrements the comment counter of
+// comment.PostID and takes back the reputation the comment and its likes
+// gave. Deleting a deleted comment changes nothing.
+func (s *MemoryStore) DeleteComment(comment *ormpkg.Comment, deletedBy uuid.UUID, reason string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.comments[comment.ID]
+	if !ok || stored.DeletedAt != nil {
+		return nil
+	}
+
+	err := s.checkDeletedBy("comment", deletedBy)
+	if err != nil {
+		return err
+	}
+
+	stored.DeletedAt = deletionTime()
+	stored.DeletedBy = &deletedBy
+	stored.DeletionReason = reason
+	if post, ok := s.posts[comment.PostID]; ok {
+		post.CommentCount--
+	}
+
+	sourceIDs := map[uuid.UUID]bool{comment.ID: true}
+	for ID, commentLike := range s.commentLikes {
+		if commentLike.CommentID == comment.ID {
+			sourceIDs[ID] = true
+		}
+	}
+	s.revertReputationEvents(sourceIDs)
+
+	return nil
+}
+
+// SelectDeletedCommentByID finds a deleted comment that has not been purged
+// yet.
+func (s *MemoryStore) SelectDeletedCommentByID(id string) (*ormpkg.Comment, error) {
+	commentID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	comment, ok := s.comments[commentID]
+	if !ok || comment.DeletedAt == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return cloneRow(comment), nil
+}
+
+// RestoreComment undoes DeleteComment within the retention window. Fails
+// with gorm.ErrRecordNotFound if the comment cannot be restored.
+func (s *MemoryStore) RestoreComment(comment *ormpkg.Comment) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.comments[comment.ID]
+	if !ok || !restorable(stored.DeletedAt) {
+		return gorm.ErrRecordNotFound
+	}
+
+	stored.DeletedAt = nil
+	stored.DeletedBy = nil
+	stored.DeletionReason = ""
+	if post, ok := s.posts[comment.PostID]; ok {
+		post.CommentCount++
+		s.restoreCommentReputation(post, stored)
+	}
+
+	return nil
+}
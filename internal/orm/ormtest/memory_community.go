@@ -0,0 +1,279 @@
+package ormtest
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+func (s *MemoryStore) SelectCommunityByID(id string) (*ormpkg.Community, error) {
+	communityID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.selectCommunity(func(community *ormpkg.Community) bool {
+		return community.ID == communityID && community.DeletedAt == nil
+	})
+}
+
+// SelectCommunityBySlug also finds deleted communities, their slug stays
+// taken until they are purged.
+func (s *MemoryStore) SelectCommunityBySlug(slug string) (*ormpkg.Community, error) {
+	return s.selectCommunity(func(community *ormpkg.Community) bool { return community.Slug == slug })
+}
+
+func (s *MemoryStore) SelectCommunityByName(name string) (*ormpkg.Community, error) {
+	return s.selectCommunity(func(community *ormpkg.Community) bool {
+		return community.Name == name && community.DeletedAt == nil
+	})
+}
+
+func (s *MemoryStore) selectCommunity(match func(community *ormpkg.Community) bool) (*ormpkg.Community, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	community, err := firstByID(s.communities, match)
+	if err != nil {
+		return nil, err
+	}
+	return cloneRow(community), nil
+}
+
+func (s *MemoryStore) SelectCommunitiesWithPagination(owner_id string, limit int, cursor string) ([]*ormpkg.Community, lib.PageInfo, error) {
+	var ownerID uuid.UUID
+	if owner_id != "" {
+		var err error
+		ownerID, err = parseID(owner_id)
+		if err != nil {
+			return nil, lib.PageInfo{}, err
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return pageRows(s.communities, func(community *ormpkg.Community) bool {
+		return community.DeletedAt == nil && community.ArchivedAt == nil && (owner_id == "" || community.OwnerID == ownerID)
+	}, ormpkg.NewestFirst, func(community *ormpkg.Community) []interface{} {
+		return []interface{}{community.CreatedAt, community.ID}
+	}, cursor, limit)
+}
+
+func (s *MemoryStore) InsertCommunity(community *ormpkg.Community) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	community.BeforeCreate(nil)
+	setCreated(community)
+
+	stored := cloneRow(community)
+	truncateTimes(stored)
+
+	err := s.checkCommunityUnique(stored)
+	if err != nil {
+		return err
+	}
+
+	s.communities[stored.ID] = stored
+	return nil
+}
+
+// UpdateCommunity saves the non-zero fields of community, never the
+// counters and the rating.
+func (s *MemoryStore) UpdateCommunity(community *ormpkg.Community) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.communities[community.ID]
+	if !ok {
+		return nil
+	}
+
+	updated := cloneRow(stored)
+	updateRow(updated, community, "MemberCount", "PostCount", "Reputation")
+
+	err := s.checkCommunityUnique(updated)
+	if err != nil {
+		return err
+	}
+
+	s.communities[updated.ID] = updated
+	return nil
+}
+
+func (s *MemoryStore) checkCommunityUnique(community *ormpkg.Community) error {
+	for _, other := range s.communities {
+		if other.ID != community.ID && other.Slug == community.Slug {
+			return uniqueViolation("community_slug_key")
+		}
+	}
+	return nil
+}
+
+// DeleteCommunity marks a community deleted, which hides it with its posts.
+// Deleting a deleted community changes nothing.
+func (s *MemoryStore) DeleteCommunity(community *ormpkg.Community, deletedBy uuid.UUID, reason string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.communities[community.ID]
+	if !ok || stored.DeletedAt != nil {
+		return nil
+	}
+
+	err := s.checkDeletedBy("community", deletedBy)
+	if err != nil {
+		return err
+	}
+
+	stored.DeletedAt = deletionTime()
+	stored.DeletedBy = &deletedBy
+	stored.DeletionReason = reason
+	return nil
+}
+
+// SelectDeletedCommunityByID finds a deleted community that has not been
+// purged yet.
+func (s *MemoryStore) SelectDeletedCommunityByID(id string) (*ormpkg.Community, error) {
+	communityID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.selectCommunity(func(community *ormpkg.Community) bool {
+		return community.ID == communityID && community.DeletedAt != nil
+	})
+}
+
+// RestoreCommunity undoes DeleteCommunity within the retention window.
+// Fails with gorm.ErrRecordNotFound if the community cannot be restored.
+func (s *MemoryStore) RestoreCommunity(community *ormpkg.Community) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.communities[community.ID]
+	if !ok || !restorable(stored.DeletedAt) {
+		return gorm.ErrRecordNotFound
+	}
+
+	stored.DeletedAt = nil
+	stored.DeletedBy = nil
+	stored.DeletionReason = ""
+	return nil
+}
+
+// deleteCommunity deletes a community with its roles and reputation ledger
+// entries, which reference it on delete cascade.
+func (s *MemoryStore) deleteCommunity(communityID uuid.UUID) {
+	delete(s.communities, communityID)
+	for ID, role := range s.roles {
+		if role.CommunityID != nil && *role.CommunityID == communityID {
+			s.deleteRole(ID)
+		}
+	}
+	s.deleteReputationEvents(func(event *ormpkg.ReputationEvent) bool {
+		return event.CommunityID != nil && *event.CommunityID == communityID
+	})
+}
+
+func (s *MemoryStore) SelectCommunityUser(communityID string, userID string) (*ormpkg.CommunityUser, error) {
+	community, err := parseID(communityID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	index := s.communityUserIndex(community, user)
+	if index < 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return cloneRow(s.communityUsers[index]), nil
+}
+
+// SelectCommunityUsersByUserID returns the user's memberships with their
+// communities, oldest first. Deleted communities are left out.
+func (s *MemoryStore) SelectCommunityUsersByUserID(userID string) ([]*ormpkg.CommunityUser, error) {
+	ID, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	communityUsers := []*ormpkg.CommunityUser{}
+	for _, communityUser := range s.communityUsers {
+		if communityUser.UserID != ID || s.communityDeleted(communityUser.CommunityID) {
+			continue
+		}
+
+		selected := cloneRow(communityUser)
+		if community, ok := s.communities[communityUser.CommunityID]; ok {
+			selected.Community = *cloneRow(community)
+		}
+		communityUsers = append(communityUsers, selected)
+	}
+
+	slices.SortStableFunc(communityUsers, func(a *ormpkg.CommunityUser, b *ormpkg.CommunityUser) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return communityUsers, nil
+}
+
+// InsertCommunityUser adds a member and increments the member counter.
+// Returns false, and changes nothing, if the user is a member already.
+func (s *MemoryStore) InsertCommunityUser(communityUser *ormpkg.CommunityUser) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	communityUser.BeforeCreate(nil)
+	setCreated(communityUser)
+
+	if s.communityUserIndex(communityUser.CommunityID, communityUser.UserID) >= 0 {
+		return false, nil
+	}
+
+	stored := cloneRow(communityUser)
+	truncateTimes(stored)
+
+	s.communityUsers = append(s.communityUsers, stored)
+	if community, ok := s.communities[communityUser.CommunityID]; ok {
+		community.MemberCount++
+	}
+	return true, nil
+}
+
+// DeleteCommunityUser removes a member and decrements the member counter.
+// Returns false if the user is not a member.
+func (s *MemoryStore) DeleteCommunityUser(communityID uuid.UUID, userID uuid.UUID) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	index := s.communityUserIndex(communityID, userID)
+	if index < 0 {
+		return false, nil
+	}
+
+	s.communityUsers = slices.Delete(s.communityUsers, index, index+1)
+	if community, ok := s.communities[communityID]; ok {
+		community.MemberCount--
+	}
+	return true, nil
+}
+
+func (s *MemoryStore) communityUserIndex(communityID uuid.UUID, userID uuid.UUID) int {
+	return slices.IndexFunc(s.communityUsers, func(communityUser *ormpkg.CommunityUser) bool {
+		return communityUser.CommunityID == communityID && communityUser.UserID == userID
+	})
+}
@@ -0,0 +1,124 @@
+package ormtest
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+func (s *MemoryStore) SelectFollowerByID(userID string, followerID string) (*ormpkg.Follower, error) {
+	user, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+	follower, err := parseID(followerID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	follow, err := firstByID(s.followers, func(follow *ormpkg.Follower) bool {
+		return follow.UserID == user && follow.FollowerID == follower
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cloneRow(follow), nil
+}
+
+// SelectFollowersWithPagination lists follows newest first with both users,
+// filtered by the followed user, the follower or both.
+func (s *MemoryStore) SelectFollowersWithPagination(userID string, followerID string, limit int, cursor string) ([]*ormpkg.Follower, lib.PageInfo, error) {
+	var user, follower uuid.UUID
+	var err error
+	if userID != "" {
+		user, err = parseID(userID)
+		if err != nil {
+			return nil, lib.PageInfo{}, err
+		}
+	}
+	if followerID != "" {
+		follower, err = parseID(followerID)
+		if err != nil {
+			return nil, lib.PageInfo{}, err
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	follows, page, err := pageRows(s.followers, func(follow *ormpkg.Follower) bool {
+		return (userID == "" || follow.UserID == user) && (followerID == "" || follow.FollowerID == follower)
+	}, ormpkg.NewestFirst, func(follow *ormpkg.Follower) []interface{} {
+		return []interface{}{follow.CreatedAt, follow.ID}
+	}, cursor, limit)
+	if err != nil {
+		return nil, lib.PageInfo{}, err
+	}
+
+	for _, follow := range follows {
+		if user, ok := s.users[follow.UserID]; ok {
+			follow.User = *cloneRow(user)
+		}
+		if follower, ok := s.users[follow.FollowerID]; ok {
+			follow.Follower = *cloneRow(follower)
+		}
+	}
+	return follows, page, nil
+}
+
+// SelectFollowsByUserID returns follows in both directions, oldest first.
+func (s *MemoryStore) SelectFollowsByUserID(userID string) ([]*ormpkg.Follower, error) {
+	ID, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return selectRows(
+		s.followers,
+		func(follow *ormpkg.Follower) bool { return follow.UserID == ID || follow.FollowerID == ID },
+		func(a *ormpkg.Follower, b *ormpkg.Follower) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	), nil
+}
+
+// InsertFollower stores a follow unless it exists, like ON CONFLICT DO
+// NOTHING on follower_user_id_follower_id_key.
+func (s *MemoryStore) InsertFollower(follower *ormpkg.Follower) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	follower.BeforeCreate(nil)
+	setCreated(follower)
+
+	_, err := firstByID(s.followers, func(other *ormpkg.Follower) bool {
+		return other.UserID == follower.UserID && other.FollowerID == follower.FollowerID
+	})
+	if err == nil {
+		return false, nil
+	}
+
+	stored := cloneRow(follower)
+	truncateTimes(stored)
+
+	s.followers[stored.ID] = stored
+	return true, nil
+}
+
+func (s *MemoryStore) DeleteFollower(userID uuid.UUID, followerID uuid.UUID) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for ID, follow := range s.followers {
+		if follow.UserID == userID && follow.FollowerID == followerID {
+			delete(s.followers, ID)
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,121 @@
+package ormtest
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+func (s *MemoryStore) SelectInviteCodeByID(ID string) (*ormpkg.InviteCode, error) {
+	codeID, err := parseID(ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	code, ok := s.inviteCodes[codeID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return cloneRow(code), nil
+}
+
+// SelectInviteCodesWithPagination lists invite codes newest first.
+func (s *MemoryStore) SelectInviteCodesWithPagination(limit int, cursor string) ([]*ormpkg.InviteCode, lib.PageInfo, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return pageRows(s.inviteCodes, func(code *ormpkg.InviteCode) bool {
+		return true
+	}, ormpkg.NewestFirst, func(code *ormpkg.InviteCode) []interface{} {
+		return []interface{}{code.CreatedAt, code.ID}
+	}, cursor, limit)
+}
+
+func (s *MemoryStore) InsertInviteCode(code *ormpkg.InviteCode) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if code.CreatedBy != nil {
+		if _, ok := s.users[*code.CreatedBy]; !ok {
+			return foreignKeyViolation("invite_code", "invite_code_created_by_fkey")
+		}
+	}
+	for _, other := range s.inviteCodes {
+		if other.CodeHash == code.CodeHash {
+			return uniqueViolation("invite_code_code_hash_key")
+		}
+	}
+
+	code.BeforeCreate(nil)
+	setCreated(code)
+
+	stored := cloneRow(code)
+	truncateTimes(stored)
+
+	s.inviteCodes[stored.ID] = stored
+	return nil
+}
+
+// RevokeInviteCode returns gorm.ErrRecordNotFound if there is no such code
+// or it is already revoked.
+func (s *MemoryStore) RevokeInviteCode(ID string) error {
+	codeID, err := parseID(ID)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.inviteCodes[codeID]
+	if !ok || stored.RevokedAt != nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	revoked := cloneRow(stored)
+	now := time.Now()
+	revoked.RevokedAt = &now
+	truncateTimes(revoked)
+
+	s.inviteCodes[codeID] = revoked
+	return nil
+}
+
+// InsertUserWithInviteCode creates the user and spends one use of the code,
+// or neither, see PostgresClient.InsertUserWithInviteCode.
+func (s *MemoryStore) InsertUserWithInviteCode(user *ormpkg.User, codeHash string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.userSlugTaken(user.SlugSkeleton, uuid.Nil) {
+		return ormpkg.ErrUserSlugTaken
+	}
+
+	var code *ormpkg.InviteCode
+	for _, stored := range s.inviteCodes {
+		if stored.CodeHash == codeHash && stored.IsActive(time.Now()) {
+			code = stored
+		}
+	}
+	if code == nil {
+		return ormpkg.ErrInviteCodeInvalid
+	}
+
+	user.InviteCodeID = &code.ID
+	err := s.insertUser(user)
+	if err != nil {
+		return err
+	}
+
+	spent := cloneRow(code)
+	spent.UseCount++
+	s.inviteCodes[spent.ID] = spent
+	return nil
+}
@@ -0,0 +1,220 @@
+package ormtest
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+func (s *MemoryStore) SelectPostLikeByID(postID string, userID string) (*ormpkg.PostLike, error) {
+	post, err := parseID(postID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	postLike, err := firstByID(s.postLikes, func(postLike *ormpkg.PostLike) bool {
+		return postLike.PostID == post && postLike.UserID == user
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cloneRow(postLike), nil
+}
+
+// SelectPostLikesByUserID returns the user's post likes oldest first.
+func (s *MemoryStore) SelectPostLikesByUserID(userID string) ([]*ormpkg.PostLike, error) {
+	ID, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return selectRows(
+		s.postLikes,
+		func(postLike *ormpkg.PostLike) bool { return postLike.UserID == ID },
+		func(a *ormpkg.PostLike, b *ormpkg.PostLike) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	), nil
+}
+
+// InsertPostLike stores a like, increments the post like counter and
+// credits the author and the community. Returns false, and changes nothing,
+// if the user already liked the post; fails with gorm.ErrRecordNotFound if
+// the post does not exist.
+func (s *MemoryStore) InsertPostLike(postLike *ormpkg.PostLike) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	postLike.BeforeCreate(nil)
+	setCreated(postLike)
+
+	_, err := firstByID(s.postLikes, func(other *ormpkg.PostLike) bool {
+		return other.PostID == postLike.PostID && other.UserID == postLike.UserID
+	})
+	if err == nil {
+		return false, nil
+	}
+
+	post, ok := s.posts[postLike.PostID]
+	if !ok {
+		return false, gorm.ErrRecordNotFound
+	}
+
+	event := postLikeEvent(post, postLike)
+	err = s.checkReputationEvent(event)
+	if err != nil {
+		return false, err
+	}
+
+	stored := cloneRow(postLike)
+	truncateTimes(stored)
+
+	s.postLikes[stored.ID] = stored
+	post.LikeCount++
+	s.insertReputationEvent(event)
+	return true, nil
+}
+
+// DeletePostLike removes a like, decrements the post like counter and takes
+// back the reputation it gave. Returns false if the user has not liked the
+// post.
+func (s *MemoryStore) DeletePostLike(postID uuid.UUID, userID uuid.UUID) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	deleted := false
+	for ID, postLike := range s.postLikes {
+		if postLike.PostID != postID || postLike.UserID != userID {
+			continue
+		}
+
+		delete(s.postLikes, ID)
+		s.revertReputationEvents(map[uuid.UUID]bool{ID: true})
+		deleted = true
+	}
+	if !deleted {
+		return false, nil
+	}
+
+	if post, ok := s.posts[postID]; ok {
+		post.LikeCount--
+	}
+	return true, nil
+}
+
+func (s *MemoryStore) SelectCommentLikeByID(commentID string, userID string) (*ormpkg.CommentLike, error) {
+	comment, err := parseID(commentID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	commentLike, err := firstByID(s.commentLikes, func(commentLike *ormpkg.CommentLike) bool {
+		return commentLike.CommentID == comment && commentLike.UserID == user
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cloneRow(commentLike), nil
+}
+
+// SelectCommentLikesByUserID returns the user's comment likes oldest first.
+func (s *MemoryStore) SelectCommentLikesByUserID(userID string) ([]*ormpkg.CommentLike, error) {
+	ID, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return selectRows(
+		s.commentLikes,
+		func(commentLike *ormpkg.CommentLike) bool { return commentLike.UserID == ID },
+		func(a *ormpkg.CommentLike, b *ormpkg.CommentLike) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	), nil
+}
+
+// InsertCommentLike stores a like, increments the comment like counter and
+// credits the author in the community of the post. Returns false, and
+// changes nothing, if the user already liked the comment; fails with
+// gorm.ErrRecordNotFound if the comment or its post does not exist.
+func (s *MemoryStore) InsertCommentLike(commentLike *ormpkg.CommentLike) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	commentLike.BeforeCreate(nil)
+	setCreated(commentLike)
+
+	_, err := firstByID(s.commentLikes, func(other *ormpkg.CommentLike) bool {
+		return other.CommentID == commentLike.CommentID && other.UserID == commentLike.UserID
+	})
+	if err == nil {
+		return false, nil
+	}
+
+	comment, ok := s.comments[commentLike.CommentID]
+	if !ok {
+		return false, gorm.ErrRecordNotFound
+	}
+	post, ok := s.posts[comment.PostID]
+	if !ok {
+		return false, gorm.ErrRecordNotFound
+	}
+
+	event := commentLikeEvent(post, comment, commentLike)
+	err = s.checkReputationEvent(event)
+	if err != nil {
+		return false, err
+	}
+
+	stored := cloneRow(commentLike)
+	truncateTimes(stored)
+
+	s.commentLikes[stored.ID] = stored
+	comment.LikeCount++
+	s.insertReputationEvent(event)
+	return true, nil
+}
+
+// DeleteCommentLike removes a like, decrements the comment like counter and
+// takes back the reputation it gave. Returns false if the user has not liked
+// the comment.
+func (s *MemoryStore) DeleteCommentLike(commentID uuid.UUID, userID uuid.UUID) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	deleted := false
+	for ID, commentLike := range s.commentLikes {
+		if commentLike.CommentID != commentID || commentLike.UserID != userID {
+			continue
+		}
+
+		delete(s.commentLikes, ID)
+		s.revertReputationEvents(map[uuid.UUID]bool{ID: true})
+		deleted = true
+	}
+	if !deleted {
+		return false, nil
+	}
+
+	if comment, ok := s.comments[commentID]; ok {
+		comment.LikeCount--
+	}
+	return true, nil
+}
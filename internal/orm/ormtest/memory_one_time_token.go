@@ -0,0 +1,85 @@
+package ormtest
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+// InsertOneTimeToken drops the user's unconsumed tokens of the same purpose
+// before storing the new one, see PostgresClient.InsertOneTimeToken.
+func (s *MemoryStore) InsertOneTimeToken(token *ormpkg.OneTimeToken) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.users[token.UserID]; !ok {
+		return foreignKeyViolation("one_time_token", "one_time_token_user_id_fkey")
+	}
+	for _, other := range s.oneTimeTokens {
+		if other.TokenHash == token.TokenHash && !s.isReplacedToken(other, token) {
+			return uniqueViolation("one_time_token_token_hash_key")
+		}
+	}
+
+	for ID, other := range s.oneTimeTokens {
+		if s.isReplacedToken(other, token) {
+			delete(s.oneTimeTokens, ID)
+		}
+	}
+
+	token.BeforeCreate(nil)
+	setCreated(token)
+
+	stored := cloneRow(token)
+	truncateTimes(stored)
+
+	s.oneTimeTokens[stored.ID] = stored
+	return nil
+}
+
+// isReplacedToken reports whether inserting token drops other.
+func (s *MemoryStore) isReplacedToken(other *ormpkg.OneTimeToken, token *ormpkg.OneTimeToken) bool {
+	return other.UserID == token.UserID && other.Purpose == token.Purpose && other.ConsumedAt == nil
+}
+
+// ConsumeOneTimeToken marks an unexpired, unconsumed token as consumed and
+// returns it.
+func (s *MemoryStore) ConsumeOneTimeToken(purpose string, tokenHash string) (*ormpkg.OneTimeToken, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	for ID, token := range s.oneTimeTokens {
+		if token.TokenHash != tokenHash || token.Purpose != purpose || token.ConsumedAt != nil || !token.ExpiresAt.After(now) {
+			continue
+		}
+
+		consumed := cloneRow(token)
+		consumed.ConsumedAt = &now
+		truncateTimes(consumed)
+
+		s.oneTimeTokens[ID] = consumed
+		return cloneRow(consumed), nil
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (s *MemoryStore) DeleteOneTimeTokensByUserID(userID string, purpose string) error {
+	ID, err := parseID(userID)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for tokenID, token := range s.oneTimeTokens {
+		if token.UserID == ID && token.Purpose == purpose && token.ConsumedAt == nil {
+			delete(s.oneTimeTokens, tokenID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package ormtest
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+// SelectPostByID returns the post with its community and author.
+func (s *MemoryStore) SelectPostByID(id string) (*ormpkg.Post, error) {
+	postID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	post, ok := s.posts[postID]
+	if !ok || !s.postVisible(post) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return s.preloadPost(cloneRow(post)), nil
+}
+
+// SelectPostsWithPagination lists posts in the order of sort with their
+// community and author.
+func (s *MemoryStore) SelectPostsWithPagination(author_id string, sort string, limit int, cursor string) ([]*ormpkg.Post, lib.PageInfo, error) {
+	keys, values, ok := ormpkg.PostSort(sort)
+	if !ok {
+		return nil, lib.PageInfo{}, ormpkg.ErrUnknownPostSort
+	}
+
+	var authorID uuid.UUID
+	if author_id != "" {
+		var err error
+		authorID, err = parseID(author_id)
+		if err != nil {
+			return nil, lib.PageInfo{}, err
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	posts, page, err := pageRows(s.posts, func(post *ormpkg.Post) bool {
+		return s.postVisible(post) && (author_id == "" || post.AuthorID == authorID)
+	}, keys, values, cursor, limit)
+	if err != nil {
+		return nil, lib.PageInfo{}, err
+	}
+
+	for _, post := range posts {
+		s.preloadPost(post)
+	}
+	return posts, page, nil
+}
+
+func (s *MemoryStore) preloadPost(post *ormpkg.Post) *ormpkg.Post {
+	if community, ok := s.communities[post.CommunityID]; ok {
+		post.Community = *cloneRow(community)
+	}
+	if author, ok := s.users[post.AuthorID]; ok {
+		post.Author = *cloneRow(author)
+	}
+	return post
+}
+
+// SelectPostsByAuthorID returns the author's posts oldest first, including
+// deleted ones.
+func (s *MemoryStore) SelectPostsByAuthorID(authorID string) ([]*ormpkg.Post, error) {
+	ID, err := parseID(authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return selectRows(
+		s.posts,
+		func(post *ormpkg.Post) bool { return post.AuthorID == ID },
+		func(a *ormpkg.Post, b *ormpkg.Post) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	), nil
+}
+
+// InsertPost stores a post and increments the community post counter.
+func (s *MemoryStore) InsertPost(post *ormpkg.Post) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	post.BeforeCreate(nil)
+	setCreated(post)
+
+	if post.Content == nil {
+		return notNullViolation("post", "content")
+	}
+
+	stored := cloneRow(post)
+	truncateTimes(stored)
+
+	s.posts[stored.ID] = stored
+	if community, ok := s.communities[post.CommunityID]; ok {
+		community.PostCount++
+	}
+	return nil
+}
+
+// UpdatePost saves the non-zero fields of post, never the counters.
+func (s *MemoryStore) UpdatePost(post *ormpkg.Post) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.posts[post.ID]
+	if !ok {
+		return nil
+	}
+
+	updated := cloneRow(stored)
+	updateRow(updated, post, "LikeCount", "CommentCount")
+
+	s.posts[updated.ID] = updated
+	return nil
+}
+
+// DeletePost marks a post deleted, decrements the post counter of
+// post.CommunityID and takes back the reputation its likes and comments
+// gave. Deleting a deleted post changes nothing.
+func (s *MemoryStore) DeletePost(post *ormpkg.Post, deletedBy uuid.UUID, reason string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.posts[post.ID]
+	if !ok || stored.DeletedAt != nil {
+		return nil
+	}
+
+	err := s.checkDeletedBy("post", deletedBy)
+	if err != nil {
+		return err
+	}
+
+	stored.DeletedAt = deletionTime()
+	stored.DeletedBy = &deletedBy
+	stored.DeletionReason = reason
+	if community, ok := s.communities[post.CommunityID]; ok {
+		community.PostCount--
+	}
+
+	sourceIDs := map[uuid.UUID]bool{}
+	for ID, postLike := range s.postLikes {
+		if postLike.PostID == post.ID {
+			sourceIDs[ID] = true
+		}
+	}
+	for commentID, comment := range s.comments {
+		if comment.PostID != post.ID {
+			continue
+		}
+
+		sourceIDs[commentID] = true
+		for ID, commentLike := range s.commentLikes {
+			if commentLike.CommentID == commentID {
+				sourceIDs[ID] = true
+			}
+		}
+	}
+	s.revertReputationEvents(sourceIDs)
+
+	return nil
+}
+
+// SelectDeletedPostByID finds a deleted post that has not been purged yet.
+func (s *MemoryStore) SelectDeletedPostByID(id string) (*ormpkg.Post, error) {
+	postID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	post, ok := s.posts[postID]
+	if !ok || post.DeletedAt == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return cloneRow(post), nil
+}
+
+// RestorePost undoes DeletePost within the retention window. Fails with
+// gorm.ErrRecordNotFound if the post cannot be restored.
+func (s *MemoryStore) RestorePost(post *ormpkg.Post) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.posts[post.ID]
+	if !ok || !restorable(stored.DeletedAt) {
+		return gorm.ErrRecordNotFound
+	}
+
+	stored.DeletedAt = nil
+	stored.DeletedBy = nil
+	stored.DeletionReason = ""
+	if community, ok := s.communities[post.CommunityID]; ok {
+		community.PostCount++
+	}
+	s.restorePostReputation(stored)
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package ormtest
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+// postLikeEvent credits the post author and the community for a like.
+func postLikeEvent(post *ormpkg.Post, postLike *ormpkg.PostLike) *ormpkg.ReputationEvent {
+	return &ormpkg.ReputationEvent{
+		UserID:         &post.AuthorID,
+		CommunityID:    &post.CommunityID,
+		Kind:           ormpkg.REPUTATION_POST_LIKE,
+		SourceID:       &postLike.ID,
+		ActorID:        &postLike.UserID,
+		UserDelta:      ormpkg.REPUTATION_LIKE_POINTS,
+		CommunityDelta: ormpkg.REPUTATION_LIKE_POINTS,
+	}
+}
+
+// commentLikeEvent credits the comment author, in the community of the
+// post, for a like.
+func commentLikeEvent(post *ormpkg.Post, comment *ormpkg.Comment, commentLike *ormpkg.CommentLike) *ormpkg.ReputationEvent {
+	return &ormpkg.ReputationEvent{
+		UserID:      &comment.AuthorID,
+		CommunityID: &post.CommunityID,
+		Kind:        ormpkg.REPUTATION_COMMENT_LIKE,
+		SourceID:    &commentLike.ID,
+		ActorID:     &commentLike.UserID,
+		UserDelta:   ormpkg.REPUTATION_LIKE_POINTS,
+	}
+}
+
+// commentEvent adds a comment to the rating of the community of its post.
+func commentEvent(post *ormpkg.Post, comment *ormpkg.Comment) *ormpkg.ReputationEvent {
+	return &ormpkg.ReputationEvent{
+		CommunityID:    &post.CommunityID,
+		Kind:           ormpkg.REPUTATION_COMMENT_RECEIVED,
+		SourceID:       &comment.ID,
+		ActorID:        &comment.AuthorID,
+		CommunityDelta: ormpkg.REPUTATION_COMMENT_POINTS,
+	}
+}
+
+// checkReputationEvent checks the foreign keys of a ledger entry.
+func (s *MemoryStore) checkReputationEvent(event *ormpkg.ReputationEvent) error {
+	if event.UserID != nil {
+		if _, ok := s.users[*event.UserID]; !ok {
+			return foreignKeyViolation("reputation_event", "reputation_event_user_id_fkey")
+		}
+	}
+	if event.CommunityID != nil {
+		if _, ok := s.communities[*event.CommunityID]; !ok {
+			return foreignKeyViolation("reputation_event", "reputation_event_community_id_fkey")
+		}
+	}
+	if event.ActorID != nil {
+		if _, ok := s.users[*event.ActorID]; !ok {
+			return foreignKeyViolation("reputation_event", "reputation_event_actor_id_fkey")
+		}
+	}
+	return nil
+}
+
+// insertReputationEvent records a checked ledger entry and applies it to the
+// user reputation and the community rating.
+func (s *MemoryStore) insertReputationEvent(event *ormpkg.ReputationEvent) {
+	event.BeforeCreate(nil)
+	event.CreatedAt = time.Now()
+
+	stored := cloneRow(event)
+	truncateTimes(stored)
+	s.reputationEvents = append(s.reputationEvents, stored)
+
+	if event.UserID != nil {
+		if user, ok := s.users[*event.UserID]; ok {
+			user.Reputation += event.UserDelta
+		}
+	}
+	if event.CommunityID != nil {
+		if community, ok := s.communities[*event.CommunityID]; ok {
+			community.Reputation = roundToTenth(community.Reputation + event.CommunityDelta)
+		}
+	}
+}
+
+// revertReputationEvents records, per source and recipient, the opposite of
+// what the ledger still holds for the given likes and comments.
+func (s *MemoryStore) revertReputationEvents(sourceIDs map[uuid.UUID]bool) {
+	type recipient struct {
+		source    uuid.UUID
+		user      uuid.UUID
+		community uuid.UUID
+	}
+
+	var recipients []recipient
+	balances := map[recipient]*ormpkg.ReputationEvent{}
+	for _, event := range s.reputationEvents {
+		if event.SourceID == nil || !sourceIDs[*event.SourceID] {
+			continue
+		}
+
+		key := recipient{source: *event.SourceID}
+		if event.UserID != nil {
+			key.user = *event.UserID
+		}
+		if event.CommunityID != nil {
+			key.community = *event.CommunityID
+		}
+
+		balance, ok := balances[key]
+		if !ok {
+			balance = &ormpkg.ReputationEvent{
+				UserID:      event.UserID,
+				CommunityID: event.CommunityID,
+				SourceID:    event.SourceID,
+				Kind:        strings.TrimSuffix(event.Kind, ormpkg.REPUTATION_REMOVED_SUFFIX),
+			}
+			balances[key] = balance
+			recipients = append(recipients, key)
+		}
+		balance.UserDelta += event.UserDelta
+		balance.CommunityDelta = roundToTenth(balance.CommunityDelta + event.CommunityDelta)
+	}
+
+	for _, key := range recipients {
+		balance := balances[key]
+		if balance.UserDelta == 0 && balance.CommunityDelta == 0 {
+			continue
+		}
+
+		s.insertReputationEvent(&ormpkg.ReputationEvent{
+			UserID:         balance.UserID,
+			CommunityID:    balance.CommunityID,
+			Kind:           balance.Kind + ormpkg.REPUTATION_REMOVED_SUFFIX,
+			SourceID:       balance.SourceID,
+			UserDelta:      -balance.UserDelta,
+			CommunityDelta: -balance.CommunityDelta,
+		})
+	}
+}
+
+// deleteReputationEvents drops ledger entries on a cascading delete, the
+// totals of the deleted user or community go with it.
+func (s *MemoryStore) deleteReputationEvents(match func(event *ormpkg.ReputationEvent) bool) {
+	s.reputationEvents = slices.DeleteFunc(s.reputationEvents, match)
+}
+
+// roundToTenth keeps a rating at the NUMERIC(14, 1) precision of the
+// column.
+func roundToTenth(value float64) float64 {
+	return math.Round(value*10) / 10
+}
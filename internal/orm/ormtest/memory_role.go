@@ -0,0 +1,180 @@
+package ormtest
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+// Column defaults of the roles table
+const ROLE_DEFAULT_COLOR = "#95a5a6"
+const ROLE_DEFAULT_PERMISSIONS = "{}"
+
+// SelectRoleByName finds a role of the community, or a platform role if
+// communityID is nil.
+func (s *MemoryStore) SelectRoleByName(name string, communityID *uuid.UUID) (*ormpkg.Role, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	role, err := firstByID(s.roles, func(role *ormpkg.Role) bool {
+		if role.Name != name {
+			return false
+		}
+		if communityID == nil {
+			return role.CommunityID == nil
+		}
+		return role.CommunityID != nil && *role.CommunityID == *communityID
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cloneRow(role), nil
+}
+
+// SelectRolesByUserID returns the user's roles oldest first.
+func (s *MemoryStore) SelectRolesByUserID(userID string) ([]*ormpkg.Role, error) {
+	ID, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return selectRows(
+		s.roles,
+		func(role *ormpkg.Role) bool {
+			return slices.Contains(s.userRoles, ormpkg.UserRole{UserID: ID, RoleID: role.ID})
+		},
+		func(a *ormpkg.Role, b *ormpkg.Role) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	), nil
+}
+
+// SelectUserHasPlatformPermission reports whether the user is the platform
+// owner or holds a platform role granting the given permission.
+func (s *MemoryStore) SelectUserHasPlatformPermission(userID string, permission string) (bool, error) {
+	ID, err := parseID(userID)
+	if err != nil {
+		return false, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.platformOwnerID != nil && *s.platformOwnerID == ID {
+		return true, nil
+	}
+
+	for _, userRole := range s.userRoles {
+		role, ok := s.roles[userRole.RoleID]
+		if userRole.UserID != ID || !ok || role.CommunityID != nil {
+			continue
+		}
+
+		// permissions ->> permission = 'true' matches both true and "true"
+		var permissions map[string]interface{}
+		err = json.Unmarshal(role.Permissions, &permissions)
+		if err != nil {
+			return false, err
+		}
+		if granted := permissions[permission]; granted == true || granted == "true" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// InsertRole stores a role. Like the unique constraint on (name,
+// community_id), only community roles need distinct names: NULL community
+// ids never collide.
+func (s *MemoryStore) InsertRole(role *ormpkg.Role) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	role.BeforeCreate(nil)
+	setCreated(role)
+	if role.Color == "" {
+		role.Color = ROLE_DEFAULT_COLOR
+	}
+	if len(role.Permissions) == 0 {
+		role.Permissions = json.RawMessage(ROLE_DEFAULT_PERMISSIONS)
+	}
+
+	if role.CommunityID != nil {
+		if _, ok := s.communities[*role.CommunityID]; !ok {
+			return foreignKeyViolation("roles", "fk_community")
+		}
+
+		for _, other := range s.roles {
+			if other.Name == role.Name && other.CommunityID != nil && *other.CommunityID == *role.CommunityID {
+				return uniqueViolation("roles_name_community_id_key")
+			}
+		}
+	}
+
+	stored := cloneRow(role)
+	truncateTimes(stored)
+
+	s.roles[stored.ID] = stored
+	return nil
+}
+
+func (s *MemoryStore) InsertUserRole(userRole *ormpkg.UserRole) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if slices.Contains(s.userRoles, *userRole) {
+		return uniqueViolation("user_roles_pkey")
+	}
+	if _, ok := s.users[userRole.UserID]; !ok {
+		return foreignKeyViolation("user_roles", "user_roles_user_id_fkey")
+	}
+	if _, ok := s.roles[userRole.RoleID]; !ok {
+		return foreignKeyViolation("user_roles", "user_roles_role_id_fkey")
+	}
+
+	s.userRoles = append(s.userRoles, *userRole)
+	return nil
+}
+
+// DeleteUserRole deletes by the non-zero parts of the primary key, like
+// gorm does.
+func (s *MemoryStore) DeleteUserRole(userRole *ormpkg.UserRole) error {
+	if userRole.UserID == uuid.Nil && userRole.RoleID == uuid.Nil {
+		return gorm.ErrMissingWhereClause
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.userRoles = slices.DeleteFunc(s.userRoles, func(other ormpkg.UserRole) bool {
+		return (userRole.UserID == uuid.Nil || other.UserID == userRole.UserID) &&
+			(userRole.RoleID == uuid.Nil || other.RoleID == userRole.RoleID)
+	})
+	return nil
+}
+
+func (s *MemoryStore) UpdatePlatformOwner(userID uuid.UUID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.users[userID]; !ok {
+		return foreignKeyViolation("platform_settings", "platform_settings_platform_owner_id_fkey")
+	}
+
+	s.platformOwnerID = &userID
+	return nil
+}
+
+// deleteRole deletes a role and, on delete cascade, its assignments.
+func (s *MemoryStore) deleteRole(ID uuid.UUID) {
+	delete(s.roles, ID)
+	s.userRoles = slices.DeleteFunc(s.userRoles, func(userRole ormpkg.UserRole) bool {
+		return userRole.RoleID == ID
+	})
+}
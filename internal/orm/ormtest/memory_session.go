@@ -0,0 +1,189 @@
+package ormtest
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+func (s *MemoryStore) SelectSessionByID(ID string) (*ormpkg.Session, error) {
+	sessionID, err := parseID(ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	session, ok := s.sessions[sessionID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return cloneRow(session), nil
+}
+
+// SelectSessionsByUserID lists the user's sessions newest first, a negative
+// limit lists all of them.
+func (s *MemoryStore) SelectSessionsByUserID(userID string, cursor string, limit int) ([]*ormpkg.Session, lib.PageInfo, error) {
+	ID, err := parseID(userID)
+	if err != nil {
+		return nil, lib.PageInfo{}, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return pageRows(s.sessions, func(session *ormpkg.Session) bool {
+		return session.UserID == ID
+	}, ormpkg.NewestFirst, func(session *ormpkg.Session) []interface{} {
+		return []interface{}{session.CreatedAt, session.ID}
+	}, cursor, limit)
+}
+
+func (s *MemoryStore) InsertSession(session *ormpkg.Session) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	session.BeforeCreate(nil)
+	setCreated(session)
+
+	stored := cloneRow(session)
+	truncateTimes(stored)
+
+	s.sessions[stored.ID] = stored
+	return nil
+}
+
+func (s *MemoryStore) UpdateSession(session *ormpkg.Session) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.sessions[session.ID]
+	if !ok {
+		return nil
+	}
+
+	updated := cloneRow(stored)
+	updateRow(updated, session)
+
+	s.sessions[updated.ID] = updated
+	return nil
+}
+
+func (s *MemoryStore) RotateSessionRefreshToken(sessionID string, previousHash string, refreshTokenHash string) (bool, error) {
+	ID, err := parseID(sessionID)
+	if err != nil {
+		return false, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.sessions[ID]
+	if !ok || stored.RefreshTokenHash != previousHash {
+		return false, nil
+	}
+
+	updated := cloneRow(stored)
+	updated.RefreshTokenHash = refreshTokenHash
+	setUpdated(updated)
+
+	s.sessions[ID] = updated
+	return true, nil
+}
+
+func (s *MemoryStore) UpdateSessionName(sessionID string, userID string, name string) error {
+	ID, err := parseID(sessionID)
+	if err != nil {
+		return err
+	}
+	ownerID, err := parseID(userID)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.sessions[ID]
+	if !ok || stored.UserID != ownerID {
+		return gorm.ErrRecordNotFound
+	}
+
+	updated := cloneRow(stored)
+	updated.Name = name
+	setUpdated(updated)
+
+	s.sessions[ID] = updated
+	return nil
+}
+
+func (s *MemoryStore) DeleteSession(session *ormpkg.Session) error {
+	if session.ID == uuid.Nil {
+		return gorm.ErrMissingWhereClause
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.sessions, session.ID)
+	return nil
+}
+
+// DeleteSessions deletes sessions not used for 30 days.
+func (s *MemoryStore) DeleteSessions() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	thirtyDaysAgo := time.Now().Add(-30 * 24 * time.Hour)
+	for ID, session := range s.sessions {
+		if session.UpdatedAt.Before(thirtyDaysAgo) {
+			delete(s.sessions, ID)
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) DeleteSessionsByUserID(userID string) ([]string, error) {
+	ID, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deleteSessions(func(session *ormpkg.Session) bool {
+		return session.UserID == ID
+	}), nil
+}
+
+func (s *MemoryStore) DeleteOtherSessionsByUserID(userID string, exceptSessionID string) ([]string, error) {
+	ID, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+	exceptID, err := parseID(exceptSessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deleteSessions(func(session *ormpkg.Session) bool {
+		return session.UserID == ID && session.ID != exceptID
+	}), nil
+}
+
+func (s *MemoryStore) deleteSessions(match func(session *ormpkg.Session) bool) []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	sessionIDs := []string{}
+	for ID, session := range s.sessions {
+		if match(session) {
+			delete(s.sessions, ID)
+			sessionIDs = append(sessionIDs, ID.String())
+		}
+	}
+	return sessionIDs
+}
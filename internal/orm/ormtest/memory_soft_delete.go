@@ -0,0 +1,231 @@
+package ormtest
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+// communityDeleted, postHidden, postVisible and commentVisible follow the
+// visibility conditions of PostgresClient: deleted rows are hidden together
+// with everything in a deleted post or community.
+func (s *MemoryStore) communityDeleted(ID uuid.UUID) bool {
+	community, ok := s.communities[ID]
+	return ok && community.DeletedAt != nil
+}
+
+func (s *MemoryStore) postHidden(ID uuid.UUID) bool {
+	post, ok := s.posts[ID]
+	return ok && !s.postVisible(post)
+}
+
+func (s *MemoryStore) postVisible(post *ormpkg.Post) bool {
+	return post.DeletedAt == nil && !s.communityDeleted(post.CommunityID)
+}
+
+func (s *MemoryStore) commentVisible(comment *ormpkg.Comment) bool {
+	return comment.DeletedAt == nil && !s.postHidden(comment.PostID)
+}
+
+// hasReplies reports whether other comments reply to a comment, deleted or
+// not.
+func (s *MemoryStore) hasReplies(commentID uuid.UUID) bool {
+	for _, reply := range s.comments {
+		if reply.ParentCommentID != nil && *reply.ParentCommentID == commentID {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDeletedBy checks the deleted_by foreign key of a table.
+func (s *MemoryStore) checkDeletedBy(table string, deletedBy uuid.UUID) error {
+	if _, ok := s.users[deletedBy]; !ok {
+		return foreignKeyViolation(table, table+"_deleted_by_fkey")
+	}
+	return nil
+}
+
+// deletionTime is the deleted_at of a row deleted now.
+func deletionTime() *time.Time {
+	now := time.Now().Truncate(time.Microsecond)
+	return &now
+}
+
+// restorable reports whether a row was deleted within the retention window.
+func restorable(deletedAt *time.Time) bool {
+	return deletedAt != nil && deletedAt.After(time.Now().Add(-ormpkg.DELETED_CONTENT_RETENTION))
+}
+
+// dueForPurge returns up to limit of the ids deleted before deletedBefore,
+// oldest deletion first.
+func dueForPurge(deletedAt map[uuid.UUID]*time.Time, deletedBefore time.Time, limit int) []uuid.UUID {
+	IDs := []uuid.UUID{}
+	for ID, at := range deletedAt {
+		if at != nil && at.Before(deletedBefore) {
+			IDs = append(IDs, ID)
+		}
+	}
+
+	slices.SortFunc(IDs, func(a uuid.UUID, b uuid.UUID) int {
+		if c := deletedAt[a].Compare(*deletedAt[b]); c != 0 {
+			return c
+		}
+		return compareIDs(a, b)
+	})
+	return limitRows(IDs, limit)
+}
+
+// restorePostReputation credits again the likes of a restored post and its
+// comments that are not deleted themselves.
+func (s *MemoryStore) restorePostReputation(post *ormpkg.Post) {
+	for _, postLike := range s.postLikes {
+		if postLike.PostID == post.ID {
+			s.insertReputationEvent(postLikeEvent(post, postLike))
+		}
+	}
+	for _, comment := range s.comments {
+		if comment.PostID == post.ID && comment.DeletedAt == nil {
+			s.restoreCommentReputation(post, comment)
+		}
+	}
+}
+
+// restoreCommentReputation credits again a restored comment and its likes.
+func (s *MemoryStore) restoreCommentReputation(post *ormpkg.Post, comment *ormpkg.Comment) {
+	s.insertReputationEvent(commentEvent(post, comment))
+	for _, commentLike := range s.commentLikes {
+		if commentLike.CommentID == comment.ID {
+			s.insertReputationEvent(commentLikeEvent(post, comment, commentLike))
+		}
+	}
+}
+
+// PurgeDeletedPosts removes up to limit posts deleted before deletedBefore
+// with their comments, likes and bookmarks.
+func (s *MemoryStore) PurgeDeletedPosts(deletedBefore time.Time, limit int) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	deletedAt := map[uuid.UUID]*time.Time{}
+	for ID, post := range s.posts {
+		deletedAt[ID] = post.DeletedAt
+	}
+
+	postIDs := dueForPurge(deletedAt, deletedBefore, limit)
+	s.purgePosts(func(post *ormpkg.Post) bool { return slices.Contains(postIDs, post.ID) })
+	return len(postIDs), nil
+}
+
+// PurgeDeletedComments removes up to limit comments deleted before
+// deletedBefore with their likes. Comments with replies stay.
+func (s *MemoryStore) PurgeDeletedComments(deletedBefore time.Time, limit int) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	deletedAt := map[uuid.UUID]*time.Time{}
+	for ID, comment := range s.comments {
+		if !s.hasReplies(ID) {
+			deletedAt[ID] = comment.DeletedAt
+		}
+	}
+
+	commentIDs := dueForPurge(deletedAt, deletedBefore, limit)
+	sourceIDs := map[uuid.UUID]bool{}
+	for ID, commentLike := range s.commentLikes {
+		if slices.Contains(commentIDs, commentLike.CommentID) {
+			sourceIDs[ID] = true
+		}
+	}
+	s.revertReputationEvents(sourceIDs)
+
+	for ID := range sourceIDs {
+		delete(s.commentLikes, ID)
+	}
+	for _, ID := range commentIDs {
+		delete(s.comments, ID)
+	}
+	return len(commentIDs), nil
+}
+
+// PurgeDeletedCommunities removes up to limit communities deleted before
+// deletedBefore with their posts and memberships, taking back the
+// reputation these gave. Roles and ledger entries cascade.
+func (s *MemoryStore) PurgeDeletedCommunities(deletedBefore time.Time, limit int) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	deletedAt := map[uuid.UUID]*time.Time{}
+	for ID, community := range s.communities {
+		deletedAt[ID] = community.DeletedAt
+	}
+
+	communityIDs := dueForPurge(deletedAt, deletedBefore, limit)
+	for _, communityID := range communityIDs {
+		s.purgePosts(func(post *ormpkg.Post) bool { return post.CommunityID == communityID })
+		s.communityUsers = slices.DeleteFunc(s.communityUsers, func(communityUser *ormpkg.CommunityUser) bool {
+			return communityUser.CommunityID == communityID
+		})
+
+		for _, event := range s.reputationEvents {
+			if event.UserID == nil || event.CommunityID == nil || *event.CommunityID != communityID {
+				continue
+			}
+			if user, ok := s.users[*event.UserID]; ok {
+				user.Reputation -= event.UserDelta
+			}
+		}
+
+		s.deleteCommunity(communityID)
+	}
+	return len(communityIDs), nil
+}
+
+// purgePosts removes the matching posts with their comments, likes and
+// bookmarks, taking back the reputation these still hold.
+func (s *MemoryStore) purgePosts(match func(post *ormpkg.Post) bool) {
+	sourceIDs := map[uuid.UUID]bool{}
+	for postID, post := range s.posts {
+		if !match(post) {
+			continue
+		}
+
+		for ID, postLike := range s.postLikes {
+			if postLike.PostID == postID {
+				sourceIDs[ID] = true
+			}
+		}
+		for commentID, comment := range s.comments {
+			if comment.PostID != postID {
+				continue
+			}
+
+			sourceIDs[commentID] = true
+			for ID, commentLike := range s.commentLikes {
+				if commentLike.CommentID == commentID {
+					sourceIDs[ID] = true
+				}
+			}
+		}
+	}
+	s.revertReputationEvents(sourceIDs)
+
+	for ID := range sourceIDs {
+		delete(s.postLikes, ID)
+		delete(s.comments, ID)
+		delete(s.commentLikes, ID)
+	}
+	for ID, bookmark := range s.bookmarks {
+		if post, ok := s.posts[bookmark.PostID]; ok && match(post) {
+			delete(s.bookmarks, ID)
+		}
+	}
+	for ID, post := range s.posts {
+		if match(post) {
+			delete(s.posts, ID)
+		}
+	}
+}
@@ -0,0 +1,305 @@
+package ormtest
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+// MemoryStore is an in-memory orm.Store for tests. It follows the schema
+// and queries of PostgresClient: the same unique and foreign key
+// constraints, reported as *pgconn.PgError with the Postgres codes, the same
+// cascades, counters and reputation ledger, and the same ordering and cursor
+// handling of every list.
+//
+// Rows are returned whole, where PostgresClient may leave columns it does
+// not select zero. Timestamps are kept to the microsecond, like Postgres.
+type MemoryStore struct {
+	mutex   sync.Mutex
+	txMutex sync.Mutex // held by WithTx, see memory_transaction.go
+
+	memoryState
+}
+
+// memoryState holds the tables, so a transaction can save and restore them
+// together.
+type memoryState struct {
+	users            map[uuid.UUID]*ormpkg.User
+	slugRedirects    map[string]*ormpkg.UserSlugRedirect
+	sessions         map[uuid.UUID]*ormpkg.Session
+	communities      map[uuid.UUID]*ormpkg.Community
+	communityUsers   []*ormpkg.CommunityUser
+	posts            map[uuid.UUID]*ormpkg.Post
+	comments         map[uuid.UUID]*ormpkg.Comment
+	postLikes        map[uuid.UUID]*ormpkg.PostLike
+	commentLikes     map[uuid.UUID]*ormpkg.CommentLike
+	bookmarks        map[uuid.UUID]*ormpkg.Bookmark
+	followers        map[uuid.UUID]*ormpkg.Follower
+	roles            map[uuid.UUID]*ormpkg.Role
+	userRoles        []ormpkg.UserRole
+	reputationEvents []*ormpkg.ReputationEvent
+	platformOwnerID  *uuid.UUID
+	oneTimeTokens    map[uuid.UUID]*ormpkg.OneTimeToken
+	authThrottles    map[authThrottleKey]*ormpkg.AuthThrottle
+	loginLockouts    map[uuid.UUID]*ormpkg.LoginLockout
+	inviteCodes      map[uuid.UUID]*ormpkg.InviteCode
+}
+
+var _ ormpkg.Store = (*MemoryStore)(nil)
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		memoryState: memoryState{
+			users:         map[uuid.UUID]*ormpkg.User{},
+			slugRedirects: map[string]*ormpkg.UserSlugRedirect{},
+			sessions:      map[uuid.UUID]*ormpkg.Session{},
+			communities:   map[uuid.UUID]*ormpkg.Community{},
+			posts:         map[uuid.UUID]*ormpkg.Post{},
+			comments:      map[uuid.UUID]*ormpkg.Comment{},
+			postLikes:     map[uuid.UUID]*ormpkg.PostLike{},
+			commentLikes:  map[uuid.UUID]*ormpkg.CommentLike{},
+			bookmarks:     map[uuid.UUID]*ormpkg.Bookmark{},
+			followers:     map[uuid.UUID]*ormpkg.Follower{},
+			roles:         map[uuid.UUID]*ormpkg.Role{},
+			oneTimeTokens: map[uuid.UUID]*ormpkg.OneTimeToken{},
+			authThrottles: map[authThrottleKey]*ormpkg.AuthThrottle{},
+			loginLockouts: map[uuid.UUID]*ormpkg.LoginLockout{},
+			inviteCodes:   map[uuid.UUID]*ormpkg.InviteCode{},
+		},
+	}
+}
+
+// parseID converts a string id the way Postgres casts it to uuid.
+func parseID(value string) (uuid.UUID, error) {
+	ID, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, &pgconn.PgError{
+			Severity: "ERROR",
+			Code:     "22P02",
+			Message:  fmt.Sprintf("invalid input syntax for type uuid: %q", value),
+		}
+	}
+	return ID, nil
+}
+
+func uniqueViolation(constraint string) error {
+	return &pgconn.PgError{
+		Severity:       "ERROR",
+		Code:           "23505",
+		Message:        fmt.Sprintf("duplicate key value violates unique constraint %q", constraint),
+		ConstraintName: constraint,
+	}
+}
+
+func foreignKeyViolation(table string, constraint string) error {
+	return &pgconn.PgError{
+		Severity:       "ERROR",
+		Code:           "23503",
+		Message:        fmt.Sprintf("insert or update on table %q violates foreign key constraint %q", table, constraint),
+		TableName:      table,
+		ConstraintName: constraint,
+	}
+}
+
+func notNullViolation(table string, column string) error {
+	return &pgconn.PgError{
+		Severity:   "ERROR",
+		Code:       "23502",
+		Message:    fmt.Sprintf("null value in column %q of relation %q violates not-null constraint", column, table),
+		TableName:  table,
+		ColumnName: column,
+	}
+}
+
+// isColumn reports whether a model field is stored in the model's table,
+// as opposed to an association gorm loads from another one.
+func isColumn(field reflect.StructField) bool {
+	if !field.IsExported() {
+		return false
+	}
+
+	fieldType := field.Type
+	switch fieldType.Kind() {
+	case reflect.Struct:
+		return fieldType == reflect.TypeOf(time.Time{})
+	case reflect.Ptr:
+		elem := fieldType.Elem()
+		return elem.Kind() != reflect.Struct || elem == reflect.TypeOf(time.Time{})
+	case reflect.Slice:
+		return fieldType.Elem().Kind() == reflect.Uint8
+	}
+	return true
+}
+
+// cloneRow copies the columns of a model, so neither the caller nor the
+// store sees later changes of the other. Associations are left zero.
+func cloneRow[T any](row *T) *T {
+	clone := new(T)
+	source := reflect.ValueOf(row).Elem()
+	target := reflect.ValueOf(clone).Elem()
+
+	for i := 0; i < source.NumField(); i++ {
+		if isColumn(source.Type().Field(i)) {
+			target.Field(i).Set(copyValue(source.Field(i)))
+		}
+	}
+
+	return clone
+}
+
+// copyValue copies what a pointer or a byte slice column points to.
+func copyValue(value reflect.Value) reflect.Value {
+	switch {
+	case value.Kind() == reflect.Ptr && !value.IsNil():
+		copied := reflect.New(value.Type().Elem())
+		copied.Elem().Set(value.Elem())
+		return copied
+	case value.Kind() == reflect.Slice && !value.IsNil():
+		copied := reflect.New(value.Type()).Elem()
+		copied.SetBytes(bytes.Clone(value.Bytes()))
+		return copied
+	}
+	return value
+}
+
+// truncateTimes rounds every timestamp column of a model down to the
+// microsecond, the precision Postgres stores.
+func truncateTimes(row interface{}) {
+	value := reflect.ValueOf(row).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		if !isColumn(value.Type().Field(i)) {
+			continue
+		}
+
+		switch field := value.Field(i); field.Interface().(type) {
+		case time.Time:
+			field.Set(reflect.ValueOf(field.Interface().(time.Time).Truncate(time.Microsecond)))
+		case *time.Time:
+			if !field.IsNil() {
+				truncated := field.Elem().Interface().(time.Time).Truncate(time.Microsecond)
+				field.Set(reflect.ValueOf(&truncated))
+			}
+		}
+	}
+}
+
+// setCreated fills CreatedAt and UpdatedAt of a new row if they are zero,
+// like gorm does on create.
+func setCreated(row interface{}) {
+	now := time.Now()
+	value := reflect.ValueOf(row).Elem()
+	for _, name := range []string{"CreatedAt", "UpdatedAt"} {
+		field := value.FieldByName(name)
+		if field.IsValid() && field.IsZero() {
+			field.Set(reflect.ValueOf(now))
+		}
+	}
+}
+
+// updateRow applies gorm's Updates with a struct: every non-zero column
+// except the primary key and the omitted fields is copied from changes to
+// stored, and UpdatedAt is set to now in both.
+func updateRow(stored interface{}, changes interface{}, omit ...string) {
+	target := reflect.ValueOf(stored).Elem()
+	source := reflect.ValueOf(changes).Elem()
+
+	for i := 0; i < source.NumField(); i++ {
+		field := source.Type().Field(i)
+		if !isColumn(field) || field.Name == "ID" || slices.Contains(omit, field.Name) {
+			continue
+		}
+
+		if field.Name == "UpdatedAt" {
+			source.Field(i).Set(reflect.ValueOf(time.Now()))
+		}
+		if !source.Field(i).IsZero() {
+			target.Field(i).Set(copyValue(source.Field(i)))
+		}
+	}
+
+	truncateTimes(stored)
+}
+
+// setUpdated sets UpdatedAt to now, like gorm does on every update.
+func setUpdated(row interface{}) {
+	field := reflect.ValueOf(row).Elem().FieldByName("UpdatedAt")
+	if field.IsValid() {
+		field.Set(reflect.ValueOf(time.Now().Truncate(time.Microsecond)))
+	}
+}
+
+// compareIDs orders ids the way Postgres compares uuids.
+func compareIDs(a uuid.UUID, b uuid.UUID) int {
+	return bytes.Compare(a[:], b[:])
+}
+
+// newestFirst orders by created_at DESC, id DESC.
+func newestFirst(aCreatedAt time.Time, aID uuid.UUID, bCreatedAt time.Time, bID uuid.UUID) int {
+	if c := bCreatedAt.Compare(aCreatedAt); c != 0 {
+		return c
+	}
+	return compareIDs(bID, aID)
+}
+
+// limitRows applies LIMIT, a negative limit means none.
+func limitRows[T any](rows []T, limit int) []T {
+	if limit >= 0 && len(rows) > limit {
+		return rows[:limit]
+	}
+	return rows
+}
+
+// firstByID returns what gorm's First returns among matching rows, the one
+// with the lowest id.
+func firstByID[T any](rows map[uuid.UUID]*T, match func(row *T) bool) (*T, error) {
+	var first *T
+	var firstID uuid.UUID
+	for ID, row := range rows {
+		if !match(row) {
+			continue
+		}
+		if first == nil || compareIDs(ID, firstID) < 0 {
+			first = row
+			firstID = ID
+		}
+	}
+
+	if first == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return first, nil
+}
+
+// selectRows returns copies of the matching rows in the order of compare.
+func selectRows[T any](rows map[uuid.UUID]*T, match func(row *T) bool, compare func(a *T, b *T) int) []*T {
+	selected := []*T{}
+	for _, row := range rows {
+		if match(row) {
+			selected = append(selected, cloneRow(row))
+		}
+	}
+	slices.SortStableFunc(selected, compare)
+	return selected
+}
+
+// pageRows returns copies of a page of the matching rows, see
+// lib.PaginateRows.
+func pageRows[T any](rows map[uuid.UUID]*T, match func(row *T) bool, keys []lib.SortKey, values func(row *T) []interface{}, cursor string, limit int) ([]*T, lib.PageInfo, error) {
+	selected := []*T{}
+	for _, row := range rows {
+		if match(row) {
+			selected = append(selected, cloneRow(row))
+		}
+	}
+	return lib.PaginateRows(selected, keys, values, cursor, limit)
+}
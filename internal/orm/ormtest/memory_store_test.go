@@ -0,0 +1,13 @@
+package ormtest
+
+import (
+	"testing"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+func TestMemoryStoreConformance(t *testing.T) {
+	RunStoreConformance(t, func(t *testing.T) ormpkg.Store {
+		return NewMemoryStore()
+	})
+}
@@ -0,0 +1,107 @@
+package ormtest
+
+import (
+	"context"
+	"slices"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+// WithTx runs fn in a transaction of the store. Transactions run one at a
+// time and a failed one restores the state from before it. Writes made
+// outside WithTx while a transaction runs are undone by its rollback too,
+// so tests should not mix the two concurrently. Unlike PostgresClient, a
+// transaction is never retried.
+func (s *MemoryStore) WithTx(ctx context.Context, fn func(tx ormpkg.Store) error) error {
+	err := ctx.Err()
+	if err != nil {
+		return err
+	}
+
+	s.txMutex.Lock()
+	defer s.txMutex.Unlock()
+
+	return s.runTx(fn)
+}
+
+// memoryTx is the store handed to the function of a transaction. WithTx on
+// it opens a savepoint instead of waiting for the transaction to end.
+type memoryTx struct {
+	*MemoryStore
+}
+
+func (tx memoryTx) WithTx(ctx context.Context, fn func(tx ormpkg.Store) error) error {
+	err := ctx.Err()
+	if err != nil {
+		return err
+	}
+
+	return tx.runTx(fn)
+}
+
+// runTx runs fn and rolls the state back to before it if fn fails.
+func (s *MemoryStore) runTx(fn func(tx ormpkg.Store) error) error {
+	s.mutex.Lock()
+	saved := s.memoryState.clone()
+	s.mutex.Unlock()
+
+	err := fn(memoryTx{s})
+	if err != nil {
+		s.mutex.Lock()
+		s.memoryState = saved
+		s.mutex.Unlock()
+	}
+	return err
+}
+
+// clone copies the state without sharing a row with it. Every table of
+// memoryState must be copied here.
+func (state *memoryState) clone() memoryState {
+	var platformOwnerID = state.platformOwnerID
+	if platformOwnerID != nil {
+		ID := *platformOwnerID
+		platformOwnerID = &ID
+	}
+
+	return memoryState{
+		users:            cloneTable(state.users),
+		slugRedirects:    cloneTable(state.slugRedirects),
+		sessions:         cloneTable(state.sessions),
+		communities:      cloneTable(state.communities),
+		communityUsers:   cloneRowList(state.communityUsers),
+		posts:            cloneTable(state.posts),
+		comments:         cloneTable(state.comments),
+		postLikes:        cloneTable(state.postLikes),
+		commentLikes:     cloneTable(state.commentLikes),
+		bookmarks:        cloneTable(state.bookmarks),
+		followers:        cloneTable(state.followers),
+		roles:            cloneTable(state.roles),
+		userRoles:        slices.Clone(state.userRoles),
+		reputationEvents: cloneRowList(state.reputationEvents),
+		platformOwnerID:  platformOwnerID,
+		oneTimeTokens:    cloneTable(state.oneTimeTokens),
+		authThrottles:    cloneTable(state.authThrottles),
+		loginLockouts:    cloneTable(state.loginLockouts),
+		inviteCodes:      cloneTable(state.inviteCodes),
+	}
+}
+
+func cloneTable[K comparable, T any](rows map[K]*T) map[K]*T {
+	cloned := make(map[K]*T, len(rows))
+	for key, row := range rows {
+		cloned[key] = cloneRow(row)
+	}
+	return cloned
+}
+
+func cloneRowList[T any](rows []*T) []*T {
+	if rows == nil {
+		return nil
+	}
+
+	cloned := make([]*T, len(rows))
+	for i, row := range rows {
+		cloned[i] = cloneRow(row)
+	}
+	return cloned
+}
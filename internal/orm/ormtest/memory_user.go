@@ -0,0 +1,363 @@
+package ormtest
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/stormhead-org/backend/internal/lib"
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+)
+
+func (s *MemoryStore) SelectUserByID(ID string) (*ormpkg.User, error) {
+	userID, err := parseID(ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.selectUser(func(user *ormpkg.User) bool { return user.ID == userID })
+}
+
+func (s *MemoryStore) SelectUserBySlug(slug string) (*ormpkg.User, error) {
+	return s.selectUser(func(user *ormpkg.User) bool { return user.Slug == slug })
+}
+
+func (s *MemoryStore) SelectUserByName(name string) (*ormpkg.User, error) {
+	return s.selectUser(func(user *ormpkg.User) bool { return user.Name == name })
+}
+
+// SelectUserByEmail compares case-insensitively, like PostgresClient.
+func (s *MemoryStore) SelectUserByEmail(email string) (*ormpkg.User, error) {
+	return s.selectUser(func(user *ormpkg.User) bool { return strings.EqualFold(user.Email, email) })
+}
+
+func (s *MemoryStore) selectUser(match func(user *ormpkg.User) bool) (*ormpkg.User, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	user, err := firstByID(s.users, match)
+	if err != nil {
+		return nil, err
+	}
+	return cloneRow(user), nil
+}
+
+func (s *MemoryStore) SelectUserSlugTaken(slugSkeleton string, exceptUserID string) (bool, error) {
+	var exceptID uuid.UUID
+	if exceptUserID != "" {
+		var err error
+		exceptID, err = parseID(exceptUserID)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.userSlugTaken(slugSkeleton, exceptID), nil
+}
+
+// userSlugTaken reports whether the skeleton is used by, or redirects to, a
+// user other than exceptID, which is the zero uuid to except nobody.
+func (s *MemoryStore) userSlugTaken(slugSkeleton string, exceptID uuid.UUID) bool {
+	for _, user := range s.users {
+		if user.SlugSkeleton == slugSkeleton && user.ID != exceptID {
+			return true
+		}
+	}
+	for _, redirect := range s.slugRedirects {
+		if redirect.SlugSkeleton == slugSkeleton && redirect.UserID != exceptID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *MemoryStore) SelectUserSlugRedirect(slug string) (*ormpkg.UserSlugRedirect, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	redirect, ok := s.slugRedirects[slug]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return cloneRow(redirect), nil
+}
+
+func (s *MemoryStore) SelectPendingUsersWithPagination(limit int, cursor string) ([]*ormpkg.User, lib.PageInfo, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return pageRows(s.users, func(user *ormpkg.User) bool {
+		return !user.IsApproved
+	}, ormpkg.OldestFirst, func(user *ormpkg.User) []interface{} {
+		return []interface{}{user.CreatedAt, user.ID}
+	}, cursor, limit)
+}
+
+func (s *MemoryStore) CountUsers() (int64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return int64(len(s.users)), nil
+}
+
+// InsertUser creates the user, see PostgresClient.InsertUser.
+func (s *MemoryStore) InsertUser(user *ormpkg.User) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.insertUser(user)
+}
+
+func (s *MemoryStore) insertUser(user *ormpkg.User) error {
+	if s.userSlugTaken(user.SlugSkeleton, uuid.Nil) {
+		return ormpkg.ErrUserSlugTaken
+	}
+
+	user.BeforeCreate(nil)
+	setCreated(user)
+
+	stored := cloneRow(user)
+	truncateTimes(stored)
+
+	err := s.checkUserUnique(stored)
+	if err != nil {
+		return err
+	}
+
+	s.users[stored.ID] = stored
+	return nil
+}
+
+// UpdateUser saves the non-zero fields of user, never the reputation.
+func (s *MemoryStore) UpdateUser(user *ormpkg.User) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.users[user.ID]
+	if !ok {
+		return nil
+	}
+
+	updated := cloneRow(stored)
+	updateRow(updated, user, "Reputation")
+
+	err := s.checkUserUnique(updated)
+	if err != nil {
+		return err
+	}
+
+	s.users[updated.ID] = updated
+	return nil
+}
+
+func (s *MemoryStore) checkUserUnique(user *ormpkg.User) error {
+	for _, other := range s.users {
+		if other.ID == user.ID {
+			continue
+		}
+		if other.Slug == user.Slug {
+			return uniqueViolation("user_slug_key")
+		}
+		if other.Email == user.Email {
+			return uniqueViolation("user_email_key")
+		}
+	}
+	return nil
+}
+
+// UpdateUserSlug changes the slug and keeps the old one as a redirect, see
+// PostgresClient.UpdateUserSlug.
+func (s *MemoryStore) UpdateUserSlug(user *ormpkg.User, slug string, slugSkeleton string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.users[user.ID]
+	if !ok {
+		return foreignKeyViolation("user_slug_redirect", "user_slug_redirect_user_id_fkey")
+	}
+
+	if s.userSlugTaken(slugSkeleton, user.ID) {
+		return ormpkg.ErrUserSlugTaken
+	}
+
+	// The user's own redirect of the new slug is dropped before the old
+	// slug becomes a redirect
+	ownRedirect := false
+	if redirect, ok := s.slugRedirects[slug]; ok && redirect.UserID == user.ID {
+		ownRedirect = true
+	}
+	if _, ok := s.slugRedirects[user.Slug]; ok && !(ownRedirect && user.Slug == slug) {
+		return uniqueViolation("user_slug_redirect_pkey")
+	}
+
+	updated := cloneRow(stored)
+	updated.Slug = slug
+	updated.SlugSkeleton = slugSkeleton
+	now := time.Now().Truncate(time.Microsecond)
+	updated.SlugChangedAt = &now
+	updated.UpdatedAt = now
+
+	err := s.checkUserUnique(updated)
+	if err != nil {
+		return err
+	}
+
+	if ownRedirect {
+		delete(s.slugRedirects, slug)
+	}
+	s.slugRedirects[user.Slug] = &ormpkg.UserSlugRedirect{
+		Slug:         user.Slug,
+		SlugSkeleton: user.SlugSkeleton,
+		UserID:       user.ID,
+		CreatedAt:    now,
+	}
+	s.users[updated.ID] = updated
+	return nil
+}
+
+func (s *MemoryStore) UpdateUserPassword(userID string, password string) error {
+	return s.updateUser(userID, func(user *ormpkg.User) bool {
+		user.Password = password
+		user.Salt = ""
+		return true
+	})
+}
+
+func (s *MemoryStore) UpdateUserVerified(userID string) error {
+	return s.updateUser(userID, func(user *ormpkg.User) bool {
+		user.IsVerified = true
+		return true
+	})
+}
+
+func (s *MemoryStore) ScheduleUserDeletion(userID string, scheduledAt time.Time) error {
+	return s.updateUser(userID, func(user *ormpkg.User) bool {
+		requestedAt := time.Now()
+		user.DeletionRequestedAt = &requestedAt
+		user.DeletionScheduledAt = &scheduledAt
+		return true
+	})
+}
+
+func (s *MemoryStore) CancelUserDeletion(userID string) error {
+	return s.updateUser(userID, func(user *ormpkg.User) bool {
+		user.DeletionRequestedAt = nil
+		user.DeletionScheduledAt = nil
+		return true
+	})
+}
+
+func (s *MemoryStore) ApproveUser(userID string) error {
+	approved := false
+	err := s.updateUser(userID, func(user *ormpkg.User) bool {
+		if user.IsApproved {
+			return false
+		}
+
+		user.IsApproved = true
+		approved = true
+		return true
+	})
+	if err == nil && !approved {
+		return gorm.ErrRecordNotFound
+	}
+	return err
+}
+
+// updateUser applies change to the user if it exists and change returns
+// true, like an UPDATE ... WHERE id = ? that may match nothing.
+func (s *MemoryStore) updateUser(userID string, change func(user *ormpkg.User) bool) error {
+	ID, err := parseID(userID)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.users[ID]
+	if !ok {
+		return nil
+	}
+
+	updated := cloneRow(stored)
+	if !change(updated) {
+		return nil
+	}
+	setUpdated(updated)
+	truncateTimes(updated)
+
+	s.users[ID] = updated
+	return nil
+}
+
+// DeletePendingUser deletes a user not approved yet, with what references
+// them on delete cascade.
+func (s *MemoryStore) DeletePendingUser(userID string) error {
+	ID, err := parseID(userID)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	user, ok := s.users[ID]
+	if !ok || user.IsApproved {
+		return gorm.ErrRecordNotFound
+	}
+
+	delete(s.users, ID)
+	for slug, redirect := range s.slugRedirects {
+		if redirect.UserID == ID {
+			delete(s.slugRedirects, slug)
+		}
+	}
+	s.userRoles = slices.DeleteFunc(s.userRoles, func(userRole ormpkg.UserRole) bool {
+		return userRole.UserID == ID
+	})
+	s.deleteReputationEvents(func(event *ormpkg.ReputationEvent) bool {
+		return event.UserID != nil && *event.UserID == ID
+	})
+	for _, event := range s.reputationEvents {
+		if event.ActorID != nil && *event.ActorID == ID {
+			event.ActorID = nil
+		}
+	}
+	for _, post := range s.posts {
+		if post.DeletedBy != nil && *post.DeletedBy == ID {
+			post.DeletedBy = nil
+		}
+	}
+	for _, comment := range s.comments {
+		if comment.DeletedBy != nil && *comment.DeletedBy == ID {
+			comment.DeletedBy = nil
+		}
+	}
+	for _, community := range s.communities {
+		if community.DeletedBy != nil && *community.DeletedBy == ID {
+			community.DeletedBy = nil
+		}
+	}
+	if s.platformOwnerID != nil && *s.platformOwnerID == ID {
+		s.platformOwnerID = nil
+	}
+	for tokenID, token := range s.oneTimeTokens {
+		if token.UserID == ID {
+			delete(s.oneTimeTokens, tokenID)
+		}
+	}
+	for _, code := range s.inviteCodes {
+		if code.CreatedBy != nil && *code.CreatedBy == ID {
+			code.CreatedBy = nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package orm
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/stormhead-org/backend/internal/lib"
+)
+
+// NewestFirst and OldestFirst are the sort specs of most lists, see
+// lib.Paginate. The in-memory store of ormtest pages by them as well.
+var NewestFirst = []lib.SortKey{
+	{Column: "created_at", Descending: true},
+	{Column: "id", Descending: true},
+}
+
+var OldestFirst = []lib.SortKey{
+	{Column: "created_at"},
+	{Column: "id"},
+}
+
+// MostLikedFirst orders posts by likes, newest first among equals.
+var MostLikedFirst = []lib.SortKey{
+	{Column: "like_count", Descending: true},
+	{Column: "created_at", Descending: true},
+	{Column: "id", Descending: true},
+}
+
+// createdAtAndID returns the sort-key values of NewestFirst and OldestFirst.
+func createdAtAndID(createdAt time.Time, ID uuid.UUID) []interface{} {
+	return []interface{}{createdAt, ID}
+}
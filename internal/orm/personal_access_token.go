@@ -0,0 +1,119 @@
+package orm
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PersonalAccessToken struct {
+	ID          uuid.UUID `gorm:"primaryKey"`
+	UserID      uuid.UUID
+	Name        string
+	TokenHash   string
+	TokenPrefix string
+	Scopes      json.RawMessage `gorm:"type:jsonb;not null;default:'[]'"`
+	CommunityID *uuid.UUID
+	ExpiresAt   *time.Time
+	LastUsedAt  *time.Time
+	RevokedAt   *time.Time
+	CreatedAt   time.Time
+}
+
+func (c *PersonalAccessToken) TableName() string {
+	return "personal_access_token"
+}
+
+func (c *PersonalAccessToken) BeforeCreate(transaction *gorm.DB) error {
+	c.ID = uuid.New()
+	return nil
+}
+
+// ScopeList decodes the granted scopes.
+func (c *PersonalAccessToken) ScopeList() []string {
+	var scopes []string
+	_ = json.Unmarshal(c.Scopes, &scopes)
+	return scopes
+}
+
+func (c *PersonalAccessToken) HasScope(scope string) bool {
+	for _, s := range c.ScopeList() {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// IsActive reports whether the token is neither revoked nor expired.
+func (c *PersonalAccessToken) IsActive(now time.Time) bool {
+	if c.RevokedAt != nil {
+		return false
+	}
+	return c.ExpiresAt == nil || now.Before(*c.ExpiresAt)
+}
+
+func (c *PostgresClient) SelectPersonalAccessTokenByHash(tokenHash string) (*PersonalAccessToken, error) {
+	var token PersonalAccessToken
+	tx := c.database.
+		Where("token_hash = ?", tokenHash).
+		First(&token)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &token, nil
+}
+
+func (c *PostgresClient) SelectPersonalAccessTokenByID(ID string) (*PersonalAccessToken, error) {
+	var token PersonalAccessToken
+	tx := c.database.
+		Where("id = ?", ID).
+		First(&token)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &token, nil
+}
+
+// SelectPersonalAccessTokensByUserID returns the user's tokens that have
+// not been revoked, newest first.
+func (c *PostgresClient) SelectPersonalAccessTokensByUserID(userID string) ([]*PersonalAccessToken, error) {
+	var tokens []*PersonalAccessToken
+	tx := c.database.
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Order("created_at DESC").
+		Find(&tokens)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return tokens, nil
+}
+
+func (c *PostgresClient) InsertPersonalAccessToken(token *PersonalAccessToken) error {
+	tx := c.database.Create(token)
+	return tx.Error
+}
+
+func (c *PostgresClient) UpdatePersonalAccessTokenLastUsed(ID string, lastUsedAt time.Time) error {
+	tx := c.database.
+		Model(&PersonalAccessToken{}).
+		Where("id = ?", ID).
+		Update("last_used_at", lastUsedAt)
+	return tx.Error
+}
+
+func (c *PostgresClient) RevokePersonalAccessToken(ID string) error {
+	tx := c.database.
+		Model(&PersonalAccessToken{}).
+		Where("id = ? AND revoked_at IS NULL", ID).
+		Update("revoked_at", time.Now())
+	return tx.Error
+}
@@ -2,11 +2,53 @@ package orm
 
 import "github.com/google/uuid"
 
+// What happens to posts and comments of deleted accounts
+const DELETED_USER_CONTENT_REASSIGN = "reassign"
+const DELETED_USER_CONTENT_DELETE = "delete"
+
+// Who may create an account, see AuthorizationServer.Register
+const REGISTRATION_MODE_OPEN = "open"
+const REGISTRATION_MODE_INVITE_ONLY = "invite_only"
+const REGISTRATION_MODE_APPROVAL = "approval"
+const REGISTRATION_MODE_CLOSED = "closed"
+
 type PlatformSetting struct {
-	ID              int        `gorm:"primaryKey"`
-	PlatformOwnerID *uuid.UUID `gorm:"type:uuid"`
+	ID                 int        `gorm:"primaryKey"`
+	PlatformOwnerID    *uuid.UUID `gorm:"type:uuid"`
+	DeletedUserContent string
+	TombstoneUserID    *uuid.UUID `gorm:"type:uuid"`
+	RegistrationMode   string
 }
 
 func (PlatformSetting) TableName() string {
 	return "platform_settings"
 }
+
+func (c *PostgresClient) SelectPlatformSetting() (*PlatformSetting, error) {
+	var settings PlatformSetting
+	tx := c.database.
+		Where("id = ?", 1).
+		First(&settings)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &settings, nil
+}
+
+func (c *PostgresClient) UpdatePlatformRegistrationMode(mode string) error {
+	tx := c.database.
+		Model(&PlatformSetting{}).
+		Where("id = ?", 1).
+		Update("registration_mode", mode)
+	return tx.Error
+}
+
+func IsValidRegistrationMode(mode string) bool {
+	switch mode {
+	case REGISTRATION_MODE_OPEN, REGISTRATION_MODE_INVITE_ONLY, REGISTRATION_MODE_APPROVAL, REGISTRATION_MODE_CLOSED:
+		return true
+	}
+	return false
+}
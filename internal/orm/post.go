@@ -2,6 +2,7 @@ package orm
 
 import (
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,19 +17,45 @@ const (
 	PostStatusPublished
 )
 
+// Orders of post lists, see PostSort.
+const POST_SORT_NEWEST = "newest"
+const POST_SORT_TOP = "top"
+
+var ErrUnknownPostSort = errors.New("unknown post sort")
+
+// PostSort returns the sort spec of a post order and the sort-key values of
+// a post for it. ok is false for an unknown order.
+func PostSort(sort string) (keys []lib.SortKey, values func(post *Post) []interface{}, ok bool) {
+	switch sort {
+	case POST_SORT_NEWEST:
+		return NewestFirst, func(post *Post) []interface{} {
+			return createdAtAndID(post.CreatedAt, post.ID)
+		}, true
+	case POST_SORT_TOP:
+		return MostLikedFirst, func(post *Post) []interface{} {
+			return []interface{}{post.LikeCount, post.CreatedAt, post.ID}
+		}, true
+	}
+	return nil, nil, false
+}
+
 type Post struct {
-	ID          uuid.UUID `gorm:"primaryKey"`
-	CommunityID uuid.UUID
-	Community   Community `gorm:"foreignKey:CommunityID"`
-	AuthorID    uuid.UUID
-	Author      User `gorm:"foreignKey:AuthorID"`
-	Title       string
-	Content     json.RawMessage `gorm:"type:jsonb"`
-	Status      int
-	LikeCount   int
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	PublishedAt time.Time
+	ID             uuid.UUID `gorm:"primaryKey"`
+	CommunityID    uuid.UUID
+	Community      Community `gorm:"foreignKey:CommunityID"`
+	AuthorID       uuid.UUID
+	Author         User `gorm:"foreignKey:AuthorID"`
+	Title          string
+	Content        json.RawMessage `gorm:"type:jsonb"`
+	Status         int
+	LikeCount      int
+	CommentCount   int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	PublishedAt    time.Time
+	DeletedAt      *time.Time
+	DeletedBy      *uuid.UUID
+	DeletionReason string
 }
 
 func (c *Post) TableName() string {
@@ -40,14 +67,6 @@ func (c *Post) BeforeCreate(transaction *gorm.DB) error {
 	return nil
 }
 
-func (p Post) GetID() uuid.UUID {
-	return p.ID
-}
-
-func (p Post) GetCreatedAt() time.Time {
-	return p.CreatedAt
-}
-
 func (c *PostgresClient) SelectPostByID(id string) (*Post, error) {
 	var post Post
 	tx := c.database.
@@ -65,6 +84,7 @@ func (c *PostgresClient) SelectPostByID(id string) (*Post, error) {
 			"published_at",
 		}).
 		Where("id = ?", id).
+		Where(visiblePost).
 		Preload("Community").
 		Preload("Author").
 		First(&post)
@@ -76,8 +96,14 @@ func (c *PostgresClient) SelectPostByID(id string) (*Post, error) {
 	return &post, nil
 }
 
-func (c *PostgresClient) SelectPostsWithPagination(author_id string, limit int, cursor string) ([]*Post, error) {
-	var posts []*Post
+// SelectPostsWithPagination lists posts in the order of sort, one of the
+// POST_SORT constants. Returns ErrUnknownPostSort for another one.
+func (c *PostgresClient) SelectPostsWithPagination(author_id string, sort string, limit int, cursor string) ([]*Post, lib.PageInfo, error) {
+	keys, values, ok := PostSort(sort)
+	if !ok {
+		return nil, lib.PageInfo{}, ErrUnknownPostSort
+	}
+
 	query := c.database.
 		Select([]string{
 			"id",
@@ -92,38 +118,122 @@ func (c *PostgresClient) SelectPostsWithPagination(author_id string, limit int,
 			"updated_at",
 			"published_at",
 		}).
+		Where(visiblePost).
 		Preload("Community").
-		Preload("Author").
-		Order("created_at DESC, id DESC")
+		Preload("Author")
 
 	if author_id != "" {
 		query = query.Where("author_id = ?", author_id)
 	}
 
-	paginatedQuery, err := lib.Paginate[Post](c.database, query, cursor, limit)
-	if err != nil {
-		return nil, err
-	}
+	return lib.Paginate(query, keys, values, cursor, limit)
+}
+
+// InsertPost stores a post and increments the community post counter.
+func (c *PostgresClient) InsertPost(post *Post) error {
+	return c.database.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(post).Error
+		if err != nil {
+			return err
+		}
+
+		return updateCounter(tx, &Community{}, post.CommunityID, "post_count", 1)
+	})
+}
+
+// UpdatePost saves the post. The like and comment counters are only ever
+// changed by the like and comment functions.
+func (c *PostgresClient) UpdatePost(post *Post) error {
+	tx := c.database.Model(post).Omit("Community", "Author", "LikeCount", "CommentCount").Updates(post)
+	return tx.Error
+}
+
+// DeletePost marks a post deleted, decrements the community post counter
+// and takes back the reputation its likes and comments gave. The post is
+// kept for RestorePost until PurgeDeletedPosts removes it.
+func (c *PostgresClient) DeletePost(post *Post, deletedBy uuid.UUID, reason string) error {
+	return c.database.Transaction(func(tx *gorm.DB) error {
+		deleted, err := softDelete(tx, &Post{}, post.ID, deletedBy, reason)
+		if err != nil || !deleted {
+			return err
+		}
+
+		err = updateCounter(tx, &Community{}, post.CommunityID, "post_count", -1)
+		if err != nil {
+			return err
+		}
+
+		comments := tx.Model(&Comment{}).Select("id").Where("post_id = ?", post.ID)
+		sources := []*gorm.DB{
+			tx.Model(&PostLike{}).Select("id").Where("post_id = ?", post.ID),
+			comments,
+			tx.Model(&CommentLike{}).Select("id").Where("comment_id IN (?)", comments),
+		}
+		for _, sourceIDs := range sources {
+			err = revertReputationEvents(tx, sourceIDs)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// SelectDeletedPostByID finds a deleted post that has not been purged yet.
+func (c *PostgresClient) SelectDeletedPostByID(id string) (*Post, error) {
+	var post Post
+	tx := c.database.
+		Select([]string{
+			"id",
+			"community_id",
+			"author_id",
+			"title",
+			"deleted_at",
+			"deleted_by",
+			"deletion_reason",
+		}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		First(&post)
 
-	tx := paginatedQuery.Find(&posts)
 	if tx.Error != nil {
 		return nil, tx.Error
 	}
 
-	return posts, nil
+	return &post, nil
 }
 
-func (c *PostgresClient) InsertPost(post *Post) error {
-	transaction := c.database.Create(post)
-	return transaction.Error
+// RestorePost undoes DeletePost within the retention window: the post
+// counter and the reputation of its likes and remaining comments come back.
+// Returns gorm.ErrRecordNotFound if the post cannot be restored.
+func (c *PostgresClient) RestorePost(post *Post) error {
+	return c.database.Transaction(func(tx *gorm.DB) error {
+		err := restoreDeleted(tx, &Post{}, post.ID)
+		if err != nil {
+			return err
+		}
+
+		err = updateCounter(tx, &Community{}, post.CommunityID, "post_count", 1)
+		if err != nil {
+			return err
+		}
+
+		return restorePostReputation(tx, post.ID)
+	})
 }
 
-func (c *PostgresClient) UpdatePost(post *Post) error {
-	tx := c.database.Model(post).Omit("Community").Omit("Author").Updates(post)
-	return tx.Error
-}
+// SelectPostsByAuthorID returns every post of the author, including deleted
+// ones that have not been purged yet.
+func (c *PostgresClient) SelectPostsByAuthorID(authorID string) ([]*Post, error) {
+	var posts []*Post
+	tx := c.database.
+		Where("author_id = ?", authorID).
+		Order("created_at").
+		Find(&posts)
 
-func (c *PostgresClient) DeletePost(post *Post) error {
-	tx := c.database.Delete(post)
-	return tx.Error
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return posts, nil
 }
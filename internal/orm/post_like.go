@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PostLike struct {
@@ -42,12 +43,64 @@ func (c *PostgresClient) SelectPostLikeByID(postID string, userID string) (*Post
 	return &PostLike, nil
 }
 
-func (c *PostgresClient) InsertPostLike(postLike *PostLike) error {
-	tx := c.database.Create(postLike)
-	return tx.Error
+// InsertPostLike stores a like, increments the post like counter
+// and credits the author's reputation.
+// Returns false, and changes nothing, if the user already liked the post.
+func (c *PostgresClient) InsertPostLike(postLike *PostLike) (bool, error) {
+	inserted := false
+	err := c.database.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(postLike)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		inserted = true
+		err := updateCounter(tx, &Post{}, postLike.PostID, "like_count", 1)
+		if err != nil {
+			return err
+		}
+
+		return insertPostLikeReputation(tx, postLike)
+	})
+	return inserted, err
+}
+
+// DeletePostLike removes a like, decrements the post like counter
+// and takes back the reputation it gave.
+// Returns false if the user has not liked the post.
+func (c *PostgresClient) DeletePostLike(postID uuid.UUID, userID uuid.UUID) (bool, error) {
+	deleted := false
+	err := c.database.Transaction(func(tx *gorm.DB) error {
+		var postLike PostLike
+		result := tx.
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+			Where("post_id = ? AND user_id = ?", postID, userID).
+			Delete(&postLike)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		deleted = true
+		err := updateCounter(tx, &Post{}, postID, "like_count", -1)
+		if err != nil {
+			return err
+		}
+
+		return revertReputationEvents(tx, []uuid.UUID{postLike.ID})
+	})
+	return deleted, err
 }
 
-func (c *PostgresClient) DeletePostLike(postLike *PostLike) error {
-	tx := c.database.Delete(postLike)
-	return tx.Error
+func (c *PostgresClient) SelectPostLikesByUserID(userID string) ([]*PostLike, error) {
+	var likes []*PostLike
+	tx := c.database.
+		Where("user_id = ?", userID).
+		Order("created_at").
+		Find(&likes)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return likes, nil
 }
@@ -1,47 +1,165 @@
 package orm
 
 import (
+	"database/sql"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PostgresClient struct {
-	database *gorm.DB
+	database      *gorm.DB
+	inTransaction bool        // set on clients handed out by WithTx
+	replicas      *replicaSet // nil without read replicas
 }
 
-func NewPostgresClient(host string, port string, user string, password string) (*PostgresClient, error) {
-	database, err := gorm.Open(
-		postgres.Open(
-			fmt.Sprintf(
-				"host=%s port=%s user=%s password=%s sslmode=disable",
-				host,
-				port,
-				user,
-				password,
-			),
-		),
-		&gorm.Config{},
+// PostgresConfig configures the connection pools of a client. Zero
+// durations and limits mean none, see DefaultPostgresConfig for the values
+// the constructors use.
+type PostgresConfig struct {
+	DSN              string
+	ReplicaDSNs      []string      // read replicas, see Replica
+	MaxOpenConns     int           // per pool, the primary and every replica
+	MaxIdleConns     int           // per pool
+	ConnMaxLifetime  time.Duration // connections are closed after it, e.g. to follow a failover
+	ConnMaxIdleTime  time.Duration
+	StatementTimeout time.Duration // statement_timeout of every connection
+	MaxReplicaLag    time.Duration // replicas lagging more are skipped, zero skips none
+}
+
+// DefaultPostgresConfig returns the pool settings for a database without
+// replicas.
+func DefaultPostgresConfig(dsn string) PostgresConfig {
+	return PostgresConfig{
+		DSN:             dsn,
+		MaxOpenConns:    10,
+		MaxIdleConns:    5,
+		ConnMaxLifetime: 30 * time.Minute,
+		ConnMaxIdleTime: 5 * time.Minute,
+		MaxReplicaLag:   10 * time.Second,
+	}
+}
+
+// PostgresDSN builds the libpq connection string NewPostgresClient connects
+// with.
+func PostgresDSN(host string, port string, user string, password string) string {
+	return fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s sslmode=disable",
+		host,
+		port,
+		user,
+		password,
 	)
+}
+
+func NewPostgresClient(host string, port string, user string, password string) (*PostgresClient, error) {
+	return NewPostgresClientWithDSN(PostgresDSN(host, port, user, password))
+}
+
+// NewPostgresClientWithDSN connects with a libpq connection string or URL,
+// e.g. one naming a database other than the user's.
+func NewPostgresClientWithDSN(dsn string) (*PostgresClient, error) {
+	return NewPostgresClientWithConfig(DefaultPostgresConfig(dsn))
+}
+
+// NewPostgresClientWithConfig connects to the primary and the replicas of
+// config. Every method runs on the primary, Replica returns a client that
+// reads from the replicas.
+func NewPostgresClientWithConfig(config PostgresConfig) (*PostgresClient, error) {
+	pool, err := openPool(config.DSN, config)
+	if err != nil {
+		return nil, err
+	}
+
+	database, err := openDatabase(pool, nil)
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	client := &PostgresClient{
+		database: database,
+	}
+	if len(config.ReplicaDSNs) == 0 {
+		return client, nil
+	}
+
+	client.replicas, err = newReplicaSet(pool, config)
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// openPool opens a connection pool with the settings of config.
+func openPool(dsn string, config PostgresConfig) (*sql.DB, error) {
+	connConfig, err := pgx.ParseConfig(dsn)
 	if err != nil {
 		return nil, err
 	}
 
-	rawDatabase, err := database.DB()
+	if config.StatementTimeout > 0 {
+		connConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(config.StatementTimeout.Milliseconds(), 10)
+	}
+
+	pool := stdlib.OpenDB(*connConfig)
+	pool.SetMaxOpenConns(config.MaxOpenConns)
+	pool.SetMaxIdleConns(config.MaxIdleConns)
+	pool.SetConnMaxLifetime(config.ConnMaxLifetime)
+	pool.SetConnMaxIdleTime(config.ConnMaxIdleTime)
+	return pool, nil
+}
+
+// openDatabase opens gorm on a pool and times its statements, see
+// registerQueryMetrics. replicas is nil for the primary.
+func openDatabase(pool *sql.DB, replicas *replicaSet) (*gorm.DB, error) {
+	database, err := gorm.Open(
+		postgres.New(postgres.Config{Conn: pool}),
+		&gorm.Config{},
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	rawDatabase.SetMaxOpenConns(1)
-	rawDatabase.SetMaxIdleConns(1)
-	rawDatabase.SetConnMaxIdleTime(5 * time.Second)
+	return database, registerQueryMetrics(database, replicas)
+}
+
+// Replica returns a client whose reads go to a replica lagging less than
+// MaxReplicaLag, or to the primary if there is none. Its writes still go to
+// the primary. Reads from it may miss recent writes, so it is meant for
+// lists and feeds of requests that don't write; a request reading what it
+// wrote uses the client itself. Without replicas and inside a transaction it
+// returns the client itself.
+func (c *PostgresClient) Replica() *PostgresClient {
+	if c.replicas == nil || c.inTransaction {
+		return c
+	}
 
 	return &PostgresClient{
-		database: database,
-	}, nil
+		database: c.replicas.database,
+	}
+}
+
+// Close stops the replica lag checks and closes every pool.
+func (c *PostgresClient) Close() error {
+	if c.replicas != nil {
+		c.replicas.close()
+	}
+
+	pool, err := c.database.DB()
+	if err != nil {
+		return err
+	}
+	return pool.Close()
 }
 
 func (c *PostgresClient) CountUsers() (int64, error) {
@@ -74,9 +192,32 @@ func (c *PostgresClient) InsertUserRole(userRole *UserRole) error {
 	return c.database.Create(userRole).Error
 }
 
-func (c *PostgresClient) DeleteSessionsByUserID(userID string) error {
-	tx := c.database.Where("user_id = ?", userID).Delete(&Session{})
-	return tx.Error
+// DeleteSessionsByUserID deletes every session of the user and returns the
+// deleted session ids, for revoking them in the session cache.
+func (c *PostgresClient) DeleteSessionsByUserID(userID string) ([]string, error) {
+	return c.deleteSessions(c.database.Where("user_id = ?", userID))
+}
+
+// DeleteOtherSessionsByUserID deletes every session of the user except the
+// given one and returns the deleted session ids.
+func (c *PostgresClient) DeleteOtherSessionsByUserID(userID string, exceptSessionID string) ([]string, error) {
+	return c.deleteSessions(c.database.Where("user_id = ? AND id <> ?", userID, exceptSessionID))
+}
+
+func (c *PostgresClient) deleteSessions(query *gorm.DB) ([]string, error) {
+	var sessions []Session
+	tx := query.
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Delete(&sessions)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	sessionIDs := make([]string, len(sessions))
+	for i, session := range sessions {
+		sessionIDs[i] = session.ID.String()
+	}
+	return sessionIDs, nil
 }
 
 func (c *PostgresClient) UpdatePlatformOwner(userID uuid.UUID) error {
@@ -0,0 +1,51 @@
+package orm
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	metricpkg "github.com/stormhead-org/backend/internal/metric"
+)
+
+const queryStartKey = "metric:query_start"
+
+// registerQueryMetrics times every statement of database by operation,
+// table and the pool it ran on, see metric.ObserveQuery.
+func registerQueryMetrics(database *gorm.DB, replicas *replicaSet) error {
+	start := func(db *gorm.DB) {
+		db.InstanceSet(queryStartKey, time.Now())
+	}
+	observe := func(operation string) func(db *gorm.DB) {
+		return func(db *gorm.DB) {
+			startedAt, ok := db.InstanceGet(queryStartKey)
+			if !ok {
+				return
+			}
+			metricpkg.ObserveQuery(operation, db.Statement.Table, replicas.target(db.Statement.ConnPool), time.Since(startedAt.(time.Time)))
+		}
+	}
+
+	callbacks := database.Callback()
+	steps := []error{
+		callbacks.Create().Before("*").Register("metric:create_start", start),
+		callbacks.Create().After("*").Register("metric:create_observe", observe("create")),
+		callbacks.Query().Before("*").Register("metric:query_start", start),
+		callbacks.Query().After("*").Register("metric:query_observe", observe("query")),
+		callbacks.Update().Before("*").Register("metric:update_start", start),
+		callbacks.Update().After("*").Register("metric:update_observe", observe("update")),
+		callbacks.Delete().Before("*").Register("metric:delete_start", start),
+		callbacks.Delete().After("*").Register("metric:delete_observe", observe("delete")),
+		callbacks.Row().Before("*").Register("metric:row_start", start),
+		callbacks.Row().After("*").Register("metric:row_observe", observe("row")),
+		callbacks.Raw().Before("*").Register("metric:raw_start", start),
+		callbacks.Raw().After("*").Register("metric:raw_observe", observe("raw")),
+	}
+	for _, err := range steps {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+
+	metricpkg "github.com/stormhead-org/backend/internal/metric"
+)
+
+// REPLICA_LAG_CHECK_INTERVAL is how often the lag of every replica is
+// measured, a replica that can't be reached counts as lagging until the
+// next check that reaches it.
+const REPLICA_LAG_CHECK_INTERVAL = 5 * time.Second
+const REPLICA_LAG_CHECK_TIMEOUT = 2 * time.Second
+
+// replicaLagQuery is how far a replica is behind the primary. A replica
+// that replayed everything it received is not behind, however old its last
+// transaction is.
+const replicaLagQuery = `SELECT CASE
+	WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE coalesce(extract(epoch FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+// replicaSet routes the reads of Replica clients. It is the dbresolver
+// policy: reads go round robin to the replicas within MaxReplicaLag, or to
+// the primary if none is. Writes go to the primary.
+type replicaSet struct {
+	database *gorm.DB
+	primary  *sql.DB
+	replicas []*replica
+	maxLag   time.Duration
+	next     atomic.Uint64
+
+	stop chan struct{}
+	done sync.WaitGroup
+}
+
+type replica struct {
+	name string // host:port, for metrics
+	pool *sql.DB
+	lag  atomic.Int64 // nanoseconds, negative if the last check failed
+}
+
+// newReplicaSet connects to the replicas of config, measures their lag
+// once and keeps measuring it until close.
+func newReplicaSet(primary *sql.DB, config PostgresConfig) (*replicaSet, error) {
+	set := &replicaSet{
+		primary: primary,
+		maxLag:  config.MaxReplicaLag,
+		stop:    make(chan struct{}),
+	}
+
+	// The primary is a replica too, so that dbresolver always asks the
+	// policy, which falls back to it
+	dialectors := []gorm.Dialector{}
+	for _, dsn := range config.ReplicaDSNs {
+		connConfig, err := pgx.ParseConfig(dsn)
+		if err != nil {
+			set.closePools()
+			return nil, err
+		}
+
+		pool, err := openPool(dsn, config)
+		if err != nil {
+			set.closePools()
+			return nil, err
+		}
+
+		set.replicas = append(set.replicas, &replica{
+			name: fmt.Sprintf("%s:%d", connConfig.Host, connConfig.Port),
+			pool: pool,
+		})
+		dialectors = append(dialectors, postgres.New(postgres.Config{Conn: pool}))
+	}
+	dialectors = append(dialectors, postgres.New(postgres.Config{Conn: primary}))
+
+	database, err := openDatabase(primary, set)
+	if err == nil {
+		err = database.Use(dbresolver.Register(dbresolver.Config{
+			Replicas: dialectors,
+			Policy:   set,
+		}))
+	}
+	if err != nil {
+		set.closePools()
+		return nil, err
+	}
+	set.database = database
+
+	set.checkLag()
+	set.done.Add(1)
+	go set.monitorLag()
+
+	return set, nil
+}
+
+// Resolve implements dbresolver.Policy. pools are the replicas followed by
+// the primary, in the order of set.replicas.
+func (s *replicaSet) Resolve(pools []gorm.ConnPool) gorm.ConnPool {
+	start := s.next.Add(1)
+	for i := range s.replicas {
+		replica := s.replicas[(start+uint64(i))%uint64(len(s.replicas))]
+		if replica.available(s.maxLag) {
+			return replica.pool
+		}
+	}
+	return s.primary
+}
+
+// target names the pool a statement ran on, for metrics.
+func (s *replicaSet) target(pool gorm.ConnPool) string {
+	if s != nil {
+		for _, replica := range s.replicas {
+			if pool == gorm.ConnPool(replica.pool) {
+				return "replica"
+			}
+		}
+	}
+	return "primary"
+}
+
+func (r *replica) available(maxLag time.Duration) bool {
+	lag := time.Duration(r.lag.Load())
+	return lag >= 0 && (maxLag == 0 || lag <= maxLag)
+}
+
+func (s *replicaSet) monitorLag() {
+	defer s.done.Done()
+
+	ticker := time.NewTicker(REPLICA_LAG_CHECK_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.checkLag()
+		}
+	}
+}
+
+func (s *replicaSet) checkLag() {
+	for _, replica := range s.replicas {
+		ctx, cancel := context.WithTimeout(context.Background(), REPLICA_LAG_CHECK_TIMEOUT)
+
+		var seconds float64
+		err := replica.pool.QueryRowContext(ctx, replicaLagQuery).Scan(&seconds)
+		cancel()
+
+		lag := time.Duration(seconds * float64(time.Second))
+		if err != nil {
+			lag = -1
+		}
+		replica.lag.Store(int64(lag))
+		metricpkg.ReportReplicaLag(replica.name, lag)
+	}
+}
+
+func (s *replicaSet) close() {
+	close(s.stop)
+	s.done.Wait()
+	s.closePools()
+}
+
+func (s *replicaSet) closePools() {
+	for _, replica := range s.replicas {
+		replica.pool.Close()
+	}
+}
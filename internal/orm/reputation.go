@@ -0,0 +1,401 @@
+package orm
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stormhead-org/backend/internal/lib"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Kinds of reputation events. Undoing an event records the opposite deltas
+// with REPUTATION_REMOVED_SUFFIX appended to the kind.
+const REPUTATION_POST_LIKE = "post_like"
+const REPUTATION_COMMENT_LIKE = "comment_like"
+const REPUTATION_COMMENT_RECEIVED = "comment_received"
+const REPUTATION_ADJUSTMENT = "adjustment"
+const REPUTATION_REMOVED_SUFFIX = "_removed"
+
+// A like is worth a point to its recipient and, for posts, to the community.
+// A comment adds a tenth of a point to the community rating (FR-455).
+const REPUTATION_LIKE_POINTS = 1
+const REPUTATION_COMMENT_POINTS = 0.1
+
+// ReputationEvent is an entry of the reputation ledger. UserDelta goes to
+// the user's reputation and, with a community, to their karma there;
+// CommunityDelta goes to the community rating. The totals are materialized
+// in user.reputation, community.reputation and community_karma.
+type ReputationEvent struct {
+	ID             uuid.UUID `gorm:"primaryKey"`
+	UserID         *uuid.UUID
+	CommunityID    *uuid.UUID
+	Kind           string
+	SourceID       *uuid.UUID // like or comment that caused the change
+	ActorID        *uuid.UUID // who liked, commented or adjusted
+	UserDelta      int64
+	CommunityDelta float64
+	Reason         string
+	CreatedAt      time.Time
+}
+
+func (e *ReputationEvent) TableName() string {
+	return "reputation_event"
+}
+
+func (e *ReputationEvent) BeforeCreate(transaction *gorm.DB) error {
+	e.ID = uuid.New()
+	return nil
+}
+
+// CommunityKarma is the reputation a user earned in one community.
+type CommunityKarma struct {
+	UserID      uuid.UUID
+	CommunityID uuid.UUID
+	Community   Community
+	Karma       int64
+}
+
+func (k *CommunityKarma) TableName() string {
+	return "community_karma"
+}
+
+// InsertReputationEvent records a reputation change and applies it to the
+// totals.
+func (c *PostgresClient) InsertReputationEvent(event *ReputationEvent) error {
+	return c.database.Transaction(func(tx *gorm.DB) error {
+		return insertReputationEvent(tx, event)
+	})
+}
+
+// RevertReputationEvents undoes every reputation change caused by the given
+// likes or comments, e.g. when a like is withdrawn. Sources already undone
+// are skipped.
+func (c *PostgresClient) RevertReputationEvents(sourceIDs []uuid.UUID) error {
+	if len(sourceIDs) == 0 {
+		return nil
+	}
+
+	return c.database.Transaction(func(tx *gorm.DB) error {
+		return revertReputationEvents(tx, sourceIDs)
+	})
+}
+
+// SelectReputationEventsWithPagination returns the reputation history of a
+// user, newest first, optionally limited to one community.
+func (c *PostgresClient) SelectReputationEventsWithPagination(userID string, communityID string, limit int, cursor string) ([]*ReputationEvent, lib.PageInfo, error) {
+	query := c.database.
+		Where("user_id = ?", userID)
+
+	if communityID != "" {
+		query = query.Where("community_id = ?", communityID)
+	}
+
+	return lib.Paginate(query, NewestFirst, func(event *ReputationEvent) []interface{} {
+		return createdAtAndID(event.CreatedAt, event.ID)
+	}, cursor, limit)
+}
+
+// SelectCommunityKarmaByUserID returns the user's karma in every community
+// they earned any, highest first.
+func (c *PostgresClient) SelectCommunityKarmaByUserID(userID string) ([]*CommunityKarma, error) {
+	var karma []*CommunityKarma
+	tx := c.database.
+		Preload("Community").
+		Where("user_id = ? AND karma <> 0", userID).
+		Order("karma DESC").
+		Find(&karma)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return karma, nil
+}
+
+// RebuildReputation replays the ledger from the source tables: events of
+// existing likes and comments are recreated, undone ones dropped, manual
+// adjustments kept, and every total is recomputed from the result. Deleted
+// posts and comments give nothing, as DeletePost and DeleteComment took it
+// back.
+func (c *PostgresClient) RebuildReputation(ctx context.Context) error {
+	return c.withTx(ctx, func(tx *PostgresClient) error {
+		statements := []struct {
+			query string
+			args  []interface{}
+		}{
+			{
+				`DELETE FROM reputation_event WHERE kind <> ?`,
+				[]interface{}{REPUTATION_ADJUSTMENT},
+			},
+			{
+				`INSERT INTO reputation_event (id, user_id, community_id, kind, source_id, actor_id, user_delta, community_delta, reason, created_at)
+				SELECT gen_random_uuid(), post.author_id, post.community_id, ?, post_like.id, post_like.user_id, ?, ?, '', post_like.created_at
+				FROM post_like JOIN post ON post.id = post_like.post_id
+				WHERE post.deleted_at IS NULL`,
+				[]interface{}{REPUTATION_POST_LIKE, REPUTATION_LIKE_POINTS, REPUTATION_LIKE_POINTS},
+			},
+			{
+				`INSERT INTO reputation_event (id, user_id, community_id, kind, source_id, actor_id, user_delta, community_delta, reason, created_at)
+				SELECT gen_random_uuid(), comment.author_id, post.community_id, ?, comment_like.id, comment_like.user_id, ?, 0, '', comment_like.created_at
+				FROM comment_like JOIN comment ON comment.id = comment_like.comment_id JOIN post ON post.id = comment.post_id
+				WHERE comment.deleted_at IS NULL AND post.deleted_at IS NULL`,
+				[]interface{}{REPUTATION_COMMENT_LIKE, REPUTATION_LIKE_POINTS},
+			},
+			{
+				`INSERT INTO reputation_event (id, user_id, community_id, kind, source_id, actor_id, user_delta, community_delta, reason, created_at)
+				SELECT gen_random_uuid(), NULL, post.community_id, ?, comment.id, comment.author_id, 0, ?, '', comment.created_at
+				FROM comment JOIN post ON post.id = comment.post_id
+				WHERE comment.deleted_at IS NULL AND post.deleted_at IS NULL`,
+				[]interface{}{REPUTATION_COMMENT_RECEIVED, REPUTATION_COMMENT_POINTS},
+			},
+			{
+				`UPDATE "user" SET reputation = coalesce((SELECT sum(user_delta) FROM reputation_event WHERE reputation_event.user_id = "user".id), 0)`,
+				nil,
+			},
+			{
+				`UPDATE community SET reputation = coalesce((SELECT sum(community_delta) FROM reputation_event WHERE reputation_event.community_id = community.id), 0)`,
+				nil,
+			},
+			{
+				`DELETE FROM community_karma`,
+				nil,
+			},
+			{
+				`INSERT INTO community_karma (user_id, community_id, karma)
+				SELECT user_id, community_id, sum(user_delta)
+				FROM reputation_event
+				WHERE user_id IS NOT NULL AND community_id IS NOT NULL
+				GROUP BY user_id, community_id
+				HAVING sum(user_delta) <> 0`,
+				nil,
+			},
+		}
+
+		for _, statement := range statements {
+			err := tx.database.Exec(statement.query, statement.args...).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func insertReputationEvent(tx *gorm.DB, event *ReputationEvent) error {
+	err := tx.Create(event).Error
+	if err != nil {
+		return err
+	}
+
+	if event.UserID != nil && event.UserDelta != 0 {
+		err = tx.
+			Model(&User{}).
+			Where("id = ?", *event.UserID).
+			UpdateColumn("reputation", gorm.Expr("reputation + ?", event.UserDelta)).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if event.CommunityID != nil {
+			err = tx.
+				Clauses(clause.OnConflict{
+					Columns:   []clause.Column{{Name: "user_id"}, {Name: "community_id"}},
+					DoUpdates: clause.Assignments(map[string]interface{}{"karma": gorm.Expr("community_karma.karma + EXCLUDED.karma")}),
+				}).
+				Create(&CommunityKarma{
+					UserID:      *event.UserID,
+					CommunityID: *event.CommunityID,
+					Karma:       event.UserDelta,
+				}).
+				Error
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if event.CommunityID != nil && event.CommunityDelta != 0 {
+		err = tx.
+			Model(&Community{}).
+			Where("id = ?", *event.CommunityID).
+			UpdateColumn("reputation", gorm.Expr("reputation + ?", event.CommunityDelta)).
+			Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// revertReputationEvents records, per source and recipient, the opposite of
+// what the ledger still holds. sourceIDs is a slice or a subquery.
+func revertReputationEvents(tx *gorm.DB, sourceIDs interface{}) error {
+	var balances []ReputationEvent
+	err := tx.
+		Model(&ReputationEvent{}).
+		Select("user_id, community_id, source_id, min(kind) AS kind, sum(user_delta) AS user_delta, sum(community_delta) AS community_delta").
+		Where("source_id IN (?)", sourceIDs).
+		Group("source_id, user_id, community_id").
+		Having("sum(user_delta) <> 0 OR sum(community_delta) <> 0").
+		Find(&balances).
+		Error
+	if err != nil {
+		return err
+	}
+
+	for _, balance := range balances {
+		// min(kind) is the original kind, it sorts before its "_removed" form
+		err = insertReputationEvent(tx, &ReputationEvent{
+			UserID:         balance.UserID,
+			CommunityID:    balance.CommunityID,
+			Kind:           strings.TrimSuffix(balance.Kind, REPUTATION_REMOVED_SUFFIX) + REPUTATION_REMOVED_SUFFIX,
+			SourceID:       balance.SourceID,
+			UserDelta:      -balance.UserDelta,
+			CommunityDelta: -balance.CommunityDelta,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertPostLikeReputation credits the post author, in the post community,
+// and the community itself for a new like.
+func insertPostLikeReputation(tx *gorm.DB, postLike *PostLike) error {
+	var post Post
+	err := tx.
+		Select([]string{"author_id", "community_id"}).
+		Where("id = ?", postLike.PostID).
+		First(&post).
+		Error
+	if err != nil {
+		return err
+	}
+
+	return insertReputationEvent(tx, &ReputationEvent{
+		UserID:         &post.AuthorID,
+		CommunityID:    &post.CommunityID,
+		Kind:           REPUTATION_POST_LIKE,
+		SourceID:       &postLike.ID,
+		ActorID:        &postLike.UserID,
+		UserDelta:      REPUTATION_LIKE_POINTS,
+		CommunityDelta: REPUTATION_LIKE_POINTS,
+	})
+}
+
+// insertCommentLikeReputation credits the comment author, in the community
+// of the post, for a new like.
+func insertCommentLikeReputation(tx *gorm.DB, commentLike *CommentLike) error {
+	var comment struct {
+		AuthorID    uuid.UUID
+		CommunityID uuid.UUID
+	}
+	err := tx.
+		Table("comment").
+		Select("comment.author_id, post.community_id").
+		Joins("JOIN post ON post.id = comment.post_id").
+		Where("comment.id = ?", commentLike.CommentID).
+		Take(&comment).
+		Error
+	if err != nil {
+		return err
+	}
+
+	return insertReputationEvent(tx, &ReputationEvent{
+		UserID:      &comment.AuthorID,
+		CommunityID: &comment.CommunityID,
+		Kind:        REPUTATION_COMMENT_LIKE,
+		SourceID:    &commentLike.ID,
+		ActorID:     &commentLike.UserID,
+		UserDelta:   REPUTATION_LIKE_POINTS,
+	})
+}
+
+// insertCommentReputation adds a new comment to the rating of the community
+// of the post.
+func insertCommentReputation(tx *gorm.DB, comment *Comment) error {
+	var post Post
+	err := tx.
+		Select([]string{"community_id"}).
+		Where("id = ?", comment.PostID).
+		First(&post).
+		Error
+	if err != nil {
+		return err
+	}
+
+	return insertReputationEvent(tx, &ReputationEvent{
+		CommunityID:    &post.CommunityID,
+		Kind:           REPUTATION_COMMENT_RECEIVED,
+		SourceID:       &comment.ID,
+		ActorID:        &comment.AuthorID,
+		CommunityDelta: REPUTATION_COMMENT_POINTS,
+	})
+}
+
+// restorePostReputation credits again the likes of a restored post and its
+// comments that are not deleted themselves.
+func restorePostReputation(tx *gorm.DB, postID uuid.UUID) error {
+	var postLikes []*PostLike
+	err := tx.Where("post_id = ?", postID).Find(&postLikes).Error
+	if err != nil {
+		return err
+	}
+
+	for _, postLike := range postLikes {
+		err = insertPostLikeReputation(tx, postLike)
+		if err != nil {
+			return err
+		}
+	}
+
+	var comments []*Comment
+	err = tx.
+		Select([]string{"id", "post_id", "author_id"}).
+		Where("post_id = ? AND deleted_at IS NULL", postID).
+		Find(&comments).
+		Error
+	if err != nil {
+		return err
+	}
+
+	for _, comment := range comments {
+		err = restoreCommentReputation(tx, comment)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreCommentReputation credits again a restored comment and its likes.
+func restoreCommentReputation(tx *gorm.DB, comment *Comment) error {
+	err := insertCommentReputation(tx, comment)
+	if err != nil {
+		return err
+	}
+
+	var commentLikes []*CommentLike
+	err = tx.Where("comment_id = ?", comment.ID).Find(&commentLikes).Error
+	if err != nil {
+		return err
+	}
+
+	for _, commentLike := range commentLikes {
+		err = insertCommentLikeReputation(tx, commentLike)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package orm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SchemaDrift is a difference between the live schema and what a model
+// expects that breaks reads or writes of the model.
+type SchemaDrift struct {
+	Table   string
+	Column  string // empty for a missing table
+	Problem string
+}
+
+func (d SchemaDrift) String() string {
+	if d.Column == "" {
+		return fmt.Sprintf("%s: %s", d.Table, d.Problem)
+	}
+	return fmt.Sprintf("%s.%s: %s", d.Table, d.Column, d.Problem)
+}
+
+// schemaModels are the models verified against the live schema, one per
+// table.
+var schemaModels = []interface{}{
+	&AuthThrottle{},
+	&Bookmark{},
+	&ChallengeRedemption{},
+	&Comment{},
+	&CommentLike{},
+	&Community{},
+	&CommunityKarma{},
+	&CommunityStatistics{},
+	&CommunityUser{},
+	&DataExport{},
+	&EmailChange{},
+	&EmailDomainRule{},
+	&Follower{},
+	&InviteCode{},
+	&KnownDevice{},
+	&LoginLockout{},
+	&OneTimeToken{},
+	&PersonalAccessToken{},
+	&PlatformSetting{},
+	&PlatformStatistics{},
+	&Post{},
+	&PostLike{},
+	&ReputationEvent{},
+	&Role{},
+	&Session{},
+	&User{},
+	&UserRole{},
+	&UserSlugRedirect{},
+}
+
+// columnTypes are the Postgres types a Go field type can be read from.
+var columnTypes = []struct {
+	goType reflect.Type
+	kinds  []reflect.Kind
+	types  []string
+}{
+	{reflect.TypeOf(uuid.UUID{}), nil, []string{"uuid"}},
+	{reflect.TypeOf(time.Time{}), nil, []string{"timestamptz", "timestamp", "date"}},
+	{reflect.TypeOf(json.RawMessage{}), nil, []string{"jsonb", "json"}},
+	{nil, []reflect.Kind{reflect.String}, []string{"text", "varchar", "bpchar", "citext"}},
+	{nil, []reflect.Kind{reflect.Bool}, []string{"bool"}},
+	{nil, []reflect.Kind{reflect.Int, reflect.Int16, reflect.Int32, reflect.Int64}, []string{"int2", "int4", "int8"}},
+	{nil, []reflect.Kind{reflect.Float32, reflect.Float64}, []string{"float4", "float8", "numeric"}},
+}
+
+type liveColumn struct {
+	Type       string
+	Nullable   bool
+	HasDefault bool
+}
+
+// VerifySchema compares the live schema with the models. Reported are
+// missing tables and columns, columns of a type the field cannot be read
+// from, nullable columns behind non-pointer fields, and NOT NULL columns
+// without a default that the model does not write.
+func (c *PostgresClient) VerifySchema(ctx context.Context) ([]SchemaDrift, error) {
+	rows, err := c.database.WithContext(ctx).Raw(
+		`SELECT table_name, column_name, udt_name, is_nullable = 'YES', column_default IS NOT NULL OR is_identity = 'YES'
+		FROM information_schema.columns
+		WHERE table_schema = current_schema()`,
+	).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables := map[string]map[string]liveColumn{}
+	for rows.Next() {
+		var table, column string
+		var live liveColumn
+		err = rows.Scan(&table, &column, &live.Type, &live.Nullable, &live.HasDefault)
+		if err != nil {
+			return nil, err
+		}
+
+		if tables[table] == nil {
+			tables[table] = map[string]liveColumn{}
+		}
+		tables[table][column] = live
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var drifts []SchemaDrift
+	for _, model := range schemaModels {
+		statement := &gorm.Statement{DB: c.database}
+		err = statement.Parse(model)
+		if err != nil {
+			return nil, err
+		}
+		table := statement.Schema.Table
+
+		columns, ok := tables[table]
+		if !ok {
+			drifts = append(drifts, SchemaDrift{Table: table, Problem: "table is missing"})
+			continue
+		}
+
+		for _, name := range statement.Schema.DBNames {
+			field := statement.Schema.FieldsByDBName[name]
+			live, ok := columns[name]
+			if !ok {
+				drifts = append(drifts, SchemaDrift{Table: table, Column: name, Problem: "column is missing"})
+				continue
+			}
+
+			fieldType := field.FieldType
+			pointer := fieldType.Kind() == reflect.Ptr
+			if pointer {
+				fieldType = fieldType.Elem()
+			}
+
+			expected := expectedColumnTypes(fieldType)
+			if expected != nil && !slices.Contains(expected, live.Type) {
+				drifts = append(drifts, SchemaDrift{
+					Table:   table,
+					Column:  name,
+					Problem: fmt.Sprintf("type %s, field %s needs one of %v", live.Type, field.Name, expected),
+				})
+			}
+
+			if live.Nullable && !pointer && fieldType.Kind() != reflect.Slice {
+				drifts = append(drifts, SchemaDrift{
+					Table:   table,
+					Column:  name,
+					Problem: fmt.Sprintf("nullable, field %s is not a pointer", field.Name),
+				})
+			}
+		}
+
+		for name, live := range columns {
+			_, ok := statement.Schema.FieldsByDBName[name]
+			if !ok && !live.Nullable && !live.HasDefault {
+				drifts = append(drifts, SchemaDrift{
+					Table:   table,
+					Column:  name,
+					Problem: "NOT NULL without a default and not in the model, inserts fail",
+				})
+			}
+		}
+	}
+
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].String() < drifts[j].String()
+	})
+
+	return drifts, nil
+}
+
+func expectedColumnTypes(goType reflect.Type) []string {
+	for _, candidate := range columnTypes {
+		if candidate.goType == goType {
+			return candidate.types
+		}
+	}
+
+	for _, candidate := range columnTypes {
+		if candidate.goType == nil && slices.Contains(candidate.kinds, goType.Kind()) {
+			return candidate.types
+		}
+	}
+
+	return nil
+}
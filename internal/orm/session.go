@@ -4,17 +4,26 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/stormhead-org/backend/internal/lib"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Session struct {
-	ID        uuid.UUID `gorm:"primaryKey"`
-	UserID    uuid.UUID
-	User      User
-	UserAgent string
-	IpAddress string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID               uuid.UUID `gorm:"primaryKey"`
+	UserID           uuid.UUID
+	User             User
+	Name             string // set by the user
+	UserAgent        string
+	IpAddress        string
+	Browser          string
+	OS               string
+	Device           string // desktop, mobile, tablet, bot or a device model
+	Country          string // ISO 3166-1 alpha-2 code
+	City             string
+	RefreshTokenHash string // SHA-256 of the current refresh token
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
 
 func (s *Session) TableName() string {
@@ -32,8 +41,15 @@ func (c *PostgresClient) SelectSessionByID(ID string) (*Session, error) {
 		Select([]string{
 			"id",
 			"user_id",
+			"name",
 			"user_agent",
 			"ip_address",
+			"browser",
+			"os",
+			"device",
+			"country",
+			"city",
+			"refresh_token_hash",
 			"created_at",
 			"updated_at",
 		}).
@@ -47,61 +63,95 @@ func (c *PostgresClient) SelectSessionByID(ID string) (*Session, error) {
 	return &session, nil
 }
 
-func (c *PostgresClient) SelectSessionsByUserID(userID string, cursor string, limit int) ([]*Session, error) {
-	var sessions []*Session
+// SelectSessionsByUserID lists the user's sessions newest first, a negative
+// limit lists all of them.
+func (c *PostgresClient) SelectSessionsByUserID(userID string, cursor string, limit int) ([]*Session, lib.PageInfo, error) {
 	query := c.database.
 		Select([]string{
 			"id",
 			"user_id",
+			"name",
 			"user_agent",
 			"ip_address",
+			"browser",
+			"os",
+			"device",
+			"country",
+			"city",
 			"created_at",
 			"updated_at",
 		}).
-		Where("user_id = ?", userID).
-		Order("created_at DESC")
+		Where("user_id = ?", userID)
 
-	if cursor != "" {
-		var cursorSession Session
-		tx := c.database.
-			Where("id = ?", cursor).
-			First(&cursorSession)
+	return lib.Paginate(query, NewestFirst, func(session *Session) []interface{} {
+		return createdAtAndID(session.CreatedAt, session.ID)
+	}, cursor, limit)
+}
 
-		if tx.Error != nil {
-			return nil, tx.Error
+// InsertSession creates a session and records the login as activity of the
+// user.
+func (c *PostgresClient) InsertSession(session *Session) error {
+	return c.database.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(session).Error
+		if err != nil {
+			return err
 		}
 
-		query = query.
-			Where(
-				"(created_at < ?) OR (created_at = ? AND id < ?)",
-				cursorSession.CreatedAt,
-				cursorSession.CreatedAt,
-				cursorSession.ID,
-			)
-	}
+		return recordUserActivity(tx, session.UserID, session.CreatedAt)
+	})
+}
 
-	var tx *gorm.DB
-	if limit != 0 {
-		tx = query.Limit(limit).Find(&sessions)
-	} else {
-		tx = query.Find(&sessions)
-	}
+func (c *PostgresClient) UpdateSession(session *Session) error {
+	tx := c.database.Model(session).Updates(session)
+	return tx.Error
+}
 
-	if tx.Error != nil {
-		return nil, tx.Error
+// RotateSessionRefreshToken replaces the refresh token hash only if it is
+// still the expected one, so each refresh token can be exchanged once. A
+// rotation is recorded as activity of the session's user.
+func (c *PostgresClient) RotateSessionRefreshToken(sessionID string, previousHash string, refreshTokenHash string) (bool, error) {
+	rotated := false
+	err := c.database.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		var session Session
+		result := tx.
+			Model(&session).
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "user_id"}}}).
+			Where("id = ? AND refresh_token_hash = ?", sessionID, previousHash).
+			Updates(map[string]interface{}{
+				"refresh_token_hash": refreshTokenHash,
+				"updated_at":         now,
+			})
+		if result.Error != nil || result.RowsAffected != 1 {
+			return result.Error
+		}
+
+		rotated = true
+		return recordUserActivity(tx, session.UserID, now)
+	})
+	if err != nil {
+		return false, err
 	}
 
-	return sessions, nil
+	return rotated, nil
 }
 
-func (c *PostgresClient) InsertSession(session *Session) error {
-	tx := c.database.Create(session)
-	return tx.Error
-}
+// UpdateSessionName renames a session of the user. Returns
+// gorm.ErrRecordNotFound if the user has no such session.
+func (c *PostgresClient) UpdateSessionName(sessionID string, userID string, name string) error {
+	tx := c.database.
+		Model(&Session{}).
+		Where("id = ? AND user_id = ?", sessionID, userID).
+		Update("name", name)
 
-func (c *PostgresClient) UpdateSession(session *Session) error {
-	tx := c.database.Model(session).Updates(session)
-	return tx.Error
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
 }
 
 func (c *PostgresClient) DeleteSession(session *Session) error {
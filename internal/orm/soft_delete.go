@@ -0,0 +1,223 @@
+package orm
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DELETED_CONTENT_RETENTION is how long deleted posts, comments and
+// communities can be restored. The purge job removes them afterwards.
+const DELETED_CONTENT_RETENTION = 30 * 24 * time.Hour
+
+// DELETED_COMMENT_CONTENT replaces the content of a deleted comment that
+// stays in its thread because it has replies.
+const DELETED_COMMENT_CONTENT = "[deleted]"
+
+// Deleted rows stay in their tables until purged. Reads hide them together
+// with everything in a deleted post or community.
+const deletedCommunityIDs = "SELECT id FROM community WHERE deleted_at IS NOT NULL"
+const hiddenPostIDs = "SELECT id FROM post WHERE deleted_at IS NOT NULL OR community_id IN (" + deletedCommunityIDs + ")"
+const visiblePost = "deleted_at IS NULL AND community_id NOT IN (" + deletedCommunityIDs + ")"
+const visibleComment = "deleted_at IS NULL AND post_id NOT IN (" + hiddenPostIDs + ")"
+
+// hasReplies matches comments other comments reply to, deleted or not.
+const hasReplies = "EXISTS (SELECT 1 FROM comment reply WHERE reply.parent_comment_id = comment.id)"
+
+// softDelete marks a row deleted. Returns false if it already was.
+// updated_at is left alone, it tracks edits.
+func softDelete(tx *gorm.DB, model interface{}, ID uuid.UUID, deletedBy uuid.UUID, reason string) (bool, error) {
+	result := tx.
+		Model(model).
+		Where("id = ? AND deleted_at IS NULL", ID).
+		UpdateColumns(map[string]interface{}{
+			"deleted_at":      time.Now(),
+			"deleted_by":      deletedBy,
+			"deletion_reason": reason,
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+// restoreDeleted clears the deletion of a row deleted within the retention
+// window. Returns gorm.ErrRecordNotFound if there is none.
+func restoreDeleted(tx *gorm.DB, model interface{}, ID uuid.UUID) error {
+	result := tx.
+		Model(model).
+		Where("id = ? AND deleted_at > ?", ID, time.Now().Add(-DELETED_CONTENT_RETENTION)).
+		UpdateColumns(map[string]interface{}{
+			"deleted_at":      nil,
+			"deleted_by":      nil,
+			"deletion_reason": "",
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// PurgeDeletedPosts removes up to limit posts deleted before deletedBefore
+// with their comments, likes and bookmarks. Returns how many were removed.
+func (c *PostgresClient) PurgeDeletedPosts(deletedBefore time.Time, limit int) (int, error) {
+	var posts []Post
+	err := c.database.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Select([]string{"id"}).
+			Where("deleted_at < ?", deletedBefore).
+			Order("deleted_at").
+			Limit(limit).
+			Find(&posts).
+			Error
+		if err != nil || len(posts) == 0 {
+			return err
+		}
+
+		postIDs := make([]uuid.UUID, len(posts))
+		for i, post := range posts {
+			postIDs[i] = post.ID
+		}
+		return purgePosts(tx, postIDs)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(posts), nil
+}
+
+// PurgeDeletedComments removes up to limit comments deleted before
+// deletedBefore with their likes. Comments with replies stay as placeholders
+// until the replies are gone. Returns how many were removed.
+func (c *PostgresClient) PurgeDeletedComments(deletedBefore time.Time, limit int) (int, error) {
+	var comments []Comment
+	err := c.database.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Select([]string{"id"}).
+			Where("deleted_at < ?", deletedBefore).
+			Where("NOT " + hasReplies).
+			Order("deleted_at").
+			Limit(limit).
+			Find(&comments).
+			Error
+		if err != nil || len(comments) == 0 {
+			return err
+		}
+
+		commentIDs := make([]uuid.UUID, len(comments))
+		for i, comment := range comments {
+			commentIDs[i] = comment.ID
+		}
+
+		// The reputation was taken back on deletion, this only catches
+		// likes that raced with it
+		commentLikes := tx.Model(&CommentLike{}).Select("id").Where("comment_id IN (?)", commentIDs)
+		err = revertReputationEvents(tx, commentLikes)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("comment_id IN (?)", commentIDs).Delete(&CommentLike{}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Where("id IN (?)", commentIDs).Delete(&Comment{}).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(comments), nil
+}
+
+// PurgeDeletedCommunities removes up to limit communities deleted before
+// deletedBefore with their posts and memberships. The reputation their
+// content and adjustments gave is taken back, roles and karma cascade.
+// Returns how many were removed.
+func (c *PostgresClient) PurgeDeletedCommunities(deletedBefore time.Time, limit int) (int, error) {
+	var communities []Community
+	err := c.database.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Select([]string{"id"}).
+			Where("deleted_at < ?", deletedBefore).
+			Order("deleted_at").
+			Limit(limit).
+			Find(&communities).
+			Error
+		if err != nil {
+			return err
+		}
+
+		for _, community := range communities {
+			err = purgePosts(tx, tx.Model(&Post{}).Select("id").Where("community_id = ?", community.ID))
+			if err != nil {
+				return err
+			}
+
+			err = tx.Where("community_id = ?", community.ID).Delete(&CommunityUser{}).Error
+			if err != nil {
+				return err
+			}
+
+			// Adjustments are left, their events cascade with the community
+			err = tx.
+				Model(&User{}).
+				Where("id IN (?)", tx.Model(&ReputationEvent{}).Select("user_id").Where("community_id = ?", community.ID)).
+				UpdateColumn("reputation", gorm.Expr(
+					"reputation - (SELECT coalesce(sum(user_delta), 0) FROM reputation_event WHERE reputation_event.user_id = \"user\".id AND reputation_event.community_id = ?)",
+					community.ID,
+				)).
+				Error
+			if err != nil {
+				return err
+			}
+
+			err = tx.Delete(&Community{}, "id = ?", community.ID).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(communities), nil
+}
+
+// purgePosts hard-deletes posts with their comments, likes and bookmarks,
+// taking back the reputation these still hold. postIDs is a slice or a
+// subquery.
+func purgePosts(tx *gorm.DB, postIDs interface{}) error {
+	comments := tx.Model(&Comment{}).Select("id").Where("post_id IN (?)", postIDs)
+	commentLikes := tx.Model(&CommentLike{}).Select("id").Where("comment_id IN (?)", comments)
+	postLikes := tx.Model(&PostLike{}).Select("id").Where("post_id IN (?)", postIDs)
+
+	for _, sourceIDs := range []*gorm.DB{commentLikes, comments, postLikes} {
+		err := revertReputationEvents(tx, sourceIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := tx.Where("comment_id IN (?)", comments).Delete(&CommentLike{}).Error
+	if err != nil {
+		return err
+	}
+
+	steps := []interface{}{&Comment{}, &PostLike{}, &Bookmark{}}
+	for _, model := range steps {
+		err = tx.Where("post_id IN (?)", postIDs).Delete(model).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Where("id IN (?)", postIDs).Delete(&Post{}).Error
+}
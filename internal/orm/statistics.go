@@ -0,0 +1,238 @@
+package orm
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Granularities of the statistics rollups. Buckets start at the full hour
+// or at midnight UTC.
+const STATISTICS_HOUR = "hour"
+const STATISTICS_DAY = "day"
+
+// PlatformStatistics is the activity of the whole platform in one bucket.
+// ActiveUsers counts the distinct users who logged in, refreshed a session,
+// published, commented, liked or joined a community in it.
+type PlatformStatistics struct {
+	Granularity    string    `gorm:"primaryKey"`
+	BucketStart    time.Time `gorm:"primaryKey"`
+	NewUsers       int64
+	ActiveUsers    int64
+	NewCommunities int64
+	Posts          int64
+	Comments       int64
+	Likes          int64
+	Joins          int64
+	ComputedAt     time.Time
+}
+
+func (s *PlatformStatistics) TableName() string {
+	return "platform_statistics"
+}
+
+// CommunityStatistics is the activity in one community in one bucket. There
+// is no row for a bucket without activity.
+type CommunityStatistics struct {
+	CommunityID uuid.UUID `gorm:"primaryKey"`
+	Granularity string    `gorm:"primaryKey"`
+	BucketStart time.Time `gorm:"primaryKey"`
+	ActiveUsers int64
+	Posts       int64
+	Comments    int64
+	Likes       int64
+	Joins       int64
+	ComputedAt  time.Time
+}
+
+func (s *CommunityStatistics) TableName() string {
+	return "community_statistics"
+}
+
+// StatisticsBucketSize is the length of the buckets of a granularity, zero
+// for an unknown one.
+func StatisticsBucketSize(granularity string) time.Duration {
+	switch granularity {
+	case STATISTICS_HOUR:
+		return time.Hour
+	case STATISTICS_DAY:
+		return 24 * time.Hour
+	}
+	return 0
+}
+
+// StatisticsBuckets returns the starts of the buckets overlapping
+// [from, to).
+func StatisticsBuckets(granularity string, from time.Time, to time.Time) []time.Time {
+	size := StatisticsBucketSize(granularity)
+	if size == 0 {
+		return nil
+	}
+
+	buckets := []time.Time{}
+	for start := from.UTC().Truncate(size); start.Before(to); start = start.Add(size) {
+		buckets = append(buckets, start)
+	}
+	return buckets
+}
+
+// statisticsActivity lists who did what in a bucket, one row per post,
+// comment, like, membership and hour with a login or refresh, with the
+// community it happened in. Deleted content and content of deleted
+// communities doesn't count. Logins come from user_activity, not from the
+// sessions, which logout and the session purge delete.
+const statisticsActivity = `WITH activity AS (
+	SELECT p.community_id, p.author_id AS user_id, 'post' AS kind
+	FROM post p
+	WHERE p.status = @published AND p.deleted_at IS NULL AND p.published_at >= @start AND p.published_at < @end
+	UNION ALL
+	SELECT p.community_id, c.author_id, 'comment'
+	FROM comment c JOIN post p ON p.id = c.post_id
+	WHERE c.deleted_at IS NULL AND p.deleted_at IS NULL AND c.created_at >= @start AND c.created_at < @end
+	UNION ALL
+	SELECT p.community_id, l.user_id, 'like'
+	FROM post_like l JOIN post p ON p.id = l.post_id
+	WHERE p.deleted_at IS NULL AND l.created_at >= @start AND l.created_at < @end
+	UNION ALL
+	SELECT p.community_id, l.user_id, 'like'
+	FROM comment_like l JOIN comment c ON c.id = l.comment_id JOIN post p ON p.id = c.post_id
+	WHERE c.deleted_at IS NULL AND p.deleted_at IS NULL AND l.created_at >= @start AND l.created_at < @end
+	UNION ALL
+	SELECT m.community_id, m.user_id, 'join'
+	FROM community_user m
+	WHERE m.created_at >= @start AND m.created_at < @end
+	UNION ALL
+	SELECT NULL, a.user_id, 'login'
+	FROM user_activity a
+	WHERE a.hour >= @start AND a.hour < @end
+), visible_activity AS (
+	SELECT activity.*
+	FROM activity LEFT JOIN community ON community.id = activity.community_id
+	WHERE activity.community_id IS NULL OR community.deleted_at IS NULL
+)
+`
+
+// RollupStatistics recomputes the platform bucket and the community buckets
+// starting at bucketStart from the content tables. A bucket can be
+// recomputed any number of times, the last computation wins.
+func (c *PostgresClient) RollupStatistics(ctx context.Context, granularity string, bucketStart time.Time) error {
+	arguments := map[string]interface{}{
+		"granularity": granularity,
+		"start":       bucketStart,
+		"end":         bucketStart.Add(StatisticsBucketSize(granularity)),
+		"now":         time.Now(),
+		"published":   PostStatusPublished,
+	}
+
+	return c.withTx(ctx, func(tx *PostgresClient) error {
+		err := tx.database.Exec(
+			statisticsActivity+`INSERT INTO platform_statistics (granularity, bucket_start, new_users, active_users, new_communities, posts, comments, likes, joins, computed_at)
+			SELECT
+				@granularity,
+				@start,
+				(SELECT count(*) FROM "user" WHERE created_at >= @start AND created_at < @end),
+				(SELECT count(DISTINCT user_id) FROM visible_activity),
+				(SELECT count(*) FROM community WHERE deleted_at IS NULL AND created_at >= @start AND created_at < @end),
+				(SELECT count(*) FROM visible_activity WHERE kind = 'post'),
+				(SELECT count(*) FROM visible_activity WHERE kind = 'comment'),
+				(SELECT count(*) FROM visible_activity WHERE kind = 'like'),
+				(SELECT count(*) FROM visible_activity WHERE kind = 'join'),
+				@now
+			ON CONFLICT (granularity, bucket_start) DO UPDATE SET
+				new_users = excluded.new_users,
+				active_users = excluded.active_users,
+				new_communities = excluded.new_communities,
+				posts = excluded.posts,
+				comments = excluded.comments,
+				likes = excluded.likes,
+				joins = excluded.joins,
+				computed_at = excluded.computed_at`,
+			arguments,
+		).Error
+		if err != nil {
+			return err
+		}
+
+		// Communities whose activity was deleted since the last computation
+		// lose their row
+		err = tx.database.
+			Where("granularity = ? AND bucket_start = ?", granularity, bucketStart).
+			Delete(&CommunityStatistics{}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		return tx.database.Exec(
+			statisticsActivity+`INSERT INTO community_statistics (community_id, granularity, bucket_start, active_users, posts, comments, likes, joins, computed_at)
+			SELECT
+				community_id,
+				@granularity,
+				@start,
+				count(DISTINCT user_id),
+				count(*) FILTER (WHERE kind = 'post'),
+				count(*) FILTER (WHERE kind = 'comment'),
+				count(*) FILTER (WHERE kind = 'like'),
+				count(*) FILTER (WHERE kind = 'join'),
+				@now
+			FROM visible_activity
+			WHERE community_id IS NOT NULL
+			GROUP BY community_id`,
+			arguments,
+		).Error
+	})
+}
+
+// DeleteStatisticsBefore removes the buckets of a granularity that started
+// before the given time. Returns how many rows were removed.
+func (c *PostgresClient) DeleteStatisticsBefore(granularity string, before time.Time) (int64, error) {
+	var count int64
+	err := c.database.Transaction(func(tx *gorm.DB) error {
+		result := tx.
+			Where("granularity = ? AND bucket_start < ?", granularity, before).
+			Delete(&PlatformStatistics{})
+		if result.Error != nil {
+			return result.Error
+		}
+		count += result.RowsAffected
+
+		result = tx.
+			Where("granularity = ? AND bucket_start < ?", granularity, before).
+			Delete(&CommunityStatistics{})
+		count += result.RowsAffected
+		return result.Error
+	})
+	return count, err
+}
+
+// SelectPlatformStatistics returns the computed platform buckets of a
+// granularity starting in [from, to), oldest first.
+func (c *PostgresClient) SelectPlatformStatistics(granularity string, from time.Time, to time.Time) ([]*PlatformStatistics, error) {
+	var statistics []*PlatformStatistics
+	err := c.database.
+		Where("granularity = ? AND bucket_start >= ? AND bucket_start < ?", granularity, from, to).
+		Order("bucket_start").
+		Find(&statistics).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return statistics, nil
+}
+
+// SelectCommunityStatistics returns the buckets of a community with activity
+// of a granularity starting in [from, to), oldest first.
+func (c *PostgresClient) SelectCommunityStatistics(communityID string, granularity string, from time.Time, to time.Time) ([]*CommunityStatistics, error) {
+	var statistics []*CommunityStatistics
+	err := c.database.
+		Where("community_id = ? AND granularity = ? AND bucket_start >= ? AND bucket_start < ?", communityID, granularity, from, to).
+		Order("bucket_start").
+		Find(&statistics).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return statistics, nil
+}
@@ -0,0 +1,227 @@
+package orm
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/stormhead-org/backend/internal/lib"
+)
+
+// The store interfaces group the PostgresClient methods by aggregate, so
+// code can depend on the part of the database it uses and tests can swap in
+// the in-memory store of the ormtest package. Both implementations pass the
+// conformance suite in ormtest. The interfaces after Store are only
+// implemented by PostgresClient.
+
+type UserStore interface {
+	SelectUserByID(ID string) (*User, error)
+	SelectUserBySlug(slug string) (*User, error)
+	SelectUserByName(name string) (*User, error)
+	SelectUserByEmail(email string) (*User, error)
+	SelectUserSlugTaken(slugSkeleton string, exceptUserID string) (bool, error)
+	SelectUserSlugRedirect(slug string) (*UserSlugRedirect, error)
+	SelectPendingUsersWithPagination(limit int, cursor string) ([]*User, lib.PageInfo, error)
+	CountUsers() (int64, error)
+	InsertUser(user *User) error
+	UpdateUser(user *User) error
+	UpdateUserSlug(user *User, slug string, slugSkeleton string) error
+	UpdateUserPassword(userID string, password string) error
+	UpdateUserVerified(userID string) error
+	ScheduleUserDeletion(userID string, scheduledAt time.Time) error
+	CancelUserDeletion(userID string) error
+	ApproveUser(userID string) error
+	DeletePendingUser(userID string) error
+}
+
+type SessionStore interface {
+	SelectSessionByID(ID string) (*Session, error)
+	SelectSessionsByUserID(userID string, cursor string, limit int) ([]*Session, lib.PageInfo, error)
+	InsertSession(session *Session) error
+	UpdateSession(session *Session) error
+	RotateSessionRefreshToken(sessionID string, previousHash string, refreshTokenHash string) (bool, error)
+	UpdateSessionName(sessionID string, userID string, name string) error
+	DeleteSession(session *Session) error
+	DeleteSessions() error
+	DeleteSessionsByUserID(userID string) ([]string, error)
+	DeleteOtherSessionsByUserID(userID string, exceptSessionID string) ([]string, error)
+}
+
+// CommunityStore includes membership, which maintains the member counter.
+type CommunityStore interface {
+	SelectCommunityByID(id string) (*Community, error)
+	SelectCommunityBySlug(slug string) (*Community, error)
+	SelectCommunityByName(name string) (*Community, error)
+	SelectCommunitiesWithPagination(owner_id string, limit int, cursor string) ([]*Community, lib.PageInfo, error)
+	InsertCommunity(community *Community) error
+	UpdateCommunity(community *Community) error
+	DeleteCommunity(community *Community, deletedBy uuid.UUID, reason string) error
+	SelectDeletedCommunityByID(id string) (*Community, error)
+	RestoreCommunity(community *Community) error
+	PurgeDeletedCommunities(deletedBefore time.Time, limit int) (int, error)
+	SelectCommunityUser(communityID string, userID string) (*CommunityUser, error)
+	SelectCommunityUsersByUserID(userID string) ([]*CommunityUser, error)
+	InsertCommunityUser(communityUser *CommunityUser) (bool, error)
+	DeleteCommunityUser(communityID uuid.UUID, userID uuid.UUID) (bool, error)
+}
+
+type PostStore interface {
+	SelectPostByID(id string) (*Post, error)
+	SelectPostsWithPagination(author_id string, sort string, limit int, cursor string) ([]*Post, lib.PageInfo, error)
+	SelectPostsByAuthorID(authorID string) ([]*Post, error)
+	InsertPost(post *Post) error
+	UpdatePost(post *Post) error
+	DeletePost(post *Post, deletedBy uuid.UUID, reason string) error
+	SelectDeletedPostByID(id string) (*Post, error)
+	RestorePost(post *Post) error
+	PurgeDeletedPosts(deletedBefore time.Time, limit int) (int, error)
+}
+
+type CommentStore interface {
+	SelectCommentByID(id string) (*Comment, error)
+	SelectCommentsWithPagination(post_id string, author_id string, limit int, cursor string) ([]*Comment, lib.PageInfo, error)
+	SelectCommentsByAuthorID(authorID string) ([]*Comment, error)
+	InsertComment(comment *Comment) error
+	UpdateComment(comment *Comment) error
+	DeleteComment(comment *Comment, deletedBy uuid.UUID, reason string) error
+	SelectDeletedCommentByID(id string) (*Comment, error)
+	RestoreComment(comment *Comment) error
+	PurgeDeletedComments(deletedBefore time.Time, limit int) (int, error)
+}
+
+type LikeStore interface {
+	SelectPostLikeByID(postID string, userID string) (*PostLike, error)
+	SelectPostLikesByUserID(userID string) ([]*PostLike, error)
+	InsertPostLike(postLike *PostLike) (bool, error)
+	DeletePostLike(postID uuid.UUID, userID uuid.UUID) (bool, error)
+	SelectCommentLikeByID(commentID string, userID string) (*CommentLike, error)
+	SelectCommentLikesByUserID(userID string) ([]*CommentLike, error)
+	InsertCommentLike(commentLike *CommentLike) (bool, error)
+	DeleteCommentLike(commentID uuid.UUID, userID uuid.UUID) (bool, error)
+}
+
+type BookmarkStore interface {
+	SelectBookmarkByID(postID string, userID string) (*Bookmark, error)
+	SelectBookmarksWithPagination(userID string, limit int, cursor string) ([]*Bookmark, lib.PageInfo, error)
+	SelectBookmarksByUserID(userID string) ([]*Bookmark, error)
+	InsertBookmark(bookmark *Bookmark) (bool, error)
+	DeleteBookmark(postID uuid.UUID, userID uuid.UUID) (bool, error)
+}
+
+type FollowerStore interface {
+	SelectFollowerByID(userID string, followerID string) (*Follower, error)
+	SelectFollowersWithPagination(userID string, followerID string, limit int, cursor string) ([]*Follower, lib.PageInfo, error)
+	SelectFollowsByUserID(userID string) ([]*Follower, error)
+	InsertFollower(follower *Follower) (bool, error)
+	DeleteFollower(userID uuid.UUID, followerID uuid.UUID) (bool, error)
+}
+
+// RoleStore includes the platform owner, who holds every platform
+// permission.
+type RoleStore interface {
+	SelectRoleByName(name string, communityID *uuid.UUID) (*Role, error)
+	SelectRolesByUserID(userID string) ([]*Role, error)
+	SelectUserHasPlatformPermission(userID string, permission string) (bool, error)
+	InsertRole(role *Role) error
+	InsertUserRole(userRole *UserRole) error
+	DeleteUserRole(userRole *UserRole) error
+	UpdatePlatformOwner(userID uuid.UUID) error
+}
+
+type OneTimeTokenStore interface {
+	InsertOneTimeToken(token *OneTimeToken) error
+	ConsumeOneTimeToken(purpose string, tokenHash string) (*OneTimeToken, error)
+	DeleteOneTimeTokensByUserID(userID string, purpose string) error
+}
+
+// AuthThrottleStore includes the login lockouts the throttles record.
+type AuthThrottleStore interface {
+	SelectAuthThrottle(kind string, key string) (*AuthThrottle, error)
+	IncrementAuthThrottle(kind string, key string, window time.Duration) (*AuthThrottle, error)
+	UpdateAuthThrottleLockedUntil(kind string, key string, lockedUntil time.Time) error
+	DeleteAuthThrottle(kind string, key string) error
+	SelectLoginLockoutsWithPagination(limit int, cursor string) ([]*LoginLockout, lib.PageInfo, error)
+	InsertLoginLockout(lockout *LoginLockout) error
+	InsertLoginLockoutUnlessActive(lockout *LoginLockout) (bool, error)
+}
+
+type InviteCodeStore interface {
+	SelectInviteCodeByID(ID string) (*InviteCode, error)
+	SelectInviteCodesWithPagination(limit int, cursor string) ([]*InviteCode, lib.PageInfo, error)
+	InsertInviteCode(code *InviteCode) error
+	RevokeInviteCode(ID string) error
+	InsertUserWithInviteCode(user *User, codeHash string) error
+}
+
+// Transactor runs a function in a transaction of the store, see
+// PostgresClient.WithTx.
+type Transactor interface {
+	WithTx(ctx context.Context, fn func(tx Store) error) error
+}
+
+type Store interface {
+	UserStore
+	SessionStore
+	CommunityStore
+	PostStore
+	CommentStore
+	LikeStore
+	BookmarkStore
+	FollowerStore
+	RoleStore
+	OneTimeTokenStore
+	AuthThrottleStore
+	InviteCodeStore
+	Transactor
+}
+
+type PlatformSettingStore interface {
+	SelectPlatformSetting() (*PlatformSetting, error)
+	UpdatePlatformRegistrationMode(mode string) error
+	SelectEmailDomainRules() ([]*EmailDomainRule, error)
+	UpsertEmailDomainRule(rule *EmailDomainRule) error
+	DeleteEmailDomainRule(domain string) error
+}
+
+type PersonalAccessTokenStore interface {
+	SelectPersonalAccessTokenByHash(tokenHash string) (*PersonalAccessToken, error)
+	SelectPersonalAccessTokenByID(ID string) (*PersonalAccessToken, error)
+	SelectPersonalAccessTokensByUserID(userID string) ([]*PersonalAccessToken, error)
+	InsertPersonalAccessToken(token *PersonalAccessToken) error
+	UpdatePersonalAccessTokenLastUsed(ID string, lastUsedAt time.Time) error
+	RevokePersonalAccessToken(ID string) error
+}
+
+type EmailChangeStore interface {
+	SelectEmailChangeByID(ID string) (*EmailChange, error)
+	InsertEmailChange(change *EmailChange, token *OneTimeToken, revertToken *OneTimeToken) error
+	ConfirmEmailChange(tokenHash string) (*EmailChange, error)
+	RevertEmailChange(revertTokenHash string) (*EmailChange, error)
+}
+
+type ReputationStore interface {
+	InsertReputationEvent(event *ReputationEvent) error
+	SelectReputationEventsWithPagination(userID string, communityID string, limit int, cursor string) ([]*ReputationEvent, lib.PageInfo, error)
+	SelectCommunityKarmaByUserID(userID string) ([]*CommunityKarma, error)
+}
+
+type StatisticsStore interface {
+	SelectPlatformStatistics(granularity string, from time.Time, to time.Time) ([]*PlatformStatistics, error)
+	SelectCommunityStatistics(communityID string, granularity string, from time.Time, to time.Time) ([]*CommunityStatistics, error)
+}
+
+type DataExportStore interface {
+	SelectDataExportByID(ID string) (*DataExport, error)
+	SelectLatestDataExportByUserID(userID string) (*DataExport, error)
+	InsertDataExport(export *DataExport, interval time.Duration) error
+	FailDataExport(ID string) error
+}
+
+var _ Store = (*PostgresClient)(nil)
+var _ PlatformSettingStore = (*PostgresClient)(nil)
+var _ PersonalAccessTokenStore = (*PostgresClient)(nil)
+var _ EmailChangeStore = (*PostgresClient)(nil)
+var _ ReputationStore = (*PostgresClient)(nil)
+var _ StatisticsStore = (*PostgresClient)(nil)
+var _ DataExportStore = (*PostgresClient)(nil)
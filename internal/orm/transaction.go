@@ -0,0 +1,82 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// TX_MAX_ATTEMPTS bounds how many times WithTx runs a transaction that keeps
+// failing with a serialization failure or a deadlock.
+const TX_MAX_ATTEMPTS = 5
+
+// TX_RETRY_DELAY is the base of the linear backoff between attempts.
+const TX_RETRY_DELAY = 20 * time.Millisecond
+
+// retryableSQLStates are errors after which the whole transaction can simply
+// be run again.
+var retryableSQLStates = map[string]bool{
+	"40001": true, // serialization_failure
+	"40P01": true, // deadlock_detected
+}
+
+// WithTx runs fn in a transaction bound to ctx. Every method of tx runs
+// inside the transaction, which is committed if fn returns nil and rolled
+// back otherwise. Calling WithTx on tx opens a savepoint instead, so helpers
+// can use it whether or not a transaction is already open.
+//
+// The outermost transaction is retried from the start on serialization
+// failures and deadlocks, so fn must be idempotent. It must have no side
+// effects outside the database: write broker messages after WithTx returns.
+// It must not build on what a failed attempt left in captured variables:
+// read values it changes from tx instead of incrementing a copy loaded
+// before, and start records it inserts from their unsaved state, as an
+// insert fills in the ID and timestamps of the rolled back row. fn must use
+// tx only, the client itself may be waiting for the connection tx holds.
+func (c *PostgresClient) WithTx(ctx context.Context, fn func(tx Store) error) error {
+	return c.withTx(ctx, func(tx *PostgresClient) error {
+		return fn(tx)
+	})
+}
+
+// withTx is WithTx for the package itself, whose transactions also run
+// queries of their own on tx.database.
+func (c *PostgresClient) withTx(ctx context.Context, fn func(tx *PostgresClient) error) error {
+	run := func() error {
+		return c.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			return fn(&PostgresClient{database: tx, inTransaction: true})
+		})
+	}
+
+	// Only the outermost transaction can be retried, a savepoint is rolled
+	// back together with it
+	if c.inTransaction {
+		return run()
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := run()
+		if err == nil || attempt == TX_MAX_ATTEMPTS || !isRetryableError(err) {
+			return err
+		}
+
+		delay := time.Duration(attempt)*TX_RETRY_DELAY + rand.N(TX_RETRY_DELAY)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+	}
+}
+
+func isRetryableError(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return retryableSQLStates[pgErr.Code]
+}
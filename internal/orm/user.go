@@ -1,32 +1,43 @@
 package orm
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/stormhead-org/backend/internal/lib"
 	"gorm.io/gorm"
 )
 
+// USER_SLUG_LOCK_ID is the first key of the advisory locks taken per slug
+// skeleton, see claimUserSlug.
+const USER_SLUG_LOCK_ID = 31
+
+var ErrUserSlugTaken = errors.New("slug already taken")
+
 type User struct {
-	ID                uuid.UUID `gorm:"primaryKey"`
-	Slug              string
-	Name              string
-	Description       string
-	Email             string
-	Password          string
-	Salt              string
-	VerificationToken string
-	ResetToken        string
-	ResetTokenExpiresAt *time.Time
-	IsVerified        bool
-	Reputation        int64
-	LastActivity      time.Time
-	Communities       []Community `gorm:"foreignKey:OwnerID"`
-	CreatedAt         time.Time
-	UpdatedAt         time.Time
-	IsBanned          bool          `gorm:"default:false" json:"is_banned"`
-	BanReason         string        `json:"ban_reason,omitempty"`
-	Roles             []*Role       `gorm:"many2many:user_roles;" json:"roles,omitempty"`
+	ID                  uuid.UUID `gorm:"primaryKey"`
+	Slug                string
+	SlugSkeleton        string
+	SlugChangedAt       *time.Time
+	Name                string
+	Description         string
+	Email               string
+	Password            string
+	Salt                string
+	IsVerified          bool
+	IsApproved          bool
+	InviteCodeID        *uuid.UUID
+	Reputation          int64
+	LastActivity        time.Time
+	DeletionRequestedAt *time.Time
+	DeletionScheduledAt *time.Time
+	Communities         []Community `gorm:"foreignKey:OwnerID"`
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	IsBanned            bool    `gorm:"default:false" json:"is_banned"`
+	BanReason           string  `json:"ban_reason,omitempty"`
+	Roles               []*Role `gorm:"many2many:user_roles;" json:"roles,omitempty"`
 }
 
 // TableName returns the name of the table for the User model
@@ -50,16 +61,18 @@ func (c *PostgresClient) SelectUserByID(ID string) (*User, error) {
 			[]string{
 				"id",
 				"slug",
+				"slug_skeleton",
 				"name",
 				"description",
 				"email",
 				"password",
 				"salt",
-				"verification_token",
-				"reset_token",
+				"slug_changed_at",
 				"is_verified",
+				"is_approved",
 				"reputation",
 				"last_activity",
+				"deletion_scheduled_at",
 			},
 		).
 		Where("id = ?", ID).
@@ -79,13 +92,12 @@ func (c *PostgresClient) SelectUserBySlug(slug string) (*User, error) {
 			[]string{
 				"id",
 				"slug",
+				"slug_skeleton",
 				"name",
 				"description",
 				"email",
 				"password",
 				"salt",
-				"verification_token",
-				"reset_token",
 				"is_verified",
 				"reputation",
 				"last_activity",
@@ -112,8 +124,6 @@ func (c *PostgresClient) SelectUserByName(name string) (*User, error) {
 				"email",
 				"password",
 				"salt",
-				"verification_token",
-				"reset_token",
 				"is_verified",
 				"reputation",
 				"last_activity",
@@ -129,6 +139,7 @@ func (c *PostgresClient) SelectUserByName(name string) (*User, error) {
 	return &user, nil
 }
 
+// SelectUserByEmail compares case-insensitively, older rows may be mixed case.
 func (c *PostgresClient) SelectUserByEmail(email string) (*User, error) {
 	var user User
 	tx := c.database.
@@ -141,14 +152,14 @@ func (c *PostgresClient) SelectUserByEmail(email string) (*User, error) {
 				"email",
 				"password",
 				"salt",
-				"verification_token",
-				"reset_token",
 				"is_verified",
+				"is_approved",
 				"reputation",
 				"last_activity",
+				"deletion_scheduled_at",
 			},
 		).
-		Where("email = ?", email).
+		Where("LOWER(email) = LOWER(?)", email).
 		First(&user)
 
 	if tx.Error != nil {
@@ -158,73 +169,222 @@ func (c *PostgresClient) SelectUserByEmail(email string) (*User, error) {
 	return &user, nil
 }
 
-func (c *PostgresClient) SelectUserByVerificationToken(verificationToken string) (*User, error) {
-	var user User
-	tx := c.database.
-		Select(
-			[]string{
-				"id",
-				"slug",
-				"name",
-				"description",
-				"email",
-				"password",
-				"salt",
-				"verification_token",
-				"reset_token",
-				"is_verified",
-				"reputation",
-				"last_activity",
-			},
-		).
-		Where("verification_token = ?", verificationToken).
-		First(&user)
+// InsertUser creates the user. Returns ErrUserSlugTaken if the slug
+// skeleton is used by, or redirects to, another user.
+func (c *PostgresClient) InsertUser(user *User) error {
+	return c.database.Transaction(func(tx *gorm.DB) error {
+		err := claimUserSlug(tx, user.SlugSkeleton, "")
+		if err != nil {
+			return err
+		}
+		return tx.Create(user).Error
+	})
+}
 
+// UpdateUser saves the user. Reputation is maintained by the reputation
+// ledger and never overwritten here.
+func (c *PostgresClient) UpdateUser(user *User) error {
+	tx := c.database.Model(user).Omit("Reputation").Updates(user)
+	return tx.Error
+}
+
+// SelectUserSlugTaken reports whether a slug with the given skeleton is used
+// by, or redirects to, a user other than exceptUserID.
+func (c *PostgresClient) SelectUserSlugTaken(slugSkeleton string, exceptUserID string) (bool, error) {
+	return userSlugTaken(c.database, slugSkeleton, exceptUserID)
+}
+
+// claimUserSlug locks the slug skeleton until the transaction ends and
+// returns ErrUserSlugTaken if it is taken by a user other than exceptUserID.
+// Skeletons are unique across users and redirects, which no index can
+// enforce, so every write of a slug claims it first.
+func claimUserSlug(tx *gorm.DB, slugSkeleton string, exceptUserID string) error {
+	err := tx.Exec("SELECT pg_advisory_xact_lock(?::int, hashtext(?))", USER_SLUG_LOCK_ID, slugSkeleton).Error
+	if err != nil {
+		return err
+	}
+
+	taken, err := userSlugTaken(tx, slugSkeleton, exceptUserID)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return ErrUserSlugTaken
+	}
+	return nil
+}
+
+func userSlugTaken(db *gorm.DB, slugSkeleton string, exceptUserID string) (bool, error) {
+	var count int64
+	query := db.
+		Model(&User{}).
+		Where("slug_skeleton = ?", slugSkeleton)
+	if exceptUserID != "" {
+		query = query.Where("id <> ?", exceptUserID)
+	}
+	tx := query.Count(&count)
 	if tx.Error != nil {
-		return nil, tx.Error
+		return false, tx.Error
+	}
+	if count > 0 {
+		return true, nil
 	}
 
-	return &user, nil
+	query = db.
+		Model(&UserSlugRedirect{}).
+		Where("slug_skeleton = ?", slugSkeleton)
+	if exceptUserID != "" {
+		query = query.Where("user_id <> ?", exceptUserID)
+	}
+	tx = query.Count(&count)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	return count > 0, nil
 }
 
-func (c *PostgresClient) SelectUserByResetToken(resetToken string) (*User, error) {
-	var user User
+// UpdateUserSlug changes the user's slug and keeps the old one as a
+// redirect. A user taking back one of their own old slugs drops its redirect.
+// Returns ErrUserSlugTaken if the slug skeleton is used by, or redirects to,
+// another user.
+func (c *PostgresClient) UpdateUserSlug(user *User, slug string, slugSkeleton string) error {
+	return c.database.Transaction(func(tx *gorm.DB) error {
+		err := claimUserSlug(tx, slugSkeleton, user.ID.String())
+		if err != nil {
+			return err
+		}
+
+		err = tx.
+			Where("slug = ? AND user_id = ?", slug, user.ID).
+			Delete(&UserSlugRedirect{}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&UserSlugRedirect{
+			Slug:         user.Slug,
+			SlugSkeleton: user.SlugSkeleton,
+			UserID:       user.ID,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.
+			Model(&User{}).
+			Where("id = ?", user.ID).
+			Updates(map[string]interface{}{
+				"slug":            slug,
+				"slug_skeleton":   slugSkeleton,
+				"slug_changed_at": time.Now(),
+			}).
+			Error
+	})
+}
+
+// UpdateUserPassword stores a new encoded password hash. The salt column is
+// cleared because argon2id hashes carry their own salt.
+func (c *PostgresClient) UpdateUserPassword(userID string, password string) error {
+	tx := c.database.
+		Model(&User{}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"password": password,
+			"salt":     "",
+		})
+	return tx.Error
+}
+
+// ScheduleUserDeletion marks the account for deletion at scheduledAt, see
+// DeleteUserAccount.
+func (c *PostgresClient) ScheduleUserDeletion(userID string, scheduledAt time.Time) error {
+	tx := c.database.
+		Model(&User{}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"deletion_requested_at": time.Now(),
+			"deletion_scheduled_at": scheduledAt,
+		})
+	return tx.Error
+}
+
+func (c *PostgresClient) CancelUserDeletion(userID string) error {
 	tx := c.database.
+		Model(&User{}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"deletion_requested_at": nil,
+			"deletion_scheduled_at": nil,
+		})
+	return tx.Error
+}
+
+func (c *PostgresClient) UpdateUserVerified(userID string) error {
+	tx := c.database.
+		Model(&User{}).
+		Where("id = ?", userID).
+		Update("is_verified", true)
+	return tx.Error
+}
+
+// SelectPendingUsersWithPagination lists accounts waiting for approval,
+// oldest first.
+func (c *PostgresClient) SelectPendingUsersWithPagination(limit int, cursor string) ([]*User, lib.PageInfo, error) {
+	query := c.database.
 		Select(
 			[]string{
 				"id",
 				"slug",
 				"name",
-				"description",
 				"email",
-				"password",
-				"salt",
-				"verification_token",
-				"reset_token",
-				"reset_token_expires_at",
 				"is_verified",
-				"reputation",
-				"last_activity",
+				"invite_code_id",
+				"created_at",
 			},
 		).
-		Where("reset_token = ?", resetToken).
-		First(&user)
+		Where("is_approved = ?", false)
+
+	return lib.Paginate(query, OldestFirst, func(user *User) []interface{} {
+		return createdAtAndID(user.CreatedAt, user.ID)
+	}, cursor, limit)
+}
+
+// ApproveUser lets a pending account log in. Returns gorm.ErrRecordNotFound
+// if the account does not exist or is not pending.
+func (c *PostgresClient) ApproveUser(userID string) error {
+	tx := c.database.
+		Model(&User{}).
+		Where("id = ? AND is_approved = ?", userID, false).
+		Update("is_approved", true)
 
 	if tx.Error != nil {
-		return nil, tx.Error
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
 	}
 
-	return &user, nil
+	return nil
 }
 
-func (c *PostgresClient) InsertUser(user *User) error {
-	tx := c.database.Create(user)
-	return tx.Error
-}
+// DeletePendingUser removes a rejected registration, freeing its email and
+// slug. A pending account owns no content, tokens and sessions cascade.
+// Returns gorm.ErrRecordNotFound if the account is not pending.
+func (c *PostgresClient) DeletePendingUser(userID string) error {
+	tx := c.database.
+		Where("id = ? AND is_approved = ?", userID, false).
+		Delete(&User{})
 
-func (c *PostgresClient) UpdateUser(user *User) error {
-	tx := c.database.Model(user).Updates(user)
-	return tx.Error
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
 }
 
 func (c *PostgresClient) CountPostLikesByAuthor(authorID uuid.UUID) (int64, error) {
@@ -244,4 +404,3 @@ func (c *PostgresClient) CountCommentLikesByAuthor(authorID uuid.UUID) (int64, e
 		Count(&count)
 	return count, tx.Error
 }
-
@@ -0,0 +1,32 @@
+package orm
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UserActivity marks an hour in which the user logged in or refreshed a
+// session. Rows are only ever added, unlike sessions, so recomputing a past
+// statistics bucket finds the same active users.
+type UserActivity struct {
+	UserID uuid.UUID `gorm:"primaryKey"`
+	Hour   time.Time `gorm:"primaryKey"` // start of the hour in UTC
+}
+
+func (a *UserActivity) TableName() string {
+	return "user_activity"
+}
+
+// recordUserActivity marks the hour of at as active for the user.
+func recordUserActivity(tx *gorm.DB, userID uuid.UUID, at time.Time) error {
+	return tx.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&UserActivity{
+			UserID: userID,
+			Hour:   at.UTC().Truncate(time.Hour),
+		}).
+		Error
+}
@@ -14,3 +14,46 @@ func (UserRole) TableName() string {
 func (c *PostgresClient) DeleteUserRole(userRole *UserRole) error {
 	return c.database.Delete(userRole).Error
 }
+
+// SelectUserHasPlatformPermission reports whether the user is the platform
+// owner or holds a platform role granting the given permission.
+func (c *PostgresClient) SelectUserHasPlatformPermission(userID string, permission string) (bool, error) {
+	var count int64
+	tx := c.database.
+		Model(&PlatformSetting{}).
+		Where("id = ? AND platform_owner_id = ?", 1, userID).
+		Count(&count)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+	if count > 0 {
+		return true, nil
+	}
+
+	tx = c.database.
+		Model(&UserRole{}).
+		Joins("JOIN roles ON roles.id = user_roles.role_id").
+		Where("user_roles.user_id = ?", userID).
+		Where("roles.community_id IS NULL").
+		Where("(roles.permissions ->> ?) = 'true'", permission).
+		Count(&count)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	return count > 0, nil
+}
+
+func (c *PostgresClient) SelectRolesByUserID(userID string) ([]*Role, error) {
+	var roles []*Role
+	tx := c.database.
+		Where("id IN (?)", c.database.Model(&UserRole{}).Select("role_id").Where("user_id = ?", userID)).
+		Order("created_at").
+		Find(&roles)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return roles, nil
+}
@@ -0,0 +1,33 @@
+package orm
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserSlugRedirect keeps a user's previous slug pointing at them after a
+// slug change, and stops anyone else from taking it.
+type UserSlugRedirect struct {
+	Slug         string `gorm:"primaryKey"`
+	SlugSkeleton string
+	UserID       uuid.UUID
+	CreatedAt    time.Time
+}
+
+func (c *UserSlugRedirect) TableName() string {
+	return "user_slug_redirect"
+}
+
+func (c *PostgresClient) SelectUserSlugRedirect(slug string) (*UserSlugRedirect, error) {
+	var redirect UserSlugRedirect
+	tx := c.database.
+		Where("slug = ?", slug).
+		First(&redirect)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &redirect, nil
+}
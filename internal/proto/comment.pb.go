@@ -305,6 +305,7 @@ func (*UpdateCommentResponse) Descriptor() ([]byte, []int) {
 type DeleteCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // Shown to moderators, optional
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -346,6 +347,13 @@ func (x *DeleteCommentRequest) GetCommentId() string {
 	return ""
 }
 
+func (x *DeleteCommentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type DeleteCommentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -382,6 +390,86 @@ func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
 	return file_comment_proto_rawDescGZIP(), []int{7}
 }
 
+type RestoreCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCommentRequest) Reset() {
+	*x = RestoreCommentRequest{}
+	mi := &file_comment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCommentRequest) ProtoMessage() {}
+
+func (x *RestoreCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCommentRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RestoreCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+type RestoreCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCommentResponse) Reset() {
+	*x = RestoreCommentResponse{}
+	mi := &file_comment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCommentResponse) ProtoMessage() {}
+
+func (x *RestoreCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCommentResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCommentResponse) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{9}
+}
+
 type LikeCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
@@ -391,7 +479,7 @@ type LikeCommentRequest struct {
 
 func (x *LikeCommentRequest) Reset() {
 	*x = LikeCommentRequest{}
-	mi := &file_comment_proto_msgTypes[8]
+	mi := &file_comment_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +491,7 @@ func (x *LikeCommentRequest) String() string {
 func (*LikeCommentRequest) ProtoMessage() {}
 
 func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_proto_msgTypes[8]
+	mi := &file_comment_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +504,7 @@ func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeCommentRequest.ProtoReflect.Descriptor instead.
 func (*LikeCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_proto_rawDescGZIP(), []int{8}
+	return file_comment_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *LikeCommentRequest) GetCommentId() string {
@@ -435,7 +523,7 @@ type LikeCommentResponse struct {
 
 func (x *LikeCommentResponse) Reset() {
 	*x = LikeCommentResponse{}
-	mi := &file_comment_proto_msgTypes[9]
+	mi := &file_comment_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -447,7 +535,7 @@ func (x *LikeCommentResponse) String() string {
 func (*LikeCommentResponse) ProtoMessage() {}
 
 func (x *LikeCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_proto_msgTypes[9]
+	mi := &file_comment_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,7 +548,7 @@ func (x *LikeCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeCommentResponse.ProtoReflect.Descriptor instead.
 func (*LikeCommentResponse) Descriptor() ([]byte, []int) {
-	return file_comment_proto_rawDescGZIP(), []int{9}
+	return file_comment_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *LikeCommentResponse) GetNewLikeCount() int32 {
@@ -479,7 +567,7 @@ type UnlikeCommentRequest struct {
 
 func (x *UnlikeCommentRequest) Reset() {
 	*x = UnlikeCommentRequest{}
-	mi := &file_comment_proto_msgTypes[10]
+	mi := &file_comment_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -491,7 +579,7 @@ func (x *UnlikeCommentRequest) String() string {
 func (*UnlikeCommentRequest) ProtoMessage() {}
 
 func (x *UnlikeCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_proto_msgTypes[10]
+	mi := &file_comment_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,7 +592,7 @@ func (x *UnlikeCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnlikeCommentRequest.ProtoReflect.Descriptor instead.
 func (*UnlikeCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_proto_rawDescGZIP(), []int{10}
+	return file_comment_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UnlikeCommentRequest) GetCommentId() string {
@@ -523,7 +611,7 @@ type UnlikeCommentResponse struct {
 
 func (x *UnlikeCommentResponse) Reset() {
 	*x = UnlikeCommentResponse{}
-	mi := &file_comment_proto_msgTypes[11]
+	mi := &file_comment_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -535,7 +623,7 @@ func (x *UnlikeCommentResponse) String() string {
 func (*UnlikeCommentResponse) ProtoMessage() {}
 
 func (x *UnlikeCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_proto_msgTypes[11]
+	mi := &file_comment_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,7 +636,7 @@ func (x *UnlikeCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnlikeCommentResponse.ProtoReflect.Descriptor instead.
 func (*UnlikeCommentResponse) Descriptor() ([]byte, []int) {
-	return file_comment_proto_rawDescGZIP(), []int{11}
+	return file_comment_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UnlikeCommentResponse) GetNewLikeCount() int32 {
@@ -567,7 +655,7 @@ type StreamCommentRequest struct {
 
 func (x *StreamCommentRequest) Reset() {
 	*x = StreamCommentRequest{}
-	mi := &file_comment_proto_msgTypes[12]
+	mi := &file_comment_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -579,7 +667,7 @@ func (x *StreamCommentRequest) String() string {
 func (*StreamCommentRequest) ProtoMessage() {}
 
 func (x *StreamCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_proto_msgTypes[12]
+	mi := &file_comment_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,7 +680,7 @@ func (x *StreamCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamCommentRequest.ProtoReflect.Descriptor instead.
 func (*StreamCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_proto_rawDescGZIP(), []int{12}
+	return file_comment_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StreamCommentRequest) GetPostId() string {
@@ -622,11 +710,16 @@ const file_comment_proto_rawDesc = "" +
 	"\n" +
 	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\"\x17\n" +
-	"\x15UpdateCommentResponse\"5\n" +
+	"\x15UpdateCommentResponse\"M\n" +
 	"\x14DeleteCommentRequest\x12\x1d\n" +
 	"\n" +
-	"comment_id\x18\x01 \x01(\tR\tcommentId\"\x17\n" +
-	"\x15DeleteCommentResponse\"3\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x17\n" +
+	"\x15DeleteCommentResponse\"6\n" +
+	"\x15RestoreCommentRequest\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\"\x18\n" +
+	"\x16RestoreCommentResponse\"3\n" +
 	"\x12LikeCommentRequest\x12\x1d\n" +
 	"\n" +
 	"comment_id\x18\x01 \x01(\tR\tcommentId\";\n" +
@@ -640,12 +733,13 @@ const file_comment_proto_rawDesc = "" +
 	"\x14StreamCommentRequest\x12\x1c\n" +
 	"\apost_id\x18\x01 \x01(\tH\x00R\x06postId\x88\x01\x01B\n" +
 	"\n" +
-	"\b_post_id2\xa2\x05\n" +
+	"\b_post_id2\x92\x06\n" +
 	"\x0eCommentService\x12Y\n" +
 	"\x06Create\x12\x1b.proto.CreateCommentRequest\x1a\x1c.proto.CreateCommentResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/comments\x12Z\n" +
 	"\x03Get\x12\x18.proto.GetCommentRequest\x1a\x19.proto.GetCommentResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/comments/{comment_id}\x12f\n" +
 	"\x06Update\x12\x1b.proto.UpdateCommentRequest\x1a\x1c.proto.UpdateCommentResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*2\x16/comments/{comment_id}\x12c\n" +
-	"\x06Delete\x12\x1b.proto.DeleteCommentRequest\x1a\x1c.proto.DeleteCommentResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/comments/{comment_id}\x12b\n" +
+	"\x06Delete\x12\x1b.proto.DeleteCommentRequest\x1a\x1c.proto.DeleteCommentResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/comments/{comment_id}\x12n\n" +
+	"\aRestore\x12\x1c.proto.RestoreCommentRequest\x1a\x1d.proto.RestoreCommentResponse\"&\x82\xd3\xe4\x93\x02 \"\x1e/comments/{comment_id}/restore\x12b\n" +
 	"\x04Like\x12\x19.proto.LikeCommentRequest\x1a\x1a.proto.LikeCommentResponse\"#\x82\xd3\xe4\x93\x02\x1d\"\x1b/comments/{comment_id}/like\x12h\n" +
 	"\x06Unlike\x12\x1b.proto.UnlikeCommentRequest\x1a\x1c.proto.UnlikeCommentResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/comments/{comment_id}/like\x12>\n" +
 	"\x06Stream\x12\x1b.proto.StreamCommentRequest\x1a\x13.proto.CommentEvent\"\x000\x01B\bZ\x06/protob\x06proto3"
@@ -662,42 +756,46 @@ func file_comment_proto_rawDescGZIP() []byte {
 	return file_comment_proto_rawDescData
 }
 
-var file_comment_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_comment_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_comment_proto_goTypes = []any{
-	(*CreateCommentRequest)(nil),  // 0: proto.CreateCommentRequest
-	(*CreateCommentResponse)(nil), // 1: proto.CreateCommentResponse
-	(*GetCommentRequest)(nil),     // 2: proto.GetCommentRequest
-	(*GetCommentResponse)(nil),    // 3: proto.GetCommentResponse
-	(*UpdateCommentRequest)(nil),  // 4: proto.UpdateCommentRequest
-	(*UpdateCommentResponse)(nil), // 5: proto.UpdateCommentResponse
-	(*DeleteCommentRequest)(nil),  // 6: proto.DeleteCommentRequest
-	(*DeleteCommentResponse)(nil), // 7: proto.DeleteCommentResponse
-	(*LikeCommentRequest)(nil),    // 8: proto.LikeCommentRequest
-	(*LikeCommentResponse)(nil),   // 9: proto.LikeCommentResponse
-	(*UnlikeCommentRequest)(nil),  // 10: proto.UnlikeCommentRequest
-	(*UnlikeCommentResponse)(nil), // 11: proto.UnlikeCommentResponse
-	(*StreamCommentRequest)(nil),  // 12: proto.StreamCommentRequest
-	(*Comment)(nil),               // 13: proto.Comment
-	(*CommentEvent)(nil),          // 14: proto.CommentEvent
+	(*CreateCommentRequest)(nil),   // 0: proto.CreateCommentRequest
+	(*CreateCommentResponse)(nil),  // 1: proto.CreateCommentResponse
+	(*GetCommentRequest)(nil),      // 2: proto.GetCommentRequest
+	(*GetCommentResponse)(nil),     // 3: proto.GetCommentResponse
+	(*UpdateCommentRequest)(nil),   // 4: proto.UpdateCommentRequest
+	(*UpdateCommentResponse)(nil),  // 5: proto.UpdateCommentResponse
+	(*DeleteCommentRequest)(nil),   // 6: proto.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),  // 7: proto.DeleteCommentResponse
+	(*RestoreCommentRequest)(nil),  // 8: proto.RestoreCommentRequest
+	(*RestoreCommentResponse)(nil), // 9: proto.RestoreCommentResponse
+	(*LikeCommentRequest)(nil),     // 10: proto.LikeCommentRequest
+	(*LikeCommentResponse)(nil),    // 11: proto.LikeCommentResponse
+	(*UnlikeCommentRequest)(nil),   // 12: proto.UnlikeCommentRequest
+	(*UnlikeCommentResponse)(nil),  // 13: proto.UnlikeCommentResponse
+	(*StreamCommentRequest)(nil),   // 14: proto.StreamCommentRequest
+	(*Comment)(nil),                // 15: proto.Comment
+	(*CommentEvent)(nil),           // 16: proto.CommentEvent
 }
 var file_comment_proto_depIdxs = []int32{
-	13, // 0: proto.GetCommentResponse.comment:type_name -> proto.Comment
+	15, // 0: proto.GetCommentResponse.comment:type_name -> proto.Comment
 	0,  // 1: proto.CommentService.Create:input_type -> proto.CreateCommentRequest
 	2,  // 2: proto.CommentService.Get:input_type -> proto.GetCommentRequest
 	4,  // 3: proto.CommentService.Update:input_type -> proto.UpdateCommentRequest
 	6,  // 4: proto.CommentService.Delete:input_type -> proto.DeleteCommentRequest
-	8,  // 5: proto.CommentService.Like:input_type -> proto.LikeCommentRequest
-	10, // 6: proto.CommentService.Unlike:input_type -> proto.UnlikeCommentRequest
-	12, // 7: proto.CommentService.Stream:input_type -> proto.StreamCommentRequest
-	1,  // 8: proto.CommentService.Create:output_type -> proto.CreateCommentResponse
-	3,  // 9: proto.CommentService.Get:output_type -> proto.GetCommentResponse
-	5,  // 10: proto.CommentService.Update:output_type -> proto.UpdateCommentResponse
-	7,  // 11: proto.CommentService.Delete:output_type -> proto.DeleteCommentResponse
-	9,  // 12: proto.CommentService.Like:output_type -> proto.LikeCommentResponse
-	11, // 13: proto.CommentService.Unlike:output_type -> proto.UnlikeCommentResponse
-	14, // 14: proto.CommentService.Stream:output_type -> proto.CommentEvent
-	8,  // [8:15] is the sub-list for method output_type
-	1,  // [1:8] is the sub-list for method input_type
+	8,  // 5: proto.CommentService.Restore:input_type -> proto.RestoreCommentRequest
+	10, // 6: proto.CommentService.Like:input_type -> proto.LikeCommentRequest
+	12, // 7: proto.CommentService.Unlike:input_type -> proto.UnlikeCommentRequest
+	14, // 8: proto.CommentService.Stream:input_type -> proto.StreamCommentRequest
+	1,  // 9: proto.CommentService.Create:output_type -> proto.CreateCommentResponse
+	3,  // 10: proto.CommentService.Get:output_type -> proto.GetCommentResponse
+	5,  // 11: proto.CommentService.Update:output_type -> proto.UpdateCommentResponse
+	7,  // 12: proto.CommentService.Delete:output_type -> proto.DeleteCommentResponse
+	9,  // 13: proto.CommentService.Restore:output_type -> proto.RestoreCommentResponse
+	11, // 14: proto.CommentService.Like:output_type -> proto.LikeCommentResponse
+	13, // 15: proto.CommentService.Unlike:output_type -> proto.UnlikeCommentResponse
+	16, // 16: proto.CommentService.Stream:output_type -> proto.CommentEvent
+	9,  // [9:17] is the sub-list for method output_type
+	1,  // [1:9] is the sub-list for method input_type
 	1,  // [1:1] is the sub-list for extension type_name
 	1,  // [1:1] is the sub-list for extension extendee
 	0,  // [0:1] is the sub-list for field type_name
@@ -709,14 +807,14 @@ func file_comment_proto_init() {
 		return
 	}
 	file_entity_proto_init()
-	file_comment_proto_msgTypes[12].OneofWrappers = []any{}
+	file_comment_proto_msgTypes[14].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_comment_proto_rawDesc), len(file_comment_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
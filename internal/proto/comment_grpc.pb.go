@@ -19,13 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CommentService_Create_FullMethodName = "/proto.CommentService/Create"
-	CommentService_Get_FullMethodName    = "/proto.CommentService/Get"
-	CommentService_Update_FullMethodName = "/proto.CommentService/Update"
-	CommentService_Delete_FullMethodName = "/proto.CommentService/Delete"
-	CommentService_Like_FullMethodName   = "/proto.CommentService/Like"
-	CommentService_Unlike_FullMethodName = "/proto.CommentService/Unlike"
-	CommentService_Stream_FullMethodName = "/proto.CommentService/Stream"
+	CommentService_Create_FullMethodName  = "/proto.CommentService/Create"
+	CommentService_Get_FullMethodName     = "/proto.CommentService/Get"
+	CommentService_Update_FullMethodName  = "/proto.CommentService/Update"
+	CommentService_Delete_FullMethodName  = "/proto.CommentService/Delete"
+	CommentService_Restore_FullMethodName = "/proto.CommentService/Restore"
+	CommentService_Like_FullMethodName    = "/proto.CommentService/Like"
+	CommentService_Unlike_FullMethodName  = "/proto.CommentService/Unlike"
+	CommentService_Stream_FullMethodName  = "/proto.CommentService/Stream"
 )
 
 // CommentServiceClient is the client API for CommentService service.
@@ -37,6 +38,7 @@ type CommentServiceClient interface {
 	Get(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*GetCommentResponse, error)
 	Update(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error)
 	Delete(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	Restore(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (*RestoreCommentResponse, error)
 	// Like Operations
 	Like(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error)
 	Unlike(ctx context.Context, in *UnlikeCommentRequest, opts ...grpc.CallOption) (*UnlikeCommentResponse, error)
@@ -92,6 +94,16 @@ func (c *commentServiceClient) Delete(ctx context.Context, in *DeleteCommentRequ
 	return out, nil
 }
 
+func (c *commentServiceClient) Restore(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (*RestoreCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCommentResponse)
+	err := c.cc.Invoke(ctx, CommentService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commentServiceClient) Like(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LikeCommentResponse)
@@ -140,6 +152,7 @@ type CommentServiceServer interface {
 	Get(context.Context, *GetCommentRequest) (*GetCommentResponse, error)
 	Update(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error)
 	Delete(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	Restore(context.Context, *RestoreCommentRequest) (*RestoreCommentResponse, error)
 	// Like Operations
 	Like(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error)
 	Unlike(context.Context, *UnlikeCommentRequest) (*UnlikeCommentResponse, error)
@@ -167,6 +180,9 @@ func (UnimplementedCommentServiceServer) Update(context.Context, *UpdateCommentR
 func (UnimplementedCommentServiceServer) Delete(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedCommentServiceServer) Restore(context.Context, *RestoreCommentRequest) (*RestoreCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
 func (UnimplementedCommentServiceServer) Like(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Like not implemented")
 }
@@ -269,6 +285,24 @@ func _CommentService_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).Restore(ctx, req.(*RestoreCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CommentService_Like_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LikeCommentRequest)
 	if err := dec(in); err != nil {
@@ -339,6 +373,10 @@ var CommentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _CommentService_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _CommentService_Restore_Handler,
+		},
 		{
 			MethodName: "Like",
 			Handler:    _CommentService_Like_Handler,
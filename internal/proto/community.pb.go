@@ -417,6 +417,7 @@ func (x *UpdateCommunityResponse) GetCommunity() *Community {
 type DeleteCommunityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // Shown to moderators, optional
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -458,6 +459,13 @@ func (x *DeleteCommunityRequest) GetCommunityId() string {
 	return ""
 }
 
+func (x *DeleteCommunityRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type DeleteCommunityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -494,6 +502,86 @@ func (*DeleteCommunityResponse) Descriptor() ([]byte, []int) {
 	return file_community_proto_rawDescGZIP(), []int{9}
 }
 
+type RestoreCommunityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCommunityRequest) Reset() {
+	*x = RestoreCommunityRequest{}
+	mi := &file_community_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCommunityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCommunityRequest) ProtoMessage() {}
+
+func (x *RestoreCommunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_community_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCommunityRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCommunityRequest) Descriptor() ([]byte, []int) {
+	return file_community_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreCommunityRequest) GetCommunityId() string {
+	if x != nil {
+		return x.CommunityId
+	}
+	return ""
+}
+
+type RestoreCommunityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCommunityResponse) Reset() {
+	*x = RestoreCommunityResponse{}
+	mi := &file_community_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCommunityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCommunityResponse) ProtoMessage() {}
+
+func (x *RestoreCommunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_community_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCommunityResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCommunityResponse) Descriptor() ([]byte, []int) {
+	return file_community_proto_rawDescGZIP(), []int{11}
+}
+
 type ListCommunitiesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
@@ -504,7 +592,7 @@ type ListCommunitiesRequest struct {
 
 func (x *ListCommunitiesRequest) Reset() {
 	*x = ListCommunitiesRequest{}
-	mi := &file_community_proto_msgTypes[10]
+	mi := &file_community_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -516,7 +604,7 @@ func (x *ListCommunitiesRequest) String() string {
 func (*ListCommunitiesRequest) ProtoMessage() {}
 
 func (x *ListCommunitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_community_proto_msgTypes[10]
+	mi := &file_community_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,7 +617,7 @@ func (x *ListCommunitiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCommunitiesRequest.ProtoReflect.Descriptor instead.
 func (*ListCommunitiesRequest) Descriptor() ([]byte, []int) {
-	return file_community_proto_rawDescGZIP(), []int{10}
+	return file_community_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListCommunitiesRequest) GetCursor() string {
@@ -551,13 +639,14 @@ type ListCommunitiesResponse struct {
 	Communities   []*Community           `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities,omitempty"`
 	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,4,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCommunitiesResponse) Reset() {
 	*x = ListCommunitiesResponse{}
-	mi := &file_community_proto_msgTypes[11]
+	mi := &file_community_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -569,7 +658,7 @@ func (x *ListCommunitiesResponse) String() string {
 func (*ListCommunitiesResponse) ProtoMessage() {}
 
 func (x *ListCommunitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_community_proto_msgTypes[11]
+	mi := &file_community_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +671,7 @@ func (x *ListCommunitiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCommunitiesResponse.ProtoReflect.Descriptor instead.
 func (*ListCommunitiesResponse) Descriptor() ([]byte, []int) {
-	return file_community_proto_rawDescGZIP(), []int{11}
+	return file_community_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListCommunitiesResponse) GetCommunities() []*Community {
@@ -606,6 +695,13 @@ func (x *ListCommunitiesResponse) GetHasMore() bool {
 	return false
 }
 
+func (x *ListCommunitiesResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
 type JoinCommunityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
@@ -615,7 +711,7 @@ type JoinCommunityRequest struct {
 
 func (x *JoinCommunityRequest) Reset() {
 	*x = JoinCommunityRequest{}
-	mi := &file_community_proto_msgTypes[12]
+	mi := &file_community_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -627,7 +723,7 @@ func (x *JoinCommunityRequest) String() string {
 func (*JoinCommunityRequest) ProtoMessage() {}
 
 func (x *JoinCommunityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_community_proto_msgTypes[12]
+	mi := &file_community_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,7 +736,7 @@ func (x *JoinCommunityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinCommunityRequest.ProtoReflect.Descriptor instead.
 func (*JoinCommunityRequest) Descriptor() ([]byte, []int) {
-	return file_community_proto_rawDescGZIP(), []int{12}
+	return file_community_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *JoinCommunityRequest) GetCommunityId() string {
@@ -658,7 +754,7 @@ type JoinCommunityResponse struct {
 
 func (x *JoinCommunityResponse) Reset() {
 	*x = JoinCommunityResponse{}
-	mi := &file_community_proto_msgTypes[13]
+	mi := &file_community_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -670,7 +766,7 @@ func (x *JoinCommunityResponse) String() string {
 func (*JoinCommunityResponse) ProtoMessage() {}
 
 func (x *JoinCommunityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_community_proto_msgTypes[13]
+	mi := &file_community_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -683,7 +779,7 @@ func (x *JoinCommunityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinCommunityResponse.ProtoReflect.Descriptor instead.
 func (*JoinCommunityResponse) Descriptor() ([]byte, []int) {
-	return file_community_proto_rawDescGZIP(), []int{13}
+	return file_community_proto_rawDescGZIP(), []int{15}
 }
 
 type LeaveCommunityRequest struct {
@@ -695,7 +791,7 @@ type LeaveCommunityRequest struct {
 
 func (x *LeaveCommunityRequest) Reset() {
 	*x = LeaveCommunityRequest{}
-	mi := &file_community_proto_msgTypes[14]
+	mi := &file_community_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -707,7 +803,7 @@ func (x *LeaveCommunityRequest) String() string {
 func (*LeaveCommunityRequest) ProtoMessage() {}
 
 func (x *LeaveCommunityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_community_proto_msgTypes[14]
+	mi := &file_community_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,7 +816,7 @@ func (x *LeaveCommunityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveCommunityRequest.ProtoReflect.Descriptor instead.
 func (*LeaveCommunityRequest) Descriptor() ([]byte, []int) {
-	return file_community_proto_rawDescGZIP(), []int{14}
+	return file_community_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *LeaveCommunityRequest) GetCommunityId() string {
@@ -738,7 +834,7 @@ type LeaveCommunityResponse struct {
 
 func (x *LeaveCommunityResponse) Reset() {
 	*x = LeaveCommunityResponse{}
-	mi := &file_community_proto_msgTypes[15]
+	mi := &file_community_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -750,7 +846,7 @@ func (x *LeaveCommunityResponse) String() string {
 func (*LeaveCommunityResponse) ProtoMessage() {}
 
 func (x *LeaveCommunityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_community_proto_msgTypes[15]
+	mi := &file_community_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,7 +859,7 @@ func (x *LeaveCommunityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveCommunityResponse.ProtoReflect.Descriptor instead.
 func (*LeaveCommunityResponse) Descriptor() ([]byte, []int) {
-	return file_community_proto_rawDescGZIP(), []int{15}
+	return file_community_proto_rawDescGZIP(), []int{17}
 }
 
 type BanCommunityRequest struct {
@@ -776,7 +872,7 @@ type BanCommunityRequest struct {
 
 func (x *BanCommunityRequest) Reset() {
 	*x = BanCommunityRequest{}
-	mi := &file_community_proto_msgTypes[16]
+	mi := &file_community_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -788,7 +884,7 @@ func (x *BanCommunityRequest) String() string {
 func (*BanCommunityRequest) ProtoMessage() {}
 
 func (x *BanCommunityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_community_proto_msgTypes[16]
+	mi := &file_community_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -801,7 +897,7 @@ func (x *BanCommunityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BanCommunityRequest.ProtoReflect.Descriptor instead.
 func (*BanCommunityRequest) Descriptor() ([]byte, []int) {
-	return file_community_proto_rawDescGZIP(), []int{16}
+	return file_community_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *BanCommunityRequest) GetCommunityId() string {
@@ -826,7 +922,7 @@ type BanCommunityResponse struct {
 
 func (x *BanCommunityResponse) Reset() {
 	*x = BanCommunityResponse{}
-	mi := &file_community_proto_msgTypes[17]
+	mi := &file_community_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -838,7 +934,7 @@ func (x *BanCommunityResponse) String() string {
 func (*BanCommunityResponse) ProtoMessage() {}
 
 func (x *BanCommunityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_community_proto_msgTypes[17]
+	mi := &file_community_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -851,7 +947,7 @@ func (x *BanCommunityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BanCommunityResponse.ProtoReflect.Descriptor instead.
 func (*BanCommunityResponse) Descriptor() ([]byte, []int) {
-	return file_community_proto_rawDescGZIP(), []int{17}
+	return file_community_proto_rawDescGZIP(), []int{19}
 }
 
 type UnbanCommunityRequest struct {
@@ -863,7 +959,7 @@ type UnbanCommunityRequest struct {
 
 func (x *UnbanCommunityRequest) Reset() {
 	*x = UnbanCommunityRequest{}
-	mi := &file_community_proto_msgTypes[18]
+	mi := &file_community_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -875,7 +971,7 @@ func (x *UnbanCommunityRequest) String() string {
 func (*UnbanCommunityRequest) ProtoMessage() {}
 
 func (x *UnbanCommunityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_community_proto_msgTypes[18]
+	mi := &file_community_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,7 +984,7 @@ func (x *UnbanCommunityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnbanCommunityRequest.ProtoReflect.Descriptor instead.
 func (*UnbanCommunityRequest) Descriptor() ([]byte, []int) {
-	return file_community_proto_rawDescGZIP(), []int{18}
+	return file_community_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UnbanCommunityRequest) GetCommunityId() string {
@@ -906,7 +1002,7 @@ type UnbanCommunityResponse struct {
 
 func (x *UnbanCommunityResponse) Reset() {
 	*x = UnbanCommunityResponse{}
-	mi := &file_community_proto_msgTypes[19]
+	mi := &file_community_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -918,7 +1014,7 @@ func (x *UnbanCommunityResponse) String() string {
 func (*UnbanCommunityResponse) ProtoMessage() {}
 
 func (x *UnbanCommunityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_community_proto_msgTypes[19]
+	mi := &file_community_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -931,7 +1027,7 @@ func (x *UnbanCommunityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnbanCommunityResponse.ProtoReflect.Descriptor instead.
 func (*UnbanCommunityResponse) Descriptor() ([]byte, []int) {
-	return file_community_proto_rawDescGZIP(), []int{19}
+	return file_community_proto_rawDescGZIP(), []int{21}
 }
 
 type TransferCommunityOwnershipRequest struct {
@@ -944,7 +1040,7 @@ type TransferCommunityOwnershipRequest struct {
 
 func (x *TransferCommunityOwnershipRequest) Reset() {
 	*x = TransferCommunityOwnershipRequest{}
-	mi := &file_community_proto_msgTypes[20]
+	mi := &file_community_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -956,7 +1052,7 @@ func (x *TransferCommunityOwnershipRequest) String() string {
 func (*TransferCommunityOwnershipRequest) ProtoMessage() {}
 
 func (x *TransferCommunityOwnershipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_community_proto_msgTypes[20]
+	mi := &file_community_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -969,7 +1065,7 @@ func (x *TransferCommunityOwnershipRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use TransferCommunityOwnershipRequest.ProtoReflect.Descriptor instead.
 func (*TransferCommunityOwnershipRequest) Descriptor() ([]byte, []int) {
-	return file_community_proto_rawDescGZIP(), []int{20}
+	return file_community_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *TransferCommunityOwnershipRequest) GetCommunityId() string {
@@ -994,7 +1090,7 @@ type TransferCommunityOwnershipResponse struct {
 
 func (x *TransferCommunityOwnershipResponse) Reset() {
 	*x = TransferCommunityOwnershipResponse{}
-	mi := &file_community_proto_msgTypes[21]
+	mi := &file_community_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1006,7 +1102,7 @@ func (x *TransferCommunityOwnershipResponse) String() string {
 func (*TransferCommunityOwnershipResponse) ProtoMessage() {}
 
 func (x *TransferCommunityOwnershipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_community_proto_msgTypes[21]
+	mi := &file_community_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,7 +1115,7 @@ func (x *TransferCommunityOwnershipResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use TransferCommunityOwnershipResponse.ProtoReflect.Descriptor instead.
 func (*TransferCommunityOwnershipResponse) Descriptor() ([]byte, []int) {
-	return file_community_proto_rawDescGZIP(), []int{21}
+	return file_community_proto_rawDescGZIP(), []int{23}
 }
 
 var File_community_proto protoreflect.FileDescriptor
@@ -1050,18 +1146,24 @@ const file_community_proto_rawDesc = "" +
 	"\f_descriptionB\b\n" +
 	"\x06_rules\"I\n" +
 	"\x17UpdateCommunityResponse\x12.\n" +
-	"\tcommunity\x18\x01 \x01(\v2\x10.proto.CommunityR\tcommunity\";\n" +
+	"\tcommunity\x18\x01 \x01(\v2\x10.proto.CommunityR\tcommunity\"S\n" +
 	"\x16DeleteCommunityRequest\x12!\n" +
-	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\"\x19\n" +
-	"\x17DeleteCommunityResponse\"F\n" +
+	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x19\n" +
+	"\x17DeleteCommunityResponse\"<\n" +
+	"\x17RestoreCommunityRequest\x12!\n" +
+	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\"\x1a\n" +
+	"\x18RestoreCommunityResponse\"F\n" +
 	"\x16ListCommunitiesRequest\x12\x16\n" +
 	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x89\x01\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xaa\x01\n" +
 	"\x17ListCommunitiesResponse\x122\n" +
 	"\vcommunities\x18\x01 \x03(\v2\x10.proto.CommunityR\vcommunities\x12\x1f\n" +
 	"\vnext_cursor\x18\x02 \x01(\tR\n" +
 	"nextCursor\x12\x19\n" +
-	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"9\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vprev_cursor\x18\x04 \x01(\tR\n" +
+	"prevCursor\"9\n" +
 	"\x14JoinCommunityRequest\x12!\n" +
 	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\"\x17\n" +
 	"\x15JoinCommunityResponse\":\n" +
@@ -1079,14 +1181,14 @@ const file_community_proto_rawDesc = "" +
 	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\x12 \n" +
 	"\fnew_owner_id\x18\x02 \x01(\tR\n" +
 	"newOwnerId\"$\n" +
-	"\"TransferCommunityOwnershipResponse2\x8d\n" +
-	"\n" +
+	"\"TransferCommunityOwnershipResponse2\x86\v\n" +
 	"\x10CommunityService\x12\x89\x01\n" +
 	"\x15ValidateCommunitySlug\x12#.proto.ValidateCommunitySlugRequest\x1a$.proto.ValidateCommunitySlugResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/communities/validate-slug\x12`\n" +
 	"\x06Create\x12\x1d.proto.CreateCommunityRequest\x1a\x1e.proto.CreateCommunityResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/communities\x12c\n" +
 	"\x03Get\x12\x1a.proto.GetCommunityRequest\x1a\x1b.proto.GetCommunityResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/communities/{community_id}\x12o\n" +
 	"\x06Update\x12\x1d.proto.UpdateCommunityRequest\x1a\x1e.proto.UpdateCommunityResponse\"&\x82\xd3\xe4\x93\x02 :\x01*2\x1b/communities/{community_id}\x12l\n" +
-	"\x06Delete\x12\x1d.proto.DeleteCommunityRequest\x1a\x1e.proto.DeleteCommunityResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/communities/{community_id}\x12f\n" +
+	"\x06Delete\x12\x1d.proto.DeleteCommunityRequest\x1a\x1e.proto.DeleteCommunityResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/communities/{community_id}\x12w\n" +
+	"\aRestore\x12\x1e.proto.RestoreCommunityRequest\x1a\x1f.proto.RestoreCommunityResponse\"+\x82\xd3\xe4\x93\x02%\"#/communities/{community_id}/restore\x12f\n" +
 	"\x0fListCommunities\x12\x1d.proto.ListCommunitiesRequest\x1a\x1e.proto.ListCommunitiesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/communities\x12k\n" +
 	"\x04Join\x12\x1b.proto.JoinCommunityRequest\x1a\x1c.proto.JoinCommunityResponse\"(\x82\xd3\xe4\x93\x02\"\" /communities/{community_id}/join\x12o\n" +
 	"\x05Leave\x12\x1c.proto.LeaveCommunityRequest\x1a\x1d.proto.LeaveCommunityResponse\")\x82\xd3\xe4\x93\x02#\"!/communities/{community_id}/leave\x12j\n" +
@@ -1106,7 +1208,7 @@ func file_community_proto_rawDescGZIP() []byte {
 	return file_community_proto_rawDescData
 }
 
-var file_community_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_community_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_community_proto_goTypes = []any{
 	(*ValidateCommunitySlugRequest)(nil),       // 0: proto.ValidateCommunitySlugRequest
 	(*ValidateCommunitySlugResponse)(nil),      // 1: proto.ValidateCommunitySlugResponse
@@ -1118,49 +1220,53 @@ var file_community_proto_goTypes = []any{
 	(*UpdateCommunityResponse)(nil),            // 7: proto.UpdateCommunityResponse
 	(*DeleteCommunityRequest)(nil),             // 8: proto.DeleteCommunityRequest
 	(*DeleteCommunityResponse)(nil),            // 9: proto.DeleteCommunityResponse
-	(*ListCommunitiesRequest)(nil),             // 10: proto.ListCommunitiesRequest
-	(*ListCommunitiesResponse)(nil),            // 11: proto.ListCommunitiesResponse
-	(*JoinCommunityRequest)(nil),               // 12: proto.JoinCommunityRequest
-	(*JoinCommunityResponse)(nil),              // 13: proto.JoinCommunityResponse
-	(*LeaveCommunityRequest)(nil),              // 14: proto.LeaveCommunityRequest
-	(*LeaveCommunityResponse)(nil),             // 15: proto.LeaveCommunityResponse
-	(*BanCommunityRequest)(nil),                // 16: proto.BanCommunityRequest
-	(*BanCommunityResponse)(nil),               // 17: proto.BanCommunityResponse
-	(*UnbanCommunityRequest)(nil),              // 18: proto.UnbanCommunityRequest
-	(*UnbanCommunityResponse)(nil),             // 19: proto.UnbanCommunityResponse
-	(*TransferCommunityOwnershipRequest)(nil),  // 20: proto.TransferCommunityOwnershipRequest
-	(*TransferCommunityOwnershipResponse)(nil), // 21: proto.TransferCommunityOwnershipResponse
-	(*Community)(nil),                          // 22: proto.Community
+	(*RestoreCommunityRequest)(nil),            // 10: proto.RestoreCommunityRequest
+	(*RestoreCommunityResponse)(nil),           // 11: proto.RestoreCommunityResponse
+	(*ListCommunitiesRequest)(nil),             // 12: proto.ListCommunitiesRequest
+	(*ListCommunitiesResponse)(nil),            // 13: proto.ListCommunitiesResponse
+	(*JoinCommunityRequest)(nil),               // 14: proto.JoinCommunityRequest
+	(*JoinCommunityResponse)(nil),              // 15: proto.JoinCommunityResponse
+	(*LeaveCommunityRequest)(nil),              // 16: proto.LeaveCommunityRequest
+	(*LeaveCommunityResponse)(nil),             // 17: proto.LeaveCommunityResponse
+	(*BanCommunityRequest)(nil),                // 18: proto.BanCommunityRequest
+	(*BanCommunityResponse)(nil),               // 19: proto.BanCommunityResponse
+	(*UnbanCommunityRequest)(nil),              // 20: proto.UnbanCommunityRequest
+	(*UnbanCommunityResponse)(nil),             // 21: proto.UnbanCommunityResponse
+	(*TransferCommunityOwnershipRequest)(nil),  // 22: proto.TransferCommunityOwnershipRequest
+	(*TransferCommunityOwnershipResponse)(nil), // 23: proto.TransferCommunityOwnershipResponse
+	(*Community)(nil),                          // 24: proto.Community
 }
 var file_community_proto_depIdxs = []int32{
-	22, // 0: proto.CreateCommunityResponse.community:type_name -> proto.Community
-	22, // 1: proto.GetCommunityResponse.community:type_name -> proto.Community
-	22, // 2: proto.UpdateCommunityResponse.community:type_name -> proto.Community
-	22, // 3: proto.ListCommunitiesResponse.communities:type_name -> proto.Community
+	24, // 0: proto.CreateCommunityResponse.community:type_name -> proto.Community
+	24, // 1: proto.GetCommunityResponse.community:type_name -> proto.Community
+	24, // 2: proto.UpdateCommunityResponse.community:type_name -> proto.Community
+	24, // 3: proto.ListCommunitiesResponse.communities:type_name -> proto.Community
 	0,  // 4: proto.CommunityService.ValidateCommunitySlug:input_type -> proto.ValidateCommunitySlugRequest
 	2,  // 5: proto.CommunityService.Create:input_type -> proto.CreateCommunityRequest
 	4,  // 6: proto.CommunityService.Get:input_type -> proto.GetCommunityRequest
 	6,  // 7: proto.CommunityService.Update:input_type -> proto.UpdateCommunityRequest
 	8,  // 8: proto.CommunityService.Delete:input_type -> proto.DeleteCommunityRequest
-	10, // 9: proto.CommunityService.ListCommunities:input_type -> proto.ListCommunitiesRequest
-	12, // 10: proto.CommunityService.Join:input_type -> proto.JoinCommunityRequest
-	14, // 11: proto.CommunityService.Leave:input_type -> proto.LeaveCommunityRequest
-	16, // 12: proto.CommunityService.Ban:input_type -> proto.BanCommunityRequest
-	18, // 13: proto.CommunityService.Unban:input_type -> proto.UnbanCommunityRequest
-	20, // 14: proto.CommunityService.TransferOwnership:input_type -> proto.TransferCommunityOwnershipRequest
-	1,  // 15: proto.CommunityService.ValidateCommunitySlug:output_type -> proto.ValidateCommunitySlugResponse
-	3,  // 16: proto.CommunityService.Create:output_type -> proto.CreateCommunityResponse
-	5,  // 17: proto.CommunityService.Get:output_type -> proto.GetCommunityResponse
-	7,  // 18: proto.CommunityService.Update:output_type -> proto.UpdateCommunityResponse
-	9,  // 19: proto.CommunityService.Delete:output_type -> proto.DeleteCommunityResponse
-	11, // 20: proto.CommunityService.ListCommunities:output_type -> proto.ListCommunitiesResponse
-	13, // 21: proto.CommunityService.Join:output_type -> proto.JoinCommunityResponse
-	15, // 22: proto.CommunityService.Leave:output_type -> proto.LeaveCommunityResponse
-	17, // 23: proto.CommunityService.Ban:output_type -> proto.BanCommunityResponse
-	19, // 24: proto.CommunityService.Unban:output_type -> proto.UnbanCommunityResponse
-	21, // 25: proto.CommunityService.TransferOwnership:output_type -> proto.TransferCommunityOwnershipResponse
-	15, // [15:26] is the sub-list for method output_type
-	4,  // [4:15] is the sub-list for method input_type
+	10, // 9: proto.CommunityService.Restore:input_type -> proto.RestoreCommunityRequest
+	12, // 10: proto.CommunityService.ListCommunities:input_type -> proto.ListCommunitiesRequest
+	14, // 11: proto.CommunityService.Join:input_type -> proto.JoinCommunityRequest
+	16, // 12: proto.CommunityService.Leave:input_type -> proto.LeaveCommunityRequest
+	18, // 13: proto.CommunityService.Ban:input_type -> proto.BanCommunityRequest
+	20, // 14: proto.CommunityService.Unban:input_type -> proto.UnbanCommunityRequest
+	22, // 15: proto.CommunityService.TransferOwnership:input_type -> proto.TransferCommunityOwnershipRequest
+	1,  // 16: proto.CommunityService.ValidateCommunitySlug:output_type -> proto.ValidateCommunitySlugResponse
+	3,  // 17: proto.CommunityService.Create:output_type -> proto.CreateCommunityResponse
+	5,  // 18: proto.CommunityService.Get:output_type -> proto.GetCommunityResponse
+	7,  // 19: proto.CommunityService.Update:output_type -> proto.UpdateCommunityResponse
+	9,  // 20: proto.CommunityService.Delete:output_type -> proto.DeleteCommunityResponse
+	11, // 21: proto.CommunityService.Restore:output_type -> proto.RestoreCommunityResponse
+	13, // 22: proto.CommunityService.ListCommunities:output_type -> proto.ListCommunitiesResponse
+	15, // 23: proto.CommunityService.Join:output_type -> proto.JoinCommunityResponse
+	17, // 24: proto.CommunityService.Leave:output_type -> proto.LeaveCommunityResponse
+	19, // 25: proto.CommunityService.Ban:output_type -> proto.BanCommunityResponse
+	21, // 26: proto.CommunityService.Unban:output_type -> proto.UnbanCommunityResponse
+	23, // 27: proto.CommunityService.TransferOwnership:output_type -> proto.TransferCommunityOwnershipResponse
+	16, // [16:28] is the sub-list for method output_type
+	4,  // [4:16] is the sub-list for method input_type
 	4,  // [4:4] is the sub-list for extension type_name
 	4,  // [4:4] is the sub-list for extension extendee
 	0,  // [0:4] is the sub-list for field type_name
@@ -1179,7 +1285,7 @@ func file_community_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_community_proto_rawDesc), len(file_community_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,7 @@ const (
 	CommunityService_Get_FullMethodName                   = "/proto.CommunityService/Get"
 	CommunityService_Update_FullMethodName                = "/proto.CommunityService/Update"
 	CommunityService_Delete_FullMethodName                = "/proto.CommunityService/Delete"
+	CommunityService_Restore_FullMethodName               = "/proto.CommunityService/Restore"
 	CommunityService_ListCommunities_FullMethodName       = "/proto.CommunityService/ListCommunities"
 	CommunityService_Join_FullMethodName                  = "/proto.CommunityService/Join"
 	CommunityService_Leave_FullMethodName                 = "/proto.CommunityService/Leave"
@@ -42,6 +43,7 @@ type CommunityServiceClient interface {
 	Get(ctx context.Context, in *GetCommunityRequest, opts ...grpc.CallOption) (*GetCommunityResponse, error)
 	Update(ctx context.Context, in *UpdateCommunityRequest, opts ...grpc.CallOption) (*UpdateCommunityResponse, error)
 	Delete(ctx context.Context, in *DeleteCommunityRequest, opts ...grpc.CallOption) (*DeleteCommunityResponse, error)
+	Restore(ctx context.Context, in *RestoreCommunityRequest, opts ...grpc.CallOption) (*RestoreCommunityResponse, error)
 	// List Operations
 	ListCommunities(ctx context.Context, in *ListCommunitiesRequest, opts ...grpc.CallOption) (*ListCommunitiesResponse, error)
 	// Membership Operations
@@ -112,6 +114,16 @@ func (c *communityServiceClient) Delete(ctx context.Context, in *DeleteCommunity
 	return out, nil
 }
 
+func (c *communityServiceClient) Restore(ctx context.Context, in *RestoreCommunityRequest, opts ...grpc.CallOption) (*RestoreCommunityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCommunityResponse)
+	err := c.cc.Invoke(ctx, CommunityService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *communityServiceClient) ListCommunities(ctx context.Context, in *ListCommunitiesRequest, opts ...grpc.CallOption) (*ListCommunitiesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListCommunitiesResponse)
@@ -182,6 +194,7 @@ type CommunityServiceServer interface {
 	Get(context.Context, *GetCommunityRequest) (*GetCommunityResponse, error)
 	Update(context.Context, *UpdateCommunityRequest) (*UpdateCommunityResponse, error)
 	Delete(context.Context, *DeleteCommunityRequest) (*DeleteCommunityResponse, error)
+	Restore(context.Context, *RestoreCommunityRequest) (*RestoreCommunityResponse, error)
 	// List Operations
 	ListCommunities(context.Context, *ListCommunitiesRequest) (*ListCommunitiesResponse, error)
 	// Membership Operations
@@ -217,6 +230,9 @@ func (UnimplementedCommunityServiceServer) Update(context.Context, *UpdateCommun
 func (UnimplementedCommunityServiceServer) Delete(context.Context, *DeleteCommunityRequest) (*DeleteCommunityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedCommunityServiceServer) Restore(context.Context, *RestoreCommunityRequest) (*RestoreCommunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
 func (UnimplementedCommunityServiceServer) ListCommunities(context.Context, *ListCommunitiesRequest) (*ListCommunitiesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCommunities not implemented")
 }
@@ -346,6 +362,24 @@ func _CommunityService_Delete_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommunityService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCommunityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).Restore(ctx, req.(*RestoreCommunityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CommunityService_ListCommunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCommunitiesRequest)
 	if err := dec(in); err != nil {
@@ -481,6 +515,10 @@ var CommunityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _CommunityService_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _CommunityService_Restore_Handler,
+		},
 		{
 			MethodName: "ListCommunities",
 			Handler:    _CommunityService_ListCommunities_Handler,
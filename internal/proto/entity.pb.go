@@ -139,6 +139,7 @@ type Community struct {
 	IsBanned      bool                   `protobuf:"varint,11,opt,name=is_banned,json=isBanned,proto3" json:"is_banned,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"` // unset unless archived, see RequestAccountDeletion
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -264,6 +265,13 @@ func (x *Community) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Community) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
 type Post struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -495,6 +503,7 @@ type Comment struct {
 	IsEdited        bool                   `protobuf:"varint,11,opt,name=is_edited,json=isEdited,proto3" json:"is_edited,omitempty"`
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	IsDeleted       bool                   `protobuf:"varint,14,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"` // Placeholder of a deleted comment with replies
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -620,6 +629,13 @@ func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Comment) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
 type CommentEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EventType     CommentEventType       `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=proto.CommentEventType" json:"event_type,omitempty"`
@@ -694,6 +710,7 @@ type UserProfile struct {
 	IsFollowing    bool                   `protobuf:"varint,10,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"` // if current user follows this user
 	IsOnline       bool                   `protobuf:"varint,11,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`          // online if last_activity < 5 minutes (FR-464)
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Slug           string                 `protobuf:"bytes,13,opt,name=slug,proto3" json:"slug,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -812,6 +829,13 @@ func (x *UserProfile) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UserProfile) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
 type CurrentUserProfile struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
 	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -827,6 +851,7 @@ type CurrentUserProfile struct {
 	JoinedCommunitiesCount int32                  `protobuf:"varint,11,opt,name=joined_communities_count,json=joinedCommunitiesCount,proto3" json:"joined_communities_count,omitempty"`
 	ActiveSessionsCount    int32                  `protobuf:"varint,12,opt,name=active_sessions_count,json=activeSessionsCount,proto3" json:"active_sessions_count,omitempty"`
 	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Slug                   string                 `protobuf:"bytes,14,opt,name=slug,proto3" json:"slug,omitempty"`
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -952,6 +977,13 @@ func (x *CurrentUserProfile) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CurrentUserProfile) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
 type UserStatistics struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Reputation         float64                `protobuf:"fixed64,1,opt,name=reputation,proto3" json:"reputation,omitempty"`
@@ -961,6 +993,7 @@ type UserStatistics struct {
 	CommentsCreated    int32                  `protobuf:"varint,5,opt,name=comments_created,json=commentsCreated,proto3" json:"comments_created,omitempty"`
 	PostLikes          int32                  `protobuf:"varint,6,opt,name=post_likes,json=postLikes,proto3" json:"post_likes,omitempty"`
 	CommentLikes       int32                  `protobuf:"varint,7,opt,name=comment_likes,json=commentLikes,proto3" json:"comment_likes,omitempty"`
+	CommunityKarma     []*CommunityKarma      `protobuf:"bytes,8,rep,name=community_karma,json=communityKarma,proto3" json:"community_karma,omitempty"` // reputation earned per community, highest first
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -1044,11 +1077,78 @@ func (x *UserStatistics) GetCommentLikes() int32 {
 	return 0
 }
 
+func (x *UserStatistics) GetCommunityKarma() []*CommunityKarma {
+	if x != nil {
+		return x.CommunityKarma
+	}
+	return nil
+}
+
+type CommunityKarma struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	CommunityName string                 `protobuf:"bytes,2,opt,name=community_name,json=communityName,proto3" json:"community_name,omitempty"`
+	Karma         int64                  `protobuf:"varint,3,opt,name=karma,proto3" json:"karma,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommunityKarma) Reset() {
+	*x = CommunityKarma{}
+	mi := &file_entity_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommunityKarma) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommunityKarma) ProtoMessage() {}
+
+func (x *CommunityKarma) ProtoReflect() protoreflect.Message {
+	mi := &file_entity_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommunityKarma.ProtoReflect.Descriptor instead.
+func (*CommunityKarma) Descriptor() ([]byte, []int) {
+	return file_entity_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CommunityKarma) GetCommunityId() string {
+	if x != nil {
+		return x.CommunityId
+	}
+	return ""
+}
+
+func (x *CommunityKarma) GetCommunityName() string {
+	if x != nil {
+		return x.CommunityName
+	}
+	return ""
+}
+
+func (x *CommunityKarma) GetKarma() int64 {
+	if x != nil {
+		return x.Karma
+	}
+	return 0
+}
+
 var File_entity_proto protoreflect.FileDescriptor
 
 const file_entity_proto_rawDesc = "" +
 	"\n" +
-	"\fentity.proto\x12\x05proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xaa\x03\n" +
+	"\fentity.proto\x12\x05proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xe7\x03\n" +
 	"\tCommunity\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x1d\n" +
@@ -1069,7 +1169,9 @@ const file_entity_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xdf\x04\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\varchived_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\"\xdf\x04\n" +
 	"\x04Post\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\fcommunity_id\x18\x02 \x01(\tR\vcommunityId\x12%\n" +
@@ -1095,7 +1197,7 @@ const file_entity_proto_rawDesc = "" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1d\n" +
 	"\n" +
-	"size_bytes\x18\x03 \x01(\x03R\tsizeBytes\"\xec\x03\n" +
+	"size_bytes\x18\x03 \x01(\x03R\tsizeBytes\"\x8b\x04\n" +
 	"\aComment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
 	"\x11parent_comment_id\x18\x02 \x01(\tR\x0fparentCommentId\x12\x17\n" +
@@ -1114,12 +1216,14 @@ const file_entity_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xaa\x01\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"is_deleted\x18\x0e \x01(\bR\tisDeleted\"\xaa\x01\n" +
 	"\fCommentEvent\x126\n" +
 	"\n" +
 	"event_type\x18\x01 \x01(\x0e2\x17.proto.CommentEventTypeR\teventType\x12(\n" +
 	"\acomment\x18\x02 \x01(\v2\x0e.proto.CommentR\acomment\x128\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x99\x03\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xad\x03\n" +
 	"\vUserProfile\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
@@ -1138,7 +1242,8 @@ const file_entity_proto_rawDesc = "" +
 	" \x01(\bR\visFollowing\x12\x1b\n" +
 	"\tis_online\x18\v \x01(\bR\bisOnline\x129\n" +
 	"\n" +
-	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xee\x03\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04slug\x18\r \x01(\tR\x04slug\"\x82\x04\n" +
 	"\x12CurrentUserProfile\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -1158,7 +1263,8 @@ const file_entity_proto_rawDesc = "" +
 	"\x18joined_communities_count\x18\v \x01(\x05R\x16joinedCommunitiesCount\x122\n" +
 	"\x15active_sessions_count\x18\f \x01(\x05R\x13activeSessionsCount\x129\n" +
 	"\n" +
-	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa4\x02\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04slug\x18\x0e \x01(\tR\x04slug\"\xe4\x02\n" +
 	"\x0eUserStatistics\x12\x1e\n" +
 	"\n" +
 	"reputation\x18\x01 \x01(\x01R\n" +
@@ -1169,7 +1275,12 @@ const file_entity_proto_rawDesc = "" +
 	"\x10comments_created\x18\x05 \x01(\x05R\x0fcommentsCreated\x12\x1d\n" +
 	"\n" +
 	"post_likes\x18\x06 \x01(\x05R\tpostLikes\x12#\n" +
-	"\rcomment_likes\x18\a \x01(\x05R\fcommentLikes*[\n" +
+	"\rcomment_likes\x18\a \x01(\x05R\fcommentLikes\x12>\n" +
+	"\x0fcommunity_karma\x18\b \x03(\v2\x15.proto.CommunityKarmaR\x0ecommunityKarma\"p\n" +
+	"\x0eCommunityKarma\x12!\n" +
+	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\x12%\n" +
+	"\x0ecommunity_name\x18\x02 \x01(\tR\rcommunityName\x12\x14\n" +
+	"\x05karma\x18\x03 \x01(\x03R\x05karma*[\n" +
 	"\n" +
 	"PostStatus\x12\x1b\n" +
 	"\x17POST_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
@@ -1194,7 +1305,7 @@ func file_entity_proto_rawDescGZIP() []byte {
 }
 
 var file_entity_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_entity_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_entity_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_entity_proto_goTypes = []any{
 	(PostStatus)(0),               // 0: proto.PostStatus
 	(CommentEventType)(0),         // 1: proto.CommentEventType
@@ -1206,30 +1317,33 @@ var file_entity_proto_goTypes = []any{
 	(*UserProfile)(nil),           // 7: proto.UserProfile
 	(*CurrentUserProfile)(nil),    // 8: proto.CurrentUserProfile
 	(*UserStatistics)(nil),        // 9: proto.UserStatistics
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),       // 11: google.protobuf.Struct
+	(*CommunityKarma)(nil),        // 10: proto.CommunityKarma
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 12: google.protobuf.Struct
 }
 var file_entity_proto_depIdxs = []int32{
-	10, // 0: proto.Community.created_at:type_name -> google.protobuf.Timestamp
-	10, // 1: proto.Community.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 2: proto.Post.content:type_name -> google.protobuf.Struct
-	0,  // 3: proto.Post.status:type_name -> proto.PostStatus
-	10, // 4: proto.Post.created_at:type_name -> google.protobuf.Timestamp
-	10, // 5: proto.Post.updated_at:type_name -> google.protobuf.Timestamp
-	10, // 6: proto.Post.published_at:type_name -> google.protobuf.Timestamp
-	4,  // 7: proto.Comment.attachments:type_name -> proto.MediaAttachment
-	10, // 8: proto.Comment.created_at:type_name -> google.protobuf.Timestamp
-	10, // 9: proto.Comment.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 10: proto.CommentEvent.event_type:type_name -> proto.CommentEventType
-	5,  // 11: proto.CommentEvent.comment:type_name -> proto.Comment
-	10, // 12: proto.CommentEvent.timestamp:type_name -> google.protobuf.Timestamp
-	10, // 13: proto.UserProfile.created_at:type_name -> google.protobuf.Timestamp
-	10, // 14: proto.CurrentUserProfile.created_at:type_name -> google.protobuf.Timestamp
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	11, // 0: proto.Community.created_at:type_name -> google.protobuf.Timestamp
+	11, // 1: proto.Community.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 2: proto.Community.archived_at:type_name -> google.protobuf.Timestamp
+	12, // 3: proto.Post.content:type_name -> google.protobuf.Struct
+	0,  // 4: proto.Post.status:type_name -> proto.PostStatus
+	11, // 5: proto.Post.created_at:type_name -> google.protobuf.Timestamp
+	11, // 6: proto.Post.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 7: proto.Post.published_at:type_name -> google.protobuf.Timestamp
+	4,  // 8: proto.Comment.attachments:type_name -> proto.MediaAttachment
+	11, // 9: proto.Comment.created_at:type_name -> google.protobuf.Timestamp
+	11, // 10: proto.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 11: proto.CommentEvent.event_type:type_name -> proto.CommentEventType
+	5,  // 12: proto.CommentEvent.comment:type_name -> proto.Comment
+	11, // 13: proto.CommentEvent.timestamp:type_name -> google.protobuf.Timestamp
+	11, // 14: proto.UserProfile.created_at:type_name -> google.protobuf.Timestamp
+	11, // 15: proto.CurrentUserProfile.created_at:type_name -> google.protobuf.Timestamp
+	10, // 16: proto.UserStatistics.community_karma:type_name -> proto.CommunityKarma
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_entity_proto_init() }
@@ -1243,7 +1357,7 @@ func file_entity_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_entity_proto_rawDesc), len(file_entity_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   8,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -326,27 +326,1837 @@ func (x *PlatformStatistics) GetCalculatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// Activity in one bucket of an analytics time series. new_users and
+// new_communities are only filled in for the whole platform.
+type AnalyticsPoint struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BucketStart    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"`
+	NewUsers       int64                  `protobuf:"varint,2,opt,name=new_users,json=newUsers,proto3" json:"new_users,omitempty"`
+	ActiveUsers    int64                  `protobuf:"varint,3,opt,name=active_users,json=activeUsers,proto3" json:"active_users,omitempty"` // distinct users who logged in, published, commented, liked or joined
+	NewCommunities int64                  `protobuf:"varint,4,opt,name=new_communities,json=newCommunities,proto3" json:"new_communities,omitempty"`
+	Posts          int64                  `protobuf:"varint,5,opt,name=posts,proto3" json:"posts,omitempty"`
+	Comments       int64                  `protobuf:"varint,6,opt,name=comments,proto3" json:"comments,omitempty"`
+	Likes          int64                  `protobuf:"varint,7,opt,name=likes,proto3" json:"likes,omitempty"`
+	Joins          int64                  `protobuf:"varint,8,opt,name=joins,proto3" json:"joins,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AnalyticsPoint) Reset() {
+	*x = AnalyticsPoint{}
+	mi := &file_platform_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyticsPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyticsPoint) ProtoMessage() {}
+
+func (x *AnalyticsPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyticsPoint.ProtoReflect.Descriptor instead.
+func (*AnalyticsPoint) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AnalyticsPoint) GetBucketStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BucketStart
+	}
+	return nil
+}
+
+func (x *AnalyticsPoint) GetNewUsers() int64 {
+	if x != nil {
+		return x.NewUsers
+	}
+	return 0
+}
+
+func (x *AnalyticsPoint) GetActiveUsers() int64 {
+	if x != nil {
+		return x.ActiveUsers
+	}
+	return 0
+}
+
+func (x *AnalyticsPoint) GetNewCommunities() int64 {
+	if x != nil {
+		return x.NewCommunities
+	}
+	return 0
+}
+
+func (x *AnalyticsPoint) GetPosts() int64 {
+	if x != nil {
+		return x.Posts
+	}
+	return 0
+}
+
+func (x *AnalyticsPoint) GetComments() int64 {
+	if x != nil {
+		return x.Comments
+	}
+	return 0
+}
+
+func (x *AnalyticsPoint) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *AnalyticsPoint) GetJoins() int64 {
+	if x != nil {
+		return x.Joins
+	}
+	return 0
+}
+
+type LoginLockout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`                   // login_account, login_ip
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // empty for unknown emails and IP lockouts
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	FailureCount  int32                  `protobuf:"varint,6,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	LockedUntil   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginLockout) Reset() {
+	*x = LoginLockout{}
+	mi := &file_platform_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginLockout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginLockout) ProtoMessage() {}
+
+func (x *LoginLockout) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginLockout.ProtoReflect.Descriptor instead.
+func (*LoginLockout) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LoginLockout) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LoginLockout) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LoginLockout) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LoginLockout) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginLockout) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *LoginLockout) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *LoginLockout) GetLockedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return nil
+}
+
+func (x *LoginLockout) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type InviteCode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CodePrefix    string                 `protobuf:"bytes,2,opt,name=code_prefix,json=codePrefix,proto3" json:"code_prefix,omitempty"` // first characters of the code, to tell codes apart
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"` // 0 means unlimited
+	UseCount      int32                  `protobuf:"varint,5,opt,name=use_count,json=useCount,proto3" json:"use_count,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // unset means never
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IsActive      bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteCode) Reset() {
+	*x = InviteCode{}
+	mi := &file_platform_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteCode) ProtoMessage() {}
+
+func (x *InviteCode) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteCode.ProtoReflect.Descriptor instead.
+func (*InviteCode) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *InviteCode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InviteCode) GetCodePrefix() string {
+	if x != nil {
+		return x.CodePrefix
+	}
+	return ""
+}
+
+func (x *InviteCode) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *InviteCode) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *InviteCode) GetUseCount() int32 {
+	if x != nil {
+		return x.UseCount
+	}
+	return 0
+}
+
+func (x *InviteCode) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *InviteCode) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *InviteCode) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *InviteCode) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type EmailDomainRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"` // ASCII form, matches subdomains too
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`     // allow, deny
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailDomainRule) Reset() {
+	*x = EmailDomainRule{}
+	mi := &file_platform_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailDomainRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailDomainRule) ProtoMessage() {}
+
+func (x *EmailDomainRule) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailDomainRule.ProtoReflect.Descriptor instead.
+func (*EmailDomainRule) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EmailDomainRule) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *EmailDomainRule) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *EmailDomainRule) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *EmailDomainRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type PendingRegistration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	IsVerified    bool                   `protobuf:"varint,5,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingRegistration) Reset() {
+	*x = PendingRegistration{}
+	mi := &file_platform_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingRegistration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingRegistration) ProtoMessage() {}
+
+func (x *PendingRegistration) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingRegistration.ProtoReflect.Descriptor instead.
+func (*PendingRegistration) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PendingRegistration) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PendingRegistration) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *PendingRegistration) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PendingRegistration) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PendingRegistration) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *PendingRegistration) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 type GetSettingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSettingsRequest) Reset() {
-	*x = GetSettingsRequest{}
-	mi := &file_platform_proto_msgTypes[3]
+func (x *GetSettingsRequest) Reset() {
+	*x = GetSettingsRequest{}
+	mi := &file_platform_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingsRequest) ProtoMessage() {}
+
+func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{8}
+}
+
+type GetSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *PlatformSettings      `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingsResponse) Reset() {
+	*x = GetSettingsResponse{}
+	mi := &file_platform_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingsResponse) ProtoMessage() {}
+
+func (x *GetSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetSettingsResponse) GetSettings() *PlatformSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateSettingsRequest struct {
+	state                  protoimpl.MessageState   `protogen:"open.v1"`
+	Name                   *string                  `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`               // 3-100 chars
+	Description            *string                  `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"` // max 1000 chars
+	Rules                  *string                  `protobuf:"bytes,3,opt,name=rules,proto3,oneof" json:"rules,omitempty"`
+	LogoUrl                *string                  `protobuf:"bytes,4,opt,name=logo_url,json=logoUrl,proto3,oneof" json:"logo_url,omitempty"`
+	BannerUrl              *string                  `protobuf:"bytes,5,opt,name=banner_url,json=bannerUrl,proto3,oneof" json:"banner_url,omitempty"`
+	AuthBannerUrl          *string                  `protobuf:"bytes,6,opt,name=auth_banner_url,json=authBannerUrl,proto3,oneof" json:"auth_banner_url,omitempty"`
+	AutomaticBadgeSettings []*AutomaticBadgeSetting `protobuf:"bytes,7,rep,name=automatic_badge_settings,json=automaticBadgeSettings,proto3" json:"automatic_badge_settings,omitempty"` // FR-540: update badge enable/disable
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateSettingsRequest) Reset() {
+	*x = UpdateSettingsRequest{}
+	mi := &file_platform_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateSettingsRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetRules() string {
+	if x != nil && x.Rules != nil {
+		return *x.Rules
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetLogoUrl() string {
+	if x != nil && x.LogoUrl != nil {
+		return *x.LogoUrl
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetBannerUrl() string {
+	if x != nil && x.BannerUrl != nil {
+		return *x.BannerUrl
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetAuthBannerUrl() string {
+	if x != nil && x.AuthBannerUrl != nil {
+		return *x.AuthBannerUrl
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetAutomaticBadgeSettings() []*AutomaticBadgeSetting {
+	if x != nil {
+		return x.AutomaticBadgeSettings
+	}
+	return nil
+}
+
+type UpdateSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *PlatformSettings      `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSettingsResponse) Reset() {
+	*x = UpdateSettingsResponse{}
+	mi := &file_platform_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateSettingsResponse) GetSettings() *PlatformSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type GetPlatformStatisticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlatformStatisticsRequest) Reset() {
+	*x = GetPlatformStatisticsRequest{}
+	mi := &file_platform_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlatformStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformStatisticsRequest) ProtoMessage() {}
+
+func (x *GetPlatformStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlatformStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{12}
+}
+
+type GetPlatformStatisticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statistics    *PlatformStatistics    `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlatformStatisticsResponse) Reset() {
+	*x = GetPlatformStatisticsResponse{}
+	mi := &file_platform_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlatformStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformStatisticsResponse) ProtoMessage() {}
+
+func (x *GetPlatformStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*GetPlatformStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPlatformStatisticsResponse) GetStatistics() *PlatformStatistics {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+type GetAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"` // empty for the whole platform
+	Granularity   string                 `protobuf:"bytes,2,opt,name=granularity,proto3" json:"granularity,omitempty"`                    // hour, day
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`                                  // unset means 30 buckets before to
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`                                      // exclusive, unset means now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnalyticsRequest) Reset() {
+	*x = GetAnalyticsRequest{}
+	mi := &file_platform_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetAnalyticsRequest) GetCommunityId() string {
+	if x != nil {
+		return x.CommunityId
+	}
+	return ""
+}
+
+func (x *GetAnalyticsRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetAnalyticsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetAnalyticsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetAnalyticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*AnalyticsPoint      `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"` // one per bucket, oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnalyticsResponse) Reset() {
+	*x = GetAnalyticsResponse{}
+	mi := &file_platform_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetAnalyticsResponse) GetPoints() []*AnalyticsPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type TransferPlatformOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewOwnerId    string                 `protobuf:"bytes,1,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferPlatformOwnershipRequest) Reset() {
+	*x = TransferPlatformOwnershipRequest{}
+	mi := &file_platform_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferPlatformOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPlatformOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferPlatformOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPlatformOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferPlatformOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TransferPlatformOwnershipRequest) GetNewOwnerId() string {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return ""
+}
+
+type TransferPlatformOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferPlatformOwnershipResponse) Reset() {
+	*x = TransferPlatformOwnershipResponse{}
+	mi := &file_platform_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferPlatformOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPlatformOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferPlatformOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPlatformOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferPlatformOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TransferPlatformOwnershipResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ConfirmPlatformOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPlatformOwnershipRequest) Reset() {
+	*x = ConfirmPlatformOwnershipRequest{}
+	mi := &file_platform_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPlatformOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPlatformOwnershipRequest) ProtoMessage() {}
+
+func (x *ConfirmPlatformOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPlatformOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPlatformOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ConfirmPlatformOwnershipRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ConfirmPlatformOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Settings      *PlatformSettings      `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPlatformOwnershipResponse) Reset() {
+	*x = ConfirmPlatformOwnershipResponse{}
+	mi := &file_platform_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPlatformOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPlatformOwnershipResponse) ProtoMessage() {}
+
+func (x *ConfirmPlatformOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPlatformOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmPlatformOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConfirmPlatformOwnershipResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConfirmPlatformOwnershipResponse) GetSettings() *PlatformSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type ListLoginLockoutsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoginLockoutsRequest) Reset() {
+	*x = ListLoginLockoutsRequest{}
+	mi := &file_platform_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoginLockoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoginLockoutsRequest) ProtoMessage() {}
+
+func (x *ListLoginLockoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoginLockoutsRequest.ProtoReflect.Descriptor instead.
+func (*ListLoginLockoutsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListLoginLockoutsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListLoginLockoutsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListLoginLockoutsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lockouts      []*LoginLockout        `protobuf:"bytes,1,rep,name=lockouts,proto3" json:"lockouts,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,4,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoginLockoutsResponse) Reset() {
+	*x = ListLoginLockoutsResponse{}
+	mi := &file_platform_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoginLockoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoginLockoutsResponse) ProtoMessage() {}
+
+func (x *ListLoginLockoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoginLockoutsResponse.ProtoReflect.Descriptor instead.
+func (*ListLoginLockoutsResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListLoginLockoutsResponse) GetLockouts() []*LoginLockout {
+	if x != nil {
+		return x.Lockouts
+	}
+	return nil
+}
+
+func (x *ListLoginLockoutsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListLoginLockoutsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListLoginLockoutsResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
+type GetRegistrationSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegistrationSettingsRequest) Reset() {
+	*x = GetRegistrationSettingsRequest{}
+	mi := &file_platform_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegistrationSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegistrationSettingsRequest) ProtoMessage() {}
+
+func (x *GetRegistrationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegistrationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetRegistrationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{22}
+}
+
+type GetRegistrationSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"` // open, invite_only, approval, closed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegistrationSettingsResponse) Reset() {
+	*x = GetRegistrationSettingsResponse{}
+	mi := &file_platform_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegistrationSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegistrationSettingsResponse) ProtoMessage() {}
+
+func (x *GetRegistrationSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegistrationSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetRegistrationSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetRegistrationSettingsResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type UpdateRegistrationModeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRegistrationModeRequest) Reset() {
+	*x = UpdateRegistrationModeRequest{}
+	mi := &file_platform_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRegistrationModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRegistrationModeRequest) ProtoMessage() {}
+
+func (x *UpdateRegistrationModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRegistrationModeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRegistrationModeRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateRegistrationModeRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type UpdateRegistrationModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRegistrationModeResponse) Reset() {
+	*x = UpdateRegistrationModeResponse{}
+	mi := &file_platform_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRegistrationModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRegistrationModeResponse) ProtoMessage() {}
+
+func (x *UpdateRegistrationModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRegistrationModeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRegistrationModeResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateRegistrationModeResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type CreateInviteCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxUses       int32                  `protobuf:"varint,1,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`      // 0 means unlimited
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // unset means never
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteCodeRequest) Reset() {
+	*x = CreateInviteCodeRequest{}
+	mi := &file_platform_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteCodeRequest) ProtoMessage() {}
+
+func (x *CreateInviteCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteCodeRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateInviteCodeRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateInviteCodeRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateInviteCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *InviteCode            `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // shown only once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteCodeResponse) Reset() {
+	*x = CreateInviteCodeResponse{}
+	mi := &file_platform_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteCodeResponse) ProtoMessage() {}
+
+func (x *CreateInviteCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteCodeResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteCodeResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateInviteCodeResponse) GetInvite() *InviteCode {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+func (x *CreateInviteCodeResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ListInviteCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInviteCodesRequest) Reset() {
+	*x = ListInviteCodesRequest{}
+	mi := &file_platform_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInviteCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInviteCodesRequest) ProtoMessage() {}
+
+func (x *ListInviteCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInviteCodesRequest.ProtoReflect.Descriptor instead.
+func (*ListInviteCodesRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListInviteCodesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListInviteCodesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListInviteCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*InviteCode          `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,4,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInviteCodesResponse) Reset() {
+	*x = ListInviteCodesResponse{}
+	mi := &file_platform_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInviteCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInviteCodesResponse) ProtoMessage() {}
+
+func (x *ListInviteCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInviteCodesResponse.ProtoReflect.Descriptor instead.
+func (*ListInviteCodesResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListInviteCodesResponse) GetInvites() []*InviteCode {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+func (x *ListInviteCodesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListInviteCodesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListInviteCodesResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
+type RevokeInviteCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteCodeRequest) Reset() {
+	*x = RevokeInviteCodeRequest{}
+	mi := &file_platform_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteCodeRequest) ProtoMessage() {}
+
+func (x *RevokeInviteCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteCodeRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteCodeRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RevokeInviteCodeRequest) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+type RevokeInviteCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteCodeResponse) Reset() {
+	*x = RevokeInviteCodeResponse{}
+	mi := &file_platform_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteCodeResponse) ProtoMessage() {}
+
+func (x *RevokeInviteCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteCodeResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInviteCodeResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{31}
+}
+
+type ListPendingRegistrationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingRegistrationsRequest) Reset() {
+	*x = ListPendingRegistrationsRequest{}
+	mi := &file_platform_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingRegistrationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingRegistrationsRequest) ProtoMessage() {}
+
+func (x *ListPendingRegistrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingRegistrationsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingRegistrationsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListPendingRegistrationsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListPendingRegistrationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListPendingRegistrationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Registrations []*PendingRegistration `protobuf:"bytes,1,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,4,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingRegistrationsResponse) Reset() {
+	*x = ListPendingRegistrationsResponse{}
+	mi := &file_platform_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingRegistrationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingRegistrationsResponse) ProtoMessage() {}
+
+func (x *ListPendingRegistrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingRegistrationsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingRegistrationsResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListPendingRegistrationsResponse) GetRegistrations() []*PendingRegistration {
+	if x != nil {
+		return x.Registrations
+	}
+	return nil
+}
+
+func (x *ListPendingRegistrationsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListPendingRegistrationsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListPendingRegistrationsResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
+type ApproveRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveRegistrationRequest) Reset() {
+	*x = ApproveRegistrationRequest{}
+	mi := &file_platform_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRegistrationRequest) ProtoMessage() {}
+
+func (x *ApproveRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*ApproveRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ApproveRegistrationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ApproveRegistrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveRegistrationResponse) Reset() {
+	*x = ApproveRegistrationResponse{}
+	mi := &file_platform_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSettingsRequest) String() string {
+func (x *ApproveRegistrationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSettingsRequest) ProtoMessage() {}
+func (*ApproveRegistrationResponse) ProtoMessage() {}
 
-func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_proto_msgTypes[3]
+func (x *ApproveRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -357,33 +2167,33 @@ func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSettingsRequest.ProtoReflect.Descriptor instead.
-func (*GetSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_platform_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ApproveRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*ApproveRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{35}
 }
 
-type GetSettingsResponse struct {
+type RejectRegistrationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Settings      *PlatformSettings      `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSettingsResponse) Reset() {
-	*x = GetSettingsResponse{}
-	mi := &file_platform_proto_msgTypes[4]
+func (x *RejectRegistrationRequest) Reset() {
+	*x = RejectRegistrationRequest{}
+	mi := &file_platform_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSettingsResponse) String() string {
+func (x *RejectRegistrationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSettingsResponse) ProtoMessage() {}
+func (*RejectRegistrationRequest) ProtoMessage() {}
 
-func (x *GetSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_proto_msgTypes[4]
+func (x *RejectRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -394,46 +2204,39 @@ func (x *GetSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSettingsResponse.ProtoReflect.Descriptor instead.
-func (*GetSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_platform_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use RejectRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*RejectRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetSettingsResponse) GetSettings() *PlatformSettings {
+func (x *RejectRegistrationRequest) GetUserId() string {
 	if x != nil {
-		return x.Settings
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type UpdateSettingsRequest struct {
-	state                  protoimpl.MessageState   `protogen:"open.v1"`
-	Name                   *string                  `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`               // 3-100 chars
-	Description            *string                  `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"` // max 1000 chars
-	Rules                  *string                  `protobuf:"bytes,3,opt,name=rules,proto3,oneof" json:"rules,omitempty"`
-	LogoUrl                *string                  `protobuf:"bytes,4,opt,name=logo_url,json=logoUrl,proto3,oneof" json:"logo_url,omitempty"`
-	BannerUrl              *string                  `protobuf:"bytes,5,opt,name=banner_url,json=bannerUrl,proto3,oneof" json:"banner_url,omitempty"`
-	AuthBannerUrl          *string                  `protobuf:"bytes,6,opt,name=auth_banner_url,json=authBannerUrl,proto3,oneof" json:"auth_banner_url,omitempty"`
-	AutomaticBadgeSettings []*AutomaticBadgeSetting `protobuf:"bytes,7,rep,name=automatic_badge_settings,json=automaticBadgeSettings,proto3" json:"automatic_badge_settings,omitempty"` // FR-540: update badge enable/disable
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type RejectRegistrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSettingsRequest) Reset() {
-	*x = UpdateSettingsRequest{}
-	mi := &file_platform_proto_msgTypes[5]
+func (x *RejectRegistrationResponse) Reset() {
+	*x = RejectRegistrationResponse{}
+	mi := &file_platform_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSettingsRequest) String() string {
+func (x *RejectRegistrationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSettingsRequest) ProtoMessage() {}
+func (*RejectRegistrationResponse) ProtoMessage() {}
 
-func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_proto_msgTypes[5]
+func (x *RejectRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,82 +2247,69 @@ func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_platform_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *UpdateSettingsRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
+// Deprecated: Use RejectRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*RejectRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *UpdateSettingsRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
+type ListEmailDomainRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSettingsRequest) GetRules() string {
-	if x != nil && x.Rules != nil {
-		return *x.Rules
-	}
-	return ""
+func (x *ListEmailDomainRulesRequest) Reset() {
+	*x = ListEmailDomainRulesRequest{}
+	mi := &file_platform_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSettingsRequest) GetLogoUrl() string {
-	if x != nil && x.LogoUrl != nil {
-		return *x.LogoUrl
-	}
-	return ""
+func (x *ListEmailDomainRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateSettingsRequest) GetBannerUrl() string {
-	if x != nil && x.BannerUrl != nil {
-		return *x.BannerUrl
-	}
-	return ""
-}
+func (*ListEmailDomainRulesRequest) ProtoMessage() {}
 
-func (x *UpdateSettingsRequest) GetAuthBannerUrl() string {
-	if x != nil && x.AuthBannerUrl != nil {
-		return *x.AuthBannerUrl
+func (x *ListEmailDomainRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateSettingsRequest) GetAutomaticBadgeSettings() []*AutomaticBadgeSetting {
-	if x != nil {
-		return x.AutomaticBadgeSettings
-	}
-	return nil
+// Deprecated: Use ListEmailDomainRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListEmailDomainRulesRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{38}
 }
 
-type UpdateSettingsResponse struct {
+type ListEmailDomainRulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Settings      *PlatformSettings      `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	Rules         []*EmailDomainRule     `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSettingsResponse) Reset() {
-	*x = UpdateSettingsResponse{}
-	mi := &file_platform_proto_msgTypes[6]
+func (x *ListEmailDomainRulesResponse) Reset() {
+	*x = ListEmailDomainRulesResponse{}
+	mi := &file_platform_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSettingsResponse) String() string {
+func (x *ListEmailDomainRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSettingsResponse) ProtoMessage() {}
+func (*ListEmailDomainRulesResponse) ProtoMessage() {}
 
-func (x *UpdateSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_proto_msgTypes[6]
+func (x *ListEmailDomainRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -530,39 +2320,41 @@ func (x *UpdateSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSettingsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_platform_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListEmailDomainRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListEmailDomainRulesResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *UpdateSettingsResponse) GetSettings() *PlatformSettings {
+func (x *ListEmailDomainRulesResponse) GetRules() []*EmailDomainRule {
 	if x != nil {
-		return x.Settings
+		return x.Rules
 	}
 	return nil
 }
 
-type GetPlatformStatisticsRequest struct {
+type SetEmailDomainRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // allow, deny
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPlatformStatisticsRequest) Reset() {
-	*x = GetPlatformStatisticsRequest{}
-	mi := &file_platform_proto_msgTypes[7]
+func (x *SetEmailDomainRuleRequest) Reset() {
+	*x = SetEmailDomainRuleRequest{}
+	mi := &file_platform_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPlatformStatisticsRequest) String() string {
+func (x *SetEmailDomainRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlatformStatisticsRequest) ProtoMessage() {}
+func (*SetEmailDomainRuleRequest) ProtoMessage() {}
 
-func (x *GetPlatformStatisticsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_proto_msgTypes[7]
+func (x *SetEmailDomainRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -573,33 +2365,47 @@ func (x *GetPlatformStatisticsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlatformStatisticsRequest.ProtoReflect.Descriptor instead.
-func (*GetPlatformStatisticsRequest) Descriptor() ([]byte, []int) {
-	return file_platform_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SetEmailDomainRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetEmailDomainRuleRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{40}
 }
 
-type GetPlatformStatisticsResponse struct {
+func (x *SetEmailDomainRuleRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *SetEmailDomainRuleRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type SetEmailDomainRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Statistics    *PlatformStatistics    `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	Rule          *EmailDomainRule       `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPlatformStatisticsResponse) Reset() {
-	*x = GetPlatformStatisticsResponse{}
-	mi := &file_platform_proto_msgTypes[8]
+func (x *SetEmailDomainRuleResponse) Reset() {
+	*x = SetEmailDomainRuleResponse{}
+	mi := &file_platform_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPlatformStatisticsResponse) String() string {
+func (x *SetEmailDomainRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlatformStatisticsResponse) ProtoMessage() {}
+func (*SetEmailDomainRuleResponse) ProtoMessage() {}
 
-func (x *GetPlatformStatisticsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_proto_msgTypes[8]
+func (x *SetEmailDomainRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,40 +2416,40 @@ func (x *GetPlatformStatisticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlatformStatisticsResponse.ProtoReflect.Descriptor instead.
-func (*GetPlatformStatisticsResponse) Descriptor() ([]byte, []int) {
-	return file_platform_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SetEmailDomainRuleResponse.ProtoReflect.Descriptor instead.
+func (*SetEmailDomainRuleResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetPlatformStatisticsResponse) GetStatistics() *PlatformStatistics {
+func (x *SetEmailDomainRuleResponse) GetRule() *EmailDomainRule {
 	if x != nil {
-		return x.Statistics
+		return x.Rule
 	}
 	return nil
 }
 
-type TransferPlatformOwnershipRequest struct {
+type DeleteEmailDomainRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NewOwnerId    string                 `protobuf:"bytes,1,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransferPlatformOwnershipRequest) Reset() {
-	*x = TransferPlatformOwnershipRequest{}
-	mi := &file_platform_proto_msgTypes[9]
+func (x *DeleteEmailDomainRuleRequest) Reset() {
+	*x = DeleteEmailDomainRuleRequest{}
+	mi := &file_platform_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferPlatformOwnershipRequest) String() string {
+func (x *DeleteEmailDomainRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferPlatformOwnershipRequest) ProtoMessage() {}
+func (*DeleteEmailDomainRuleRequest) ProtoMessage() {}
 
-func (x *TransferPlatformOwnershipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_proto_msgTypes[9]
+func (x *DeleteEmailDomainRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,40 +2460,39 @@ func (x *TransferPlatformOwnershipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferPlatformOwnershipRequest.ProtoReflect.Descriptor instead.
-func (*TransferPlatformOwnershipRequest) Descriptor() ([]byte, []int) {
-	return file_platform_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DeleteEmailDomainRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEmailDomainRuleRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *TransferPlatformOwnershipRequest) GetNewOwnerId() string {
+func (x *DeleteEmailDomainRuleRequest) GetDomain() string {
 	if x != nil {
-		return x.NewOwnerId
+		return x.Domain
 	}
 	return ""
 }
 
-type TransferPlatformOwnershipResponse struct {
+type DeleteEmailDomainRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransferPlatformOwnershipResponse) Reset() {
-	*x = TransferPlatformOwnershipResponse{}
-	mi := &file_platform_proto_msgTypes[10]
+func (x *DeleteEmailDomainRuleResponse) Reset() {
+	*x = DeleteEmailDomainRuleResponse{}
+	mi := &file_platform_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferPlatformOwnershipResponse) String() string {
+func (x *DeleteEmailDomainRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferPlatformOwnershipResponse) ProtoMessage() {}
+func (*DeleteEmailDomainRuleResponse) ProtoMessage() {}
 
-func (x *TransferPlatformOwnershipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_proto_msgTypes[10]
+func (x *DeleteEmailDomainRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,40 +2503,36 @@ func (x *TransferPlatformOwnershipResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferPlatformOwnershipResponse.ProtoReflect.Descriptor instead.
-func (*TransferPlatformOwnershipResponse) Descriptor() ([]byte, []int) {
-	return file_platform_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *TransferPlatformOwnershipResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use DeleteEmailDomainRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEmailDomainRuleResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{43}
 }
 
-type ConfirmPlatformOwnershipRequest struct {
+type AdjustReputationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // user to adjust, or empty to adjust the community rating
+	CommunityId   string                 `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"` // optional for users, counts towards their karma there
+	Delta         float64                `protobuf:"fixed64,3,opt,name=delta,proto3" json:"delta,omitempty"`                              // whole points for users, tenths for communities
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                              // required, shown in the user's reputation history
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfirmPlatformOwnershipRequest) Reset() {
-	*x = ConfirmPlatformOwnershipRequest{}
-	mi := &file_platform_proto_msgTypes[11]
+func (x *AdjustReputationRequest) Reset() {
+	*x = AdjustReputationRequest{}
+	mi := &file_platform_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmPlatformOwnershipRequest) String() string {
+func (x *AdjustReputationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmPlatformOwnershipRequest) ProtoMessage() {}
+func (*AdjustReputationRequest) ProtoMessage() {}
 
-func (x *ConfirmPlatformOwnershipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_proto_msgTypes[11]
+func (x *AdjustReputationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,41 +2543,60 @@ func (x *ConfirmPlatformOwnershipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmPlatformOwnershipRequest.ProtoReflect.Descriptor instead.
-func (*ConfirmPlatformOwnershipRequest) Descriptor() ([]byte, []int) {
-	return file_platform_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AdjustReputationRequest.ProtoReflect.Descriptor instead.
+func (*AdjustReputationRequest) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ConfirmPlatformOwnershipRequest) GetToken() string {
+func (x *AdjustReputationRequest) GetUserId() string {
 	if x != nil {
-		return x.Token
+		return x.UserId
 	}
 	return ""
 }
 
-type ConfirmPlatformOwnershipResponse struct {
+func (x *AdjustReputationRequest) GetCommunityId() string {
+	if x != nil {
+		return x.CommunityId
+	}
+	return ""
+}
+
+func (x *AdjustReputationRequest) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *AdjustReputationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdjustReputationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Settings      *PlatformSettings      `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfirmPlatformOwnershipResponse) Reset() {
-	*x = ConfirmPlatformOwnershipResponse{}
-	mi := &file_platform_proto_msgTypes[12]
+func (x *AdjustReputationResponse) Reset() {
+	*x = AdjustReputationResponse{}
+	mi := &file_platform_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmPlatformOwnershipResponse) String() string {
+func (x *AdjustReputationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmPlatformOwnershipResponse) ProtoMessage() {}
+func (*AdjustReputationResponse) ProtoMessage() {}
 
-func (x *ConfirmPlatformOwnershipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_proto_msgTypes[12]
+func (x *AdjustReputationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,23 +2607,9 @@ func (x *ConfirmPlatformOwnershipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmPlatformOwnershipResponse.ProtoReflect.Descriptor instead.
-func (*ConfirmPlatformOwnershipResponse) Descriptor() ([]byte, []int) {
-	return file_platform_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *ConfirmPlatformOwnershipResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *ConfirmPlatformOwnershipResponse) GetSettings() *PlatformSettings {
-	if x != nil {
-		return x.Settings
-	}
-	return nil
+// Deprecated: Use AdjustReputationResponse.ProtoReflect.Descriptor instead.
+func (*AdjustReputationResponse) Descriptor() ([]byte, []int) {
+	return file_platform_proto_rawDescGZIP(), []int{45}
 }
 
 var File_platform_proto protoreflect.FileDescriptor
@@ -843,7 +2649,59 @@ const file_platform_proto_rawDesc = "" +
 	"\x0factive_users_7d\x18\n" +
 	" \x01(\x05R\ractiveUsers7d\x12(\n" +
 	"\x10active_users_30d\x18\v \x01(\x05R\x0eactiveUsers30d\x12?\n" +
-	"\rcalculated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\fcalculatedAt\"\x14\n" +
+	"\rcalculated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\fcalculatedAt\"\x96\x02\n" +
+	"\x0eAnalyticsPoint\x12=\n" +
+	"\fbucket_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vbucketStart\x12\x1b\n" +
+	"\tnew_users\x18\x02 \x01(\x03R\bnewUsers\x12!\n" +
+	"\factive_users\x18\x03 \x01(\x03R\vactiveUsers\x12'\n" +
+	"\x0fnew_communities\x18\x04 \x01(\x03R\x0enewCommunities\x12\x14\n" +
+	"\x05posts\x18\x05 \x01(\x03R\x05posts\x12\x1a\n" +
+	"\bcomments\x18\x06 \x01(\x03R\bcomments\x12\x14\n" +
+	"\x05likes\x18\a \x01(\x03R\x05likes\x12\x14\n" +
+	"\x05joins\x18\b \x01(\x03R\x05joins\"\x9f\x02\n" +
+	"\fLoginLockout\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x05 \x01(\tR\tipAddress\x12#\n" +
+	"\rfailure_count\x18\x06 \x01(\x05R\ffailureCount\x12=\n" +
+	"\flocked_until\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vlockedUntil\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xe2\x02\n" +
+	"\n" +
+	"InviteCode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcode_prefix\x18\x02 \x01(\tR\n" +
+	"codePrefix\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x12\x19\n" +
+	"\bmax_uses\x18\x04 \x01(\x05R\amaxUses\x12\x1b\n" +
+	"\tuse_count\x18\x05 \x01(\x05R\buseCount\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tis_active\x18\t \x01(\bR\bisActive\"\x97\x01\n" +
+	"\x0fEmailDomainRule\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc8\x01\n" +
+	"\x13PendingRegistration\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x1f\n" +
+	"\vis_verified\x18\x05 \x01(\bR\n" +
+	"isVerified\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x14\n" +
 	"\x12GetSettingsRequest\"J\n" +
 	"\x13GetSettingsResponse\x123\n" +
 	"\bsettings\x18\x01 \x01(\v2\x17.proto.PlatformSettingsR\bsettings\"\x8e\x03\n" +
@@ -868,7 +2726,14 @@ const file_platform_proto_rawDesc = "" +
 	"\x1dGetPlatformStatisticsResponse\x129\n" +
 	"\n" +
 	"statistics\x18\x01 \x01(\v2\x19.proto.PlatformStatisticsR\n" +
-	"statistics\"D\n" +
+	"statistics\"\xb6\x01\n" +
+	"\x13GetAnalyticsRequest\x12!\n" +
+	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\x12 \n" +
+	"\vgranularity\x18\x02 \x01(\tR\vgranularity\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"E\n" +
+	"\x14GetAnalyticsResponse\x12-\n" +
+	"\x06points\x18\x01 \x03(\v2\x15.proto.AnalyticsPointR\x06points\"D\n" +
 	" TransferPlatformOwnershipRequest\x12 \n" +
 	"\fnew_owner_id\x18\x01 \x01(\tR\n" +
 	"newOwnerId\"=\n" +
@@ -878,11 +2743,95 @@ const file_platform_proto_rawDesc = "" +
 	"\x05token\x18\x01 \x01(\tR\x05token\"q\n" +
 	" ConfirmPlatformOwnershipResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x123\n" +
-	"\bsettings\x18\x02 \x01(\v2\x17.proto.PlatformSettingsR\bsettings2\xfb\x04\n" +
+	"\bsettings\x18\x02 \x01(\v2\x17.proto.PlatformSettingsR\bsettings\"H\n" +
+	"\x18ListLoginLockoutsRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xa9\x01\n" +
+	"\x19ListLoginLockoutsResponse\x12/\n" +
+	"\blockouts\x18\x01 \x03(\v2\x13.proto.LoginLockoutR\blockouts\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vprev_cursor\x18\x04 \x01(\tR\n" +
+	"prevCursor\" \n" +
+	"\x1eGetRegistrationSettingsRequest\"5\n" +
+	"\x1fGetRegistrationSettingsResponse\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\"3\n" +
+	"\x1dUpdateRegistrationModeRequest\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\"4\n" +
+	"\x1eUpdateRegistrationModeResponse\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\"o\n" +
+	"\x17CreateInviteCodeRequest\x12\x19\n" +
+	"\bmax_uses\x18\x01 \x01(\x05R\amaxUses\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"Y\n" +
+	"\x18CreateInviteCodeResponse\x12)\n" +
+	"\x06invite\x18\x01 \x01(\v2\x11.proto.InviteCodeR\x06invite\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"F\n" +
+	"\x16ListInviteCodesRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xa3\x01\n" +
+	"\x17ListInviteCodesResponse\x12+\n" +
+	"\ainvites\x18\x01 \x03(\v2\x11.proto.InviteCodeR\ainvites\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vprev_cursor\x18\x04 \x01(\tR\n" +
+	"prevCursor\"6\n" +
+	"\x17RevokeInviteCodeRequest\x12\x1b\n" +
+	"\tinvite_id\x18\x01 \x01(\tR\binviteId\"\x1a\n" +
+	"\x18RevokeInviteCodeResponse\"O\n" +
+	"\x1fListPendingRegistrationsRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xc1\x01\n" +
+	" ListPendingRegistrationsResponse\x12@\n" +
+	"\rregistrations\x18\x01 \x03(\v2\x1a.proto.PendingRegistrationR\rregistrations\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vprev_cursor\x18\x04 \x01(\tR\n" +
+	"prevCursor\"5\n" +
+	"\x1aApproveRegistrationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x1d\n" +
+	"\x1bApproveRegistrationResponse\"4\n" +
+	"\x19RejectRegistrationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x1c\n" +
+	"\x1aRejectRegistrationResponse\"\x1d\n" +
+	"\x1bListEmailDomainRulesRequest\"L\n" +
+	"\x1cListEmailDomainRulesResponse\x12,\n" +
+	"\x05rules\x18\x01 \x03(\v2\x16.proto.EmailDomainRuleR\x05rules\"G\n" +
+	"\x19SetEmailDomainRuleRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\"H\n" +
+	"\x1aSetEmailDomainRuleResponse\x12*\n" +
+	"\x04rule\x18\x01 \x01(\v2\x16.proto.EmailDomainRuleR\x04rule\"6\n" +
+	"\x1cDeleteEmailDomainRuleRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\"\x1f\n" +
+	"\x1dDeleteEmailDomainRuleResponse\"\x83\x01\n" +
+	"\x17AdjustReputationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fcommunity_id\x18\x02 \x01(\tR\vcommunityId\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x01R\x05delta\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x1a\n" +
+	"\x18AdjustReputationResponse2\xa8\x13\n" +
 	"\x0fPlatformService\x12`\n" +
 	"\vGetSettings\x12\x19.proto.GetSettingsRequest\x1a\x1a.proto.GetSettingsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/platform/settings\x12l\n" +
 	"\x0eUpdateSettings\x12\x1c.proto.UpdateSettingsRequest\x1a\x1d.proto.UpdateSettingsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*2\x12/platform/settings\x12x\n" +
-	"\rGetStatistics\x12#.proto.GetPlatformStatisticsRequest\x1a$.proto.GetPlatformStatisticsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/platform/statistics\x12\x8f\x01\n" +
+	"\rGetStatistics\x12#.proto.GetPlatformStatisticsRequest\x1a$.proto.GetPlatformStatisticsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/platform/statistics\x12d\n" +
+	"\fGetAnalytics\x12\x1a.proto.GetAnalyticsRequest\x1a\x1b.proto.GetAnalyticsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/platform/analytics\x12x\n" +
+	"\x11ListLoginLockouts\x12\x1f.proto.ListLoginLockoutsRequest\x1a .proto.ListLoginLockoutsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/platform/login-lockouts\x12\x88\x01\n" +
+	"\x17GetRegistrationSettings\x12%.proto.GetRegistrationSettingsRequest\x1a&.proto.GetRegistrationSettingsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/platform/registration\x12\x88\x01\n" +
+	"\x16UpdateRegistrationMode\x12$.proto.UpdateRegistrationModeRequest\x1a%.proto.UpdateRegistrationModeResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*2\x16/platform/registration\x12q\n" +
+	"\x10CreateInviteCode\x12\x1e.proto.CreateInviteCodeRequest\x1a\x1f.proto.CreateInviteCodeResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/platform/invites\x12k\n" +
+	"\x0fListInviteCodes\x12\x1d.proto.ListInviteCodesRequest\x1a\x1e.proto.ListInviteCodesResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/platform/invites\x12z\n" +
+	"\x10RevokeInviteCode\x12\x1e.proto.RevokeInviteCodeRequest\x1a\x1f.proto.RevokeInviteCodeResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/platform/invites/{invite_id}\x12\x94\x01\n" +
+	"\x18ListPendingRegistrations\x12&.proto.ListPendingRegistrationsRequest\x1a'.proto.ListPendingRegistrationsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/platform/registrations/pending\x12\x92\x01\n" +
+	"\x13ApproveRegistration\x12!.proto.ApproveRegistrationRequest\x1a\".proto.ApproveRegistrationResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/platform/registrations/{user_id}/approve\x12\x8e\x01\n" +
+	"\x12RejectRegistration\x12 .proto.RejectRegistrationRequest\x1a!.proto.RejectRegistrationResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/platform/registrations/{user_id}/reject\x12\x80\x01\n" +
+	"\x14ListEmailDomainRules\x12\".proto.ListEmailDomainRulesRequest\x1a#.proto.ListEmailDomainRulesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/platform/email-domains\x12\x86\x01\n" +
+	"\x12SetEmailDomainRule\x12 .proto.SetEmailDomainRuleRequest\x1a!.proto.SetEmailDomainRuleResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\x1a /platform/email-domains/{domain}\x12\x8c\x01\n" +
+	"\x15DeleteEmailDomainRule\x12#.proto.DeleteEmailDomainRuleRequest\x1a$.proto.DeleteEmailDomainRuleResponse\"(\x82\xd3\xe4\x93\x02\"* /platform/email-domains/{domain}\x12\x80\x01\n" +
+	"\x10AdjustReputation\x12\x1e.proto.AdjustReputationRequest\x1a\x1f.proto.AdjustReputationResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /platform/reputation/adjustments\x12\x8f\x01\n" +
 	"\x11TransferOwnership\x12'.proto.TransferPlatformOwnershipRequest\x1a(.proto.TransferPlatformOwnershipResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/platform/transfer-ownership\x12\x8b\x01\n" +
 	"\x10ConfirmOwnership\x12&.proto.ConfirmPlatformOwnershipRequest\x1a'.proto.ConfirmPlatformOwnershipResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/platform/confirm-ownershipB\bZ\x06/protob\x06proto3"
 
@@ -898,47 +2847,126 @@ func file_platform_proto_rawDescGZIP() []byte {
 	return file_platform_proto_rawDescData
 }
 
-var file_platform_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_platform_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_platform_proto_goTypes = []any{
 	(*AutomaticBadgeSetting)(nil),             // 0: proto.AutomaticBadgeSetting
 	(*PlatformSettings)(nil),                  // 1: proto.PlatformSettings
 	(*PlatformStatistics)(nil),                // 2: proto.PlatformStatistics
-	(*GetSettingsRequest)(nil),                // 3: proto.GetSettingsRequest
-	(*GetSettingsResponse)(nil),               // 4: proto.GetSettingsResponse
-	(*UpdateSettingsRequest)(nil),             // 5: proto.UpdateSettingsRequest
-	(*UpdateSettingsResponse)(nil),            // 6: proto.UpdateSettingsResponse
-	(*GetPlatformStatisticsRequest)(nil),      // 7: proto.GetPlatformStatisticsRequest
-	(*GetPlatformStatisticsResponse)(nil),     // 8: proto.GetPlatformStatisticsResponse
-	(*TransferPlatformOwnershipRequest)(nil),  // 9: proto.TransferPlatformOwnershipRequest
-	(*TransferPlatformOwnershipResponse)(nil), // 10: proto.TransferPlatformOwnershipResponse
-	(*ConfirmPlatformOwnershipRequest)(nil),   // 11: proto.ConfirmPlatformOwnershipRequest
-	(*ConfirmPlatformOwnershipResponse)(nil),  // 12: proto.ConfirmPlatformOwnershipResponse
-	(*timestamppb.Timestamp)(nil),             // 13: google.protobuf.Timestamp
+	(*AnalyticsPoint)(nil),                    // 3: proto.AnalyticsPoint
+	(*LoginLockout)(nil),                      // 4: proto.LoginLockout
+	(*InviteCode)(nil),                        // 5: proto.InviteCode
+	(*EmailDomainRule)(nil),                   // 6: proto.EmailDomainRule
+	(*PendingRegistration)(nil),               // 7: proto.PendingRegistration
+	(*GetSettingsRequest)(nil),                // 8: proto.GetSettingsRequest
+	(*GetSettingsResponse)(nil),               // 9: proto.GetSettingsResponse
+	(*UpdateSettingsRequest)(nil),             // 10: proto.UpdateSettingsRequest
+	(*UpdateSettingsResponse)(nil),            // 11: proto.UpdateSettingsResponse
+	(*GetPlatformStatisticsRequest)(nil),      // 12: proto.GetPlatformStatisticsRequest
+	(*GetPlatformStatisticsResponse)(nil),     // 13: proto.GetPlatformStatisticsResponse
+	(*GetAnalyticsRequest)(nil),               // 14: proto.GetAnalyticsRequest
+	(*GetAnalyticsResponse)(nil),              // 15: proto.GetAnalyticsResponse
+	(*TransferPlatformOwnershipRequest)(nil),  // 16: proto.TransferPlatformOwnershipRequest
+	(*TransferPlatformOwnershipResponse)(nil), // 17: proto.TransferPlatformOwnershipResponse
+	(*ConfirmPlatformOwnershipRequest)(nil),   // 18: proto.ConfirmPlatformOwnershipRequest
+	(*ConfirmPlatformOwnershipResponse)(nil),  // 19: proto.ConfirmPlatformOwnershipResponse
+	(*ListLoginLockoutsRequest)(nil),          // 20: proto.ListLoginLockoutsRequest
+	(*ListLoginLockoutsResponse)(nil),         // 21: proto.ListLoginLockoutsResponse
+	(*GetRegistrationSettingsRequest)(nil),    // 22: proto.GetRegistrationSettingsRequest
+	(*GetRegistrationSettingsResponse)(nil),   // 23: proto.GetRegistrationSettingsResponse
+	(*UpdateRegistrationModeRequest)(nil),     // 24: proto.UpdateRegistrationModeRequest
+	(*UpdateRegistrationModeResponse)(nil),    // 25: proto.UpdateRegistrationModeResponse
+	(*CreateInviteCodeRequest)(nil),           // 26: proto.CreateInviteCodeRequest
+	(*CreateInviteCodeResponse)(nil),          // 27: proto.CreateInviteCodeResponse
+	(*ListInviteCodesRequest)(nil),            // 28: proto.ListInviteCodesRequest
+	(*ListInviteCodesResponse)(nil),           // 29: proto.ListInviteCodesResponse
+	(*RevokeInviteCodeRequest)(nil),           // 30: proto.RevokeInviteCodeRequest
+	(*RevokeInviteCodeResponse)(nil),          // 31: proto.RevokeInviteCodeResponse
+	(*ListPendingRegistrationsRequest)(nil),   // 32: proto.ListPendingRegistrationsRequest
+	(*ListPendingRegistrationsResponse)(nil),  // 33: proto.ListPendingRegistrationsResponse
+	(*ApproveRegistrationRequest)(nil),        // 34: proto.ApproveRegistrationRequest
+	(*ApproveRegistrationResponse)(nil),       // 35: proto.ApproveRegistrationResponse
+	(*RejectRegistrationRequest)(nil),         // 36: proto.RejectRegistrationRequest
+	(*RejectRegistrationResponse)(nil),        // 37: proto.RejectRegistrationResponse
+	(*ListEmailDomainRulesRequest)(nil),       // 38: proto.ListEmailDomainRulesRequest
+	(*ListEmailDomainRulesResponse)(nil),      // 39: proto.ListEmailDomainRulesResponse
+	(*SetEmailDomainRuleRequest)(nil),         // 40: proto.SetEmailDomainRuleRequest
+	(*SetEmailDomainRuleResponse)(nil),        // 41: proto.SetEmailDomainRuleResponse
+	(*DeleteEmailDomainRuleRequest)(nil),      // 42: proto.DeleteEmailDomainRuleRequest
+	(*DeleteEmailDomainRuleResponse)(nil),     // 43: proto.DeleteEmailDomainRuleResponse
+	(*AdjustReputationRequest)(nil),           // 44: proto.AdjustReputationRequest
+	(*AdjustReputationResponse)(nil),          // 45: proto.AdjustReputationResponse
+	(*timestamppb.Timestamp)(nil),             // 46: google.protobuf.Timestamp
 }
 var file_platform_proto_depIdxs = []int32{
-	13, // 0: proto.PlatformSettings.created_at:type_name -> google.protobuf.Timestamp
+	46, // 0: proto.PlatformSettings.created_at:type_name -> google.protobuf.Timestamp
 	0,  // 1: proto.PlatformSettings.badge_settings:type_name -> proto.AutomaticBadgeSetting
-	13, // 2: proto.PlatformStatistics.calculated_at:type_name -> google.protobuf.Timestamp
-	1,  // 3: proto.GetSettingsResponse.settings:type_name -> proto.PlatformSettings
-	0,  // 4: proto.UpdateSettingsRequest.automatic_badge_settings:type_name -> proto.AutomaticBadgeSetting
-	1,  // 5: proto.UpdateSettingsResponse.settings:type_name -> proto.PlatformSettings
-	2,  // 6: proto.GetPlatformStatisticsResponse.statistics:type_name -> proto.PlatformStatistics
-	1,  // 7: proto.ConfirmPlatformOwnershipResponse.settings:type_name -> proto.PlatformSettings
-	3,  // 8: proto.PlatformService.GetSettings:input_type -> proto.GetSettingsRequest
-	5,  // 9: proto.PlatformService.UpdateSettings:input_type -> proto.UpdateSettingsRequest
-	7,  // 10: proto.PlatformService.GetStatistics:input_type -> proto.GetPlatformStatisticsRequest
-	9,  // 11: proto.PlatformService.TransferOwnership:input_type -> proto.TransferPlatformOwnershipRequest
-	11, // 12: proto.PlatformService.ConfirmOwnership:input_type -> proto.ConfirmPlatformOwnershipRequest
-	4,  // 13: proto.PlatformService.GetSettings:output_type -> proto.GetSettingsResponse
-	6,  // 14: proto.PlatformService.UpdateSettings:output_type -> proto.UpdateSettingsResponse
-	8,  // 15: proto.PlatformService.GetStatistics:output_type -> proto.GetPlatformStatisticsResponse
-	10, // 16: proto.PlatformService.TransferOwnership:output_type -> proto.TransferPlatformOwnershipResponse
-	12, // 17: proto.PlatformService.ConfirmOwnership:output_type -> proto.ConfirmPlatformOwnershipResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	46, // 2: proto.PlatformStatistics.calculated_at:type_name -> google.protobuf.Timestamp
+	46, // 3: proto.AnalyticsPoint.bucket_start:type_name -> google.protobuf.Timestamp
+	46, // 4: proto.LoginLockout.locked_until:type_name -> google.protobuf.Timestamp
+	46, // 5: proto.LoginLockout.created_at:type_name -> google.protobuf.Timestamp
+	46, // 6: proto.InviteCode.expires_at:type_name -> google.protobuf.Timestamp
+	46, // 7: proto.InviteCode.revoked_at:type_name -> google.protobuf.Timestamp
+	46, // 8: proto.InviteCode.created_at:type_name -> google.protobuf.Timestamp
+	46, // 9: proto.EmailDomainRule.created_at:type_name -> google.protobuf.Timestamp
+	46, // 10: proto.PendingRegistration.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 11: proto.GetSettingsResponse.settings:type_name -> proto.PlatformSettings
+	0,  // 12: proto.UpdateSettingsRequest.automatic_badge_settings:type_name -> proto.AutomaticBadgeSetting
+	1,  // 13: proto.UpdateSettingsResponse.settings:type_name -> proto.PlatformSettings
+	2,  // 14: proto.GetPlatformStatisticsResponse.statistics:type_name -> proto.PlatformStatistics
+	46, // 15: proto.GetAnalyticsRequest.from:type_name -> google.protobuf.Timestamp
+	46, // 16: proto.GetAnalyticsRequest.to:type_name -> google.protobuf.Timestamp
+	3,  // 17: proto.GetAnalyticsResponse.points:type_name -> proto.AnalyticsPoint
+	1,  // 18: proto.ConfirmPlatformOwnershipResponse.settings:type_name -> proto.PlatformSettings
+	4,  // 19: proto.ListLoginLockoutsResponse.lockouts:type_name -> proto.LoginLockout
+	46, // 20: proto.CreateInviteCodeRequest.expires_at:type_name -> google.protobuf.Timestamp
+	5,  // 21: proto.CreateInviteCodeResponse.invite:type_name -> proto.InviteCode
+	5,  // 22: proto.ListInviteCodesResponse.invites:type_name -> proto.InviteCode
+	7,  // 23: proto.ListPendingRegistrationsResponse.registrations:type_name -> proto.PendingRegistration
+	6,  // 24: proto.ListEmailDomainRulesResponse.rules:type_name -> proto.EmailDomainRule
+	6,  // 25: proto.SetEmailDomainRuleResponse.rule:type_name -> proto.EmailDomainRule
+	8,  // 26: proto.PlatformService.GetSettings:input_type -> proto.GetSettingsRequest
+	10, // 27: proto.PlatformService.UpdateSettings:input_type -> proto.UpdateSettingsRequest
+	12, // 28: proto.PlatformService.GetStatistics:input_type -> proto.GetPlatformStatisticsRequest
+	14, // 29: proto.PlatformService.GetAnalytics:input_type -> proto.GetAnalyticsRequest
+	20, // 30: proto.PlatformService.ListLoginLockouts:input_type -> proto.ListLoginLockoutsRequest
+	22, // 31: proto.PlatformService.GetRegistrationSettings:input_type -> proto.GetRegistrationSettingsRequest
+	24, // 32: proto.PlatformService.UpdateRegistrationMode:input_type -> proto.UpdateRegistrationModeRequest
+	26, // 33: proto.PlatformService.CreateInviteCode:input_type -> proto.CreateInviteCodeRequest
+	28, // 34: proto.PlatformService.ListInviteCodes:input_type -> proto.ListInviteCodesRequest
+	30, // 35: proto.PlatformService.RevokeInviteCode:input_type -> proto.RevokeInviteCodeRequest
+	32, // 36: proto.PlatformService.ListPendingRegistrations:input_type -> proto.ListPendingRegistrationsRequest
+	34, // 37: proto.PlatformService.ApproveRegistration:input_type -> proto.ApproveRegistrationRequest
+	36, // 38: proto.PlatformService.RejectRegistration:input_type -> proto.RejectRegistrationRequest
+	38, // 39: proto.PlatformService.ListEmailDomainRules:input_type -> proto.ListEmailDomainRulesRequest
+	40, // 40: proto.PlatformService.SetEmailDomainRule:input_type -> proto.SetEmailDomainRuleRequest
+	42, // 41: proto.PlatformService.DeleteEmailDomainRule:input_type -> proto.DeleteEmailDomainRuleRequest
+	44, // 42: proto.PlatformService.AdjustReputation:input_type -> proto.AdjustReputationRequest
+	16, // 43: proto.PlatformService.TransferOwnership:input_type -> proto.TransferPlatformOwnershipRequest
+	18, // 44: proto.PlatformService.ConfirmOwnership:input_type -> proto.ConfirmPlatformOwnershipRequest
+	9,  // 45: proto.PlatformService.GetSettings:output_type -> proto.GetSettingsResponse
+	11, // 46: proto.PlatformService.UpdateSettings:output_type -> proto.UpdateSettingsResponse
+	13, // 47: proto.PlatformService.GetStatistics:output_type -> proto.GetPlatformStatisticsResponse
+	15, // 48: proto.PlatformService.GetAnalytics:output_type -> proto.GetAnalyticsResponse
+	21, // 49: proto.PlatformService.ListLoginLockouts:output_type -> proto.ListLoginLockoutsResponse
+	23, // 50: proto.PlatformService.GetRegistrationSettings:output_type -> proto.GetRegistrationSettingsResponse
+	25, // 51: proto.PlatformService.UpdateRegistrationMode:output_type -> proto.UpdateRegistrationModeResponse
+	27, // 52: proto.PlatformService.CreateInviteCode:output_type -> proto.CreateInviteCodeResponse
+	29, // 53: proto.PlatformService.ListInviteCodes:output_type -> proto.ListInviteCodesResponse
+	31, // 54: proto.PlatformService.RevokeInviteCode:output_type -> proto.RevokeInviteCodeResponse
+	33, // 55: proto.PlatformService.ListPendingRegistrations:output_type -> proto.ListPendingRegistrationsResponse
+	35, // 56: proto.PlatformService.ApproveRegistration:output_type -> proto.ApproveRegistrationResponse
+	37, // 57: proto.PlatformService.RejectRegistration:output_type -> proto.RejectRegistrationResponse
+	39, // 58: proto.PlatformService.ListEmailDomainRules:output_type -> proto.ListEmailDomainRulesResponse
+	41, // 59: proto.PlatformService.SetEmailDomainRule:output_type -> proto.SetEmailDomainRuleResponse
+	43, // 60: proto.PlatformService.DeleteEmailDomainRule:output_type -> proto.DeleteEmailDomainRuleResponse
+	45, // 61: proto.PlatformService.AdjustReputation:output_type -> proto.AdjustReputationResponse
+	17, // 62: proto.PlatformService.TransferOwnership:output_type -> proto.TransferPlatformOwnershipResponse
+	19, // 63: proto.PlatformService.ConfirmOwnership:output_type -> proto.ConfirmPlatformOwnershipResponse
+	45, // [45:64] is the sub-list for method output_type
+	26, // [26:45] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_platform_proto_init() }
@@ -946,14 +2974,14 @@ func file_platform_proto_init() {
 	if File_platform_proto != nil {
 		return
 	}
-	file_platform_proto_msgTypes[5].OneofWrappers = []any{}
+	file_platform_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_platform_proto_rawDesc), len(file_platform_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
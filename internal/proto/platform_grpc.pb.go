@@ -19,11 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PlatformService_GetSettings_FullMethodName       = "/proto.PlatformService/GetSettings"
-	PlatformService_UpdateSettings_FullMethodName    = "/proto.PlatformService/UpdateSettings"
-	PlatformService_GetStatistics_FullMethodName     = "/proto.PlatformService/GetStatistics"
-	PlatformService_TransferOwnership_FullMethodName = "/proto.PlatformService/TransferOwnership"
-	PlatformService_ConfirmOwnership_FullMethodName  = "/proto.PlatformService/ConfirmOwnership"
+	PlatformService_GetSettings_FullMethodName              = "/proto.PlatformService/GetSettings"
+	PlatformService_UpdateSettings_FullMethodName           = "/proto.PlatformService/UpdateSettings"
+	PlatformService_GetStatistics_FullMethodName            = "/proto.PlatformService/GetStatistics"
+	PlatformService_GetAnalytics_FullMethodName             = "/proto.PlatformService/GetAnalytics"
+	PlatformService_ListLoginLockouts_FullMethodName        = "/proto.PlatformService/ListLoginLockouts"
+	PlatformService_GetRegistrationSettings_FullMethodName  = "/proto.PlatformService/GetRegistrationSettings"
+	PlatformService_UpdateRegistrationMode_FullMethodName   = "/proto.PlatformService/UpdateRegistrationMode"
+	PlatformService_CreateInviteCode_FullMethodName         = "/proto.PlatformService/CreateInviteCode"
+	PlatformService_ListInviteCodes_FullMethodName          = "/proto.PlatformService/ListInviteCodes"
+	PlatformService_RevokeInviteCode_FullMethodName         = "/proto.PlatformService/RevokeInviteCode"
+	PlatformService_ListPendingRegistrations_FullMethodName = "/proto.PlatformService/ListPendingRegistrations"
+	PlatformService_ApproveRegistration_FullMethodName      = "/proto.PlatformService/ApproveRegistration"
+	PlatformService_RejectRegistration_FullMethodName       = "/proto.PlatformService/RejectRegistration"
+	PlatformService_ListEmailDomainRules_FullMethodName     = "/proto.PlatformService/ListEmailDomainRules"
+	PlatformService_SetEmailDomainRule_FullMethodName       = "/proto.PlatformService/SetEmailDomainRule"
+	PlatformService_DeleteEmailDomainRule_FullMethodName    = "/proto.PlatformService/DeleteEmailDomainRule"
+	PlatformService_AdjustReputation_FullMethodName         = "/proto.PlatformService/AdjustReputation"
+	PlatformService_TransferOwnership_FullMethodName        = "/proto.PlatformService/TransferOwnership"
+	PlatformService_ConfirmOwnership_FullMethodName         = "/proto.PlatformService/ConfirmOwnership"
 )
 
 // PlatformServiceClient is the client API for PlatformService service.
@@ -35,6 +49,24 @@ type PlatformServiceClient interface {
 	UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*UpdateSettingsResponse, error)
 	// Statistics Operations
 	GetStatistics(ctx context.Context, in *GetPlatformStatisticsRequest, opts ...grpc.CallOption) (*GetPlatformStatisticsResponse, error)
+	GetAnalytics(ctx context.Context, in *GetAnalyticsRequest, opts ...grpc.CallOption) (*GetAnalyticsResponse, error)
+	// Security Operations
+	ListLoginLockouts(ctx context.Context, in *ListLoginLockoutsRequest, opts ...grpc.CallOption) (*ListLoginLockoutsResponse, error)
+	// Registration Operations
+	GetRegistrationSettings(ctx context.Context, in *GetRegistrationSettingsRequest, opts ...grpc.CallOption) (*GetRegistrationSettingsResponse, error)
+	UpdateRegistrationMode(ctx context.Context, in *UpdateRegistrationModeRequest, opts ...grpc.CallOption) (*UpdateRegistrationModeResponse, error)
+	CreateInviteCode(ctx context.Context, in *CreateInviteCodeRequest, opts ...grpc.CallOption) (*CreateInviteCodeResponse, error)
+	ListInviteCodes(ctx context.Context, in *ListInviteCodesRequest, opts ...grpc.CallOption) (*ListInviteCodesResponse, error)
+	RevokeInviteCode(ctx context.Context, in *RevokeInviteCodeRequest, opts ...grpc.CallOption) (*RevokeInviteCodeResponse, error)
+	ListPendingRegistrations(ctx context.Context, in *ListPendingRegistrationsRequest, opts ...grpc.CallOption) (*ListPendingRegistrationsResponse, error)
+	ApproveRegistration(ctx context.Context, in *ApproveRegistrationRequest, opts ...grpc.CallOption) (*ApproveRegistrationResponse, error)
+	RejectRegistration(ctx context.Context, in *RejectRegistrationRequest, opts ...grpc.CallOption) (*RejectRegistrationResponse, error)
+	// Email Domain Policy Operations
+	ListEmailDomainRules(ctx context.Context, in *ListEmailDomainRulesRequest, opts ...grpc.CallOption) (*ListEmailDomainRulesResponse, error)
+	SetEmailDomainRule(ctx context.Context, in *SetEmailDomainRuleRequest, opts ...grpc.CallOption) (*SetEmailDomainRuleResponse, error)
+	DeleteEmailDomainRule(ctx context.Context, in *DeleteEmailDomainRuleRequest, opts ...grpc.CallOption) (*DeleteEmailDomainRuleResponse, error)
+	// Reputation Operations
+	AdjustReputation(ctx context.Context, in *AdjustReputationRequest, opts ...grpc.CallOption) (*AdjustReputationResponse, error)
 	// Ownership Operations
 	TransferOwnership(ctx context.Context, in *TransferPlatformOwnershipRequest, opts ...grpc.CallOption) (*TransferPlatformOwnershipResponse, error)
 	ConfirmOwnership(ctx context.Context, in *ConfirmPlatformOwnershipRequest, opts ...grpc.CallOption) (*ConfirmPlatformOwnershipResponse, error)
@@ -78,6 +110,146 @@ func (c *platformServiceClient) GetStatistics(ctx context.Context, in *GetPlatfo
 	return out, nil
 }
 
+func (c *platformServiceClient) GetAnalytics(ctx context.Context, in *GetAnalyticsRequest, opts ...grpc.CallOption) (*GetAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAnalyticsResponse)
+	err := c.cc.Invoke(ctx, PlatformService_GetAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformServiceClient) ListLoginLockouts(ctx context.Context, in *ListLoginLockoutsRequest, opts ...grpc.CallOption) (*ListLoginLockoutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoginLockoutsResponse)
+	err := c.cc.Invoke(ctx, PlatformService_ListLoginLockouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformServiceClient) GetRegistrationSettings(ctx context.Context, in *GetRegistrationSettingsRequest, opts ...grpc.CallOption) (*GetRegistrationSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRegistrationSettingsResponse)
+	err := c.cc.Invoke(ctx, PlatformService_GetRegistrationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformServiceClient) UpdateRegistrationMode(ctx context.Context, in *UpdateRegistrationModeRequest, opts ...grpc.CallOption) (*UpdateRegistrationModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRegistrationModeResponse)
+	err := c.cc.Invoke(ctx, PlatformService_UpdateRegistrationMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformServiceClient) CreateInviteCode(ctx context.Context, in *CreateInviteCodeRequest, opts ...grpc.CallOption) (*CreateInviteCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInviteCodeResponse)
+	err := c.cc.Invoke(ctx, PlatformService_CreateInviteCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformServiceClient) ListInviteCodes(ctx context.Context, in *ListInviteCodesRequest, opts ...grpc.CallOption) (*ListInviteCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInviteCodesResponse)
+	err := c.cc.Invoke(ctx, PlatformService_ListInviteCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformServiceClient) RevokeInviteCode(ctx context.Context, in *RevokeInviteCodeRequest, opts ...grpc.CallOption) (*RevokeInviteCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeInviteCodeResponse)
+	err := c.cc.Invoke(ctx, PlatformService_RevokeInviteCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformServiceClient) ListPendingRegistrations(ctx context.Context, in *ListPendingRegistrationsRequest, opts ...grpc.CallOption) (*ListPendingRegistrationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingRegistrationsResponse)
+	err := c.cc.Invoke(ctx, PlatformService_ListPendingRegistrations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformServiceClient) ApproveRegistration(ctx context.Context, in *ApproveRegistrationRequest, opts ...grpc.CallOption) (*ApproveRegistrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveRegistrationResponse)
+	err := c.cc.Invoke(ctx, PlatformService_ApproveRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformServiceClient) RejectRegistration(ctx context.Context, in *RejectRegistrationRequest, opts ...grpc.CallOption) (*RejectRegistrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectRegistrationResponse)
+	err := c.cc.Invoke(ctx, PlatformService_RejectRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformServiceClient) ListEmailDomainRules(ctx context.Context, in *ListEmailDomainRulesRequest, opts ...grpc.CallOption) (*ListEmailDomainRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmailDomainRulesResponse)
+	err := c.cc.Invoke(ctx, PlatformService_ListEmailDomainRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformServiceClient) SetEmailDomainRule(ctx context.Context, in *SetEmailDomainRuleRequest, opts ...grpc.CallOption) (*SetEmailDomainRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEmailDomainRuleResponse)
+	err := c.cc.Invoke(ctx, PlatformService_SetEmailDomainRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformServiceClient) DeleteEmailDomainRule(ctx context.Context, in *DeleteEmailDomainRuleRequest, opts ...grpc.CallOption) (*DeleteEmailDomainRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEmailDomainRuleResponse)
+	err := c.cc.Invoke(ctx, PlatformService_DeleteEmailDomainRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformServiceClient) AdjustReputation(ctx context.Context, in *AdjustReputationRequest, opts ...grpc.CallOption) (*AdjustReputationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustReputationResponse)
+	err := c.cc.Invoke(ctx, PlatformService_AdjustReputation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *platformServiceClient) TransferOwnership(ctx context.Context, in *TransferPlatformOwnershipRequest, opts ...grpc.CallOption) (*TransferPlatformOwnershipResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TransferPlatformOwnershipResponse)
@@ -107,6 +279,24 @@ type PlatformServiceServer interface {
 	UpdateSettings(context.Context, *UpdateSettingsRequest) (*UpdateSettingsResponse, error)
 	// Statistics Operations
 	GetStatistics(context.Context, *GetPlatformStatisticsRequest) (*GetPlatformStatisticsResponse, error)
+	GetAnalytics(context.Context, *GetAnalyticsRequest) (*GetAnalyticsResponse, error)
+	// Security Operations
+	ListLoginLockouts(context.Context, *ListLoginLockoutsRequest) (*ListLoginLockoutsResponse, error)
+	// Registration Operations
+	GetRegistrationSettings(context.Context, *GetRegistrationSettingsRequest) (*GetRegistrationSettingsResponse, error)
+	UpdateRegistrationMode(context.Context, *UpdateRegistrationModeRequest) (*UpdateRegistrationModeResponse, error)
+	CreateInviteCode(context.Context, *CreateInviteCodeRequest) (*CreateInviteCodeResponse, error)
+	ListInviteCodes(context.Context, *ListInviteCodesRequest) (*ListInviteCodesResponse, error)
+	RevokeInviteCode(context.Context, *RevokeInviteCodeRequest) (*RevokeInviteCodeResponse, error)
+	ListPendingRegistrations(context.Context, *ListPendingRegistrationsRequest) (*ListPendingRegistrationsResponse, error)
+	ApproveRegistration(context.Context, *ApproveRegistrationRequest) (*ApproveRegistrationResponse, error)
+	RejectRegistration(context.Context, *RejectRegistrationRequest) (*RejectRegistrationResponse, error)
+	// Email Domain Policy Operations
+	ListEmailDomainRules(context.Context, *ListEmailDomainRulesRequest) (*ListEmailDomainRulesResponse, error)
+	SetEmailDomainRule(context.Context, *SetEmailDomainRuleRequest) (*SetEmailDomainRuleResponse, error)
+	DeleteEmailDomainRule(context.Context, *DeleteEmailDomainRuleRequest) (*DeleteEmailDomainRuleResponse, error)
+	// Reputation Operations
+	AdjustReputation(context.Context, *AdjustReputationRequest) (*AdjustReputationResponse, error)
 	// Ownership Operations
 	TransferOwnership(context.Context, *TransferPlatformOwnershipRequest) (*TransferPlatformOwnershipResponse, error)
 	ConfirmOwnership(context.Context, *ConfirmPlatformOwnershipRequest) (*ConfirmPlatformOwnershipResponse, error)
@@ -129,6 +319,48 @@ func (UnimplementedPlatformServiceServer) UpdateSettings(context.Context, *Updat
 func (UnimplementedPlatformServiceServer) GetStatistics(context.Context, *GetPlatformStatisticsRequest) (*GetPlatformStatisticsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStatistics not implemented")
 }
+func (UnimplementedPlatformServiceServer) GetAnalytics(context.Context, *GetAnalyticsRequest) (*GetAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnalytics not implemented")
+}
+func (UnimplementedPlatformServiceServer) ListLoginLockouts(context.Context, *ListLoginLockoutsRequest) (*ListLoginLockoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoginLockouts not implemented")
+}
+func (UnimplementedPlatformServiceServer) GetRegistrationSettings(context.Context, *GetRegistrationSettingsRequest) (*GetRegistrationSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegistrationSettings not implemented")
+}
+func (UnimplementedPlatformServiceServer) UpdateRegistrationMode(context.Context, *UpdateRegistrationModeRequest) (*UpdateRegistrationModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRegistrationMode not implemented")
+}
+func (UnimplementedPlatformServiceServer) CreateInviteCode(context.Context, *CreateInviteCodeRequest) (*CreateInviteCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInviteCode not implemented")
+}
+func (UnimplementedPlatformServiceServer) ListInviteCodes(context.Context, *ListInviteCodesRequest) (*ListInviteCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInviteCodes not implemented")
+}
+func (UnimplementedPlatformServiceServer) RevokeInviteCode(context.Context, *RevokeInviteCodeRequest) (*RevokeInviteCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInviteCode not implemented")
+}
+func (UnimplementedPlatformServiceServer) ListPendingRegistrations(context.Context, *ListPendingRegistrationsRequest) (*ListPendingRegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingRegistrations not implemented")
+}
+func (UnimplementedPlatformServiceServer) ApproveRegistration(context.Context, *ApproveRegistrationRequest) (*ApproveRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRegistration not implemented")
+}
+func (UnimplementedPlatformServiceServer) RejectRegistration(context.Context, *RejectRegistrationRequest) (*RejectRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRegistration not implemented")
+}
+func (UnimplementedPlatformServiceServer) ListEmailDomainRules(context.Context, *ListEmailDomainRulesRequest) (*ListEmailDomainRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmailDomainRules not implemented")
+}
+func (UnimplementedPlatformServiceServer) SetEmailDomainRule(context.Context, *SetEmailDomainRuleRequest) (*SetEmailDomainRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEmailDomainRule not implemented")
+}
+func (UnimplementedPlatformServiceServer) DeleteEmailDomainRule(context.Context, *DeleteEmailDomainRuleRequest) (*DeleteEmailDomainRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmailDomainRule not implemented")
+}
+func (UnimplementedPlatformServiceServer) AdjustReputation(context.Context, *AdjustReputationRequest) (*AdjustReputationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustReputation not implemented")
+}
 func (UnimplementedPlatformServiceServer) TransferOwnership(context.Context, *TransferPlatformOwnershipRequest) (*TransferPlatformOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
 }
@@ -210,6 +442,258 @@ func _PlatformService_GetStatistics_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlatformService_GetAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformServiceServer).GetAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformService_GetAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformServiceServer).GetAnalytics(ctx, req.(*GetAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformService_ListLoginLockouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoginLockoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformServiceServer).ListLoginLockouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformService_ListLoginLockouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformServiceServer).ListLoginLockouts(ctx, req.(*ListLoginLockoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformService_GetRegistrationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegistrationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformServiceServer).GetRegistrationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformService_GetRegistrationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformServiceServer).GetRegistrationSettings(ctx, req.(*GetRegistrationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformService_UpdateRegistrationMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRegistrationModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformServiceServer).UpdateRegistrationMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformService_UpdateRegistrationMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformServiceServer).UpdateRegistrationMode(ctx, req.(*UpdateRegistrationModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformService_CreateInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformServiceServer).CreateInviteCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformService_CreateInviteCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformServiceServer).CreateInviteCode(ctx, req.(*CreateInviteCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformService_ListInviteCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInviteCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformServiceServer).ListInviteCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformService_ListInviteCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformServiceServer).ListInviteCodes(ctx, req.(*ListInviteCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformService_RevokeInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformServiceServer).RevokeInviteCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformService_RevokeInviteCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformServiceServer).RevokeInviteCode(ctx, req.(*RevokeInviteCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformService_ListPendingRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformServiceServer).ListPendingRegistrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformService_ListPendingRegistrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformServiceServer).ListPendingRegistrations(ctx, req.(*ListPendingRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformService_ApproveRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformServiceServer).ApproveRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformService_ApproveRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformServiceServer).ApproveRegistration(ctx, req.(*ApproveRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformService_RejectRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformServiceServer).RejectRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformService_RejectRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformServiceServer).RejectRegistration(ctx, req.(*RejectRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformService_ListEmailDomainRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmailDomainRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformServiceServer).ListEmailDomainRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformService_ListEmailDomainRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformServiceServer).ListEmailDomainRules(ctx, req.(*ListEmailDomainRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformService_SetEmailDomainRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEmailDomainRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformServiceServer).SetEmailDomainRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformService_SetEmailDomainRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformServiceServer).SetEmailDomainRule(ctx, req.(*SetEmailDomainRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformService_DeleteEmailDomainRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmailDomainRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformServiceServer).DeleteEmailDomainRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformService_DeleteEmailDomainRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformServiceServer).DeleteEmailDomainRule(ctx, req.(*DeleteEmailDomainRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformService_AdjustReputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustReputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformServiceServer).AdjustReputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformService_AdjustReputation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformServiceServer).AdjustReputation(ctx, req.(*AdjustReputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PlatformService_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TransferPlatformOwnershipRequest)
 	if err := dec(in); err != nil {
@@ -265,6 +749,62 @@ var PlatformService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStatistics",
 			Handler:    _PlatformService_GetStatistics_Handler,
 		},
+		{
+			MethodName: "GetAnalytics",
+			Handler:    _PlatformService_GetAnalytics_Handler,
+		},
+		{
+			MethodName: "ListLoginLockouts",
+			Handler:    _PlatformService_ListLoginLockouts_Handler,
+		},
+		{
+			MethodName: "GetRegistrationSettings",
+			Handler:    _PlatformService_GetRegistrationSettings_Handler,
+		},
+		{
+			MethodName: "UpdateRegistrationMode",
+			Handler:    _PlatformService_UpdateRegistrationMode_Handler,
+		},
+		{
+			MethodName: "CreateInviteCode",
+			Handler:    _PlatformService_CreateInviteCode_Handler,
+		},
+		{
+			MethodName: "ListInviteCodes",
+			Handler:    _PlatformService_ListInviteCodes_Handler,
+		},
+		{
+			MethodName: "RevokeInviteCode",
+			Handler:    _PlatformService_RevokeInviteCode_Handler,
+		},
+		{
+			MethodName: "ListPendingRegistrations",
+			Handler:    _PlatformService_ListPendingRegistrations_Handler,
+		},
+		{
+			MethodName: "ApproveRegistration",
+			Handler:    _PlatformService_ApproveRegistration_Handler,
+		},
+		{
+			MethodName: "RejectRegistration",
+			Handler:    _PlatformService_RejectRegistration_Handler,
+		},
+		{
+			MethodName: "ListEmailDomainRules",
+			Handler:    _PlatformService_ListEmailDomainRules_Handler,
+		},
+		{
+			MethodName: "SetEmailDomainRule",
+			Handler:    _PlatformService_SetEmailDomainRule_Handler,
+		},
+		{
+			MethodName: "DeleteEmailDomainRule",
+			Handler:    _PlatformService_DeleteEmailDomainRule_Handler,
+		},
+		{
+			MethodName: "AdjustReputation",
+			Handler:    _PlatformService_AdjustReputation_Handler,
+		},
 		{
 			MethodName: "TransferOwnership",
 			Handler:    _PlatformService_TransferOwnership_Handler,
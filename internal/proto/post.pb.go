@@ -306,6 +306,7 @@ func (*UpdatePostResponse) Descriptor() ([]byte, []int) {
 type DeletePostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // Shown to moderators, optional
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -347,6 +348,13 @@ func (x *DeletePostRequest) GetPostId() string {
 	return ""
 }
 
+func (x *DeletePostRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type DeletePostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -383,6 +391,86 @@ func (*DeletePostResponse) Descriptor() ([]byte, []int) {
 	return file_post_proto_rawDescGZIP(), []int{7}
 }
 
+type RestorePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePostRequest) Reset() {
+	*x = RestorePostRequest{}
+	mi := &file_post_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostRequest) ProtoMessage() {}
+
+func (x *RestorePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostRequest.ProtoReflect.Descriptor instead.
+func (*RestorePostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RestorePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type RestorePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePostResponse) Reset() {
+	*x = RestorePostResponse{}
+	mi := &file_post_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostResponse) ProtoMessage() {}
+
+func (x *RestorePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostResponse.ProtoReflect.Descriptor instead.
+func (*RestorePostResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{9}
+}
+
 type ListPostCommentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
@@ -394,7 +482,7 @@ type ListPostCommentsRequest struct {
 
 func (x *ListPostCommentsRequest) Reset() {
 	*x = ListPostCommentsRequest{}
-	mi := &file_post_proto_msgTypes[8]
+	mi := &file_post_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -406,7 +494,7 @@ func (x *ListPostCommentsRequest) String() string {
 func (*ListPostCommentsRequest) ProtoMessage() {}
 
 func (x *ListPostCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[8]
+	mi := &file_post_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +507,7 @@ func (x *ListPostCommentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPostCommentsRequest.ProtoReflect.Descriptor instead.
 func (*ListPostCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{8}
+	return file_post_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListPostCommentsRequest) GetPostId() string {
@@ -448,13 +536,14 @@ type ListPostCommentsResponse struct {
 	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
 	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,4,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListPostCommentsResponse) Reset() {
 	*x = ListPostCommentsResponse{}
-	mi := &file_post_proto_msgTypes[9]
+	mi := &file_post_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -466,7 +555,7 @@ func (x *ListPostCommentsResponse) String() string {
 func (*ListPostCommentsResponse) ProtoMessage() {}
 
 func (x *ListPostCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[9]
+	mi := &file_post_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -479,7 +568,7 @@ func (x *ListPostCommentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPostCommentsResponse.ProtoReflect.Descriptor instead.
 func (*ListPostCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{9}
+	return file_post_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListPostCommentsResponse) GetComments() []*Comment {
@@ -503,6 +592,13 @@ func (x *ListPostCommentsResponse) GetHasMore() bool {
 	return false
 }
 
+func (x *ListPostCommentsResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
 type PublishPostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
@@ -512,7 +608,7 @@ type PublishPostRequest struct {
 
 func (x *PublishPostRequest) Reset() {
 	*x = PublishPostRequest{}
-	mi := &file_post_proto_msgTypes[10]
+	mi := &file_post_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -524,7 +620,7 @@ func (x *PublishPostRequest) String() string {
 func (*PublishPostRequest) ProtoMessage() {}
 
 func (x *PublishPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[10]
+	mi := &file_post_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +633,7 @@ func (x *PublishPostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishPostRequest.ProtoReflect.Descriptor instead.
 func (*PublishPostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{10}
+	return file_post_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PublishPostRequest) GetPostId() string {
@@ -556,7 +652,7 @@ type PublishPostResponse struct {
 
 func (x *PublishPostResponse) Reset() {
 	*x = PublishPostResponse{}
-	mi := &file_post_proto_msgTypes[11]
+	mi := &file_post_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -568,7 +664,7 @@ func (x *PublishPostResponse) String() string {
 func (*PublishPostResponse) ProtoMessage() {}
 
 func (x *PublishPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[11]
+	mi := &file_post_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,7 +677,7 @@ func (x *PublishPostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishPostResponse.ProtoReflect.Descriptor instead.
 func (*PublishPostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{11}
+	return file_post_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PublishPostResponse) GetPost() *Post {
@@ -600,7 +696,7 @@ type UnpublishPostRequest struct {
 
 func (x *UnpublishPostRequest) Reset() {
 	*x = UnpublishPostRequest{}
-	mi := &file_post_proto_msgTypes[12]
+	mi := &file_post_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -612,7 +708,7 @@ func (x *UnpublishPostRequest) String() string {
 func (*UnpublishPostRequest) ProtoMessage() {}
 
 func (x *UnpublishPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[12]
+	mi := &file_post_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,7 +721,7 @@ func (x *UnpublishPostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnpublishPostRequest.ProtoReflect.Descriptor instead.
 func (*UnpublishPostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{12}
+	return file_post_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UnpublishPostRequest) GetPostId() string {
@@ -644,7 +740,7 @@ type UnpublishPostResponse struct {
 
 func (x *UnpublishPostResponse) Reset() {
 	*x = UnpublishPostResponse{}
-	mi := &file_post_proto_msgTypes[13]
+	mi := &file_post_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -656,7 +752,7 @@ func (x *UnpublishPostResponse) String() string {
 func (*UnpublishPostResponse) ProtoMessage() {}
 
 func (x *UnpublishPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[13]
+	mi := &file_post_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,7 +765,7 @@ func (x *UnpublishPostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnpublishPostResponse.ProtoReflect.Descriptor instead.
 func (*UnpublishPostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{13}
+	return file_post_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UnpublishPostResponse) GetPost() *Post {
@@ -688,7 +784,7 @@ type LikePostRequest struct {
 
 func (x *LikePostRequest) Reset() {
 	*x = LikePostRequest{}
-	mi := &file_post_proto_msgTypes[14]
+	mi := &file_post_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -700,7 +796,7 @@ func (x *LikePostRequest) String() string {
 func (*LikePostRequest) ProtoMessage() {}
 
 func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[14]
+	mi := &file_post_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -713,7 +809,7 @@ func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
 func (*LikePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{14}
+	return file_post_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *LikePostRequest) GetPostId() string {
@@ -733,7 +829,7 @@ type LikePostResponse struct {
 
 func (x *LikePostResponse) Reset() {
 	*x = LikePostResponse{}
-	mi := &file_post_proto_msgTypes[15]
+	mi := &file_post_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -745,7 +841,7 @@ func (x *LikePostResponse) String() string {
 func (*LikePostResponse) ProtoMessage() {}
 
 func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[15]
+	mi := &file_post_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,7 +854,7 @@ func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikePostResponse.ProtoReflect.Descriptor instead.
 func (*LikePostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{15}
+	return file_post_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *LikePostResponse) GetMessage() string {
@@ -784,7 +880,7 @@ type UnlikePostRequest struct {
 
 func (x *UnlikePostRequest) Reset() {
 	*x = UnlikePostRequest{}
-	mi := &file_post_proto_msgTypes[16]
+	mi := &file_post_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -796,7 +892,7 @@ func (x *UnlikePostRequest) String() string {
 func (*UnlikePostRequest) ProtoMessage() {}
 
 func (x *UnlikePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[16]
+	mi := &file_post_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,7 +905,7 @@ func (x *UnlikePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnlikePostRequest.ProtoReflect.Descriptor instead.
 func (*UnlikePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{16}
+	return file_post_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UnlikePostRequest) GetPostId() string {
@@ -829,7 +925,7 @@ type UnlikePostResponse struct {
 
 func (x *UnlikePostResponse) Reset() {
 	*x = UnlikePostResponse{}
-	mi := &file_post_proto_msgTypes[17]
+	mi := &file_post_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -841,7 +937,7 @@ func (x *UnlikePostResponse) String() string {
 func (*UnlikePostResponse) ProtoMessage() {}
 
 func (x *UnlikePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[17]
+	mi := &file_post_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,7 +950,7 @@ func (x *UnlikePostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnlikePostResponse.ProtoReflect.Descriptor instead.
 func (*UnlikePostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{17}
+	return file_post_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UnlikePostResponse) GetMessage() string {
@@ -880,7 +976,7 @@ type CreateBookmarkRequest struct {
 
 func (x *CreateBookmarkRequest) Reset() {
 	*x = CreateBookmarkRequest{}
-	mi := &file_post_proto_msgTypes[18]
+	mi := &file_post_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -892,7 +988,7 @@ func (x *CreateBookmarkRequest) String() string {
 func (*CreateBookmarkRequest) ProtoMessage() {}
 
 func (x *CreateBookmarkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[18]
+	mi := &file_post_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -905,7 +1001,7 @@ func (x *CreateBookmarkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBookmarkRequest.ProtoReflect.Descriptor instead.
 func (*CreateBookmarkRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{18}
+	return file_post_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CreateBookmarkRequest) GetPostId() string {
@@ -924,7 +1020,7 @@ type CreateBookmarkResponse struct {
 
 func (x *CreateBookmarkResponse) Reset() {
 	*x = CreateBookmarkResponse{}
-	mi := &file_post_proto_msgTypes[19]
+	mi := &file_post_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -936,7 +1032,7 @@ func (x *CreateBookmarkResponse) String() string {
 func (*CreateBookmarkResponse) ProtoMessage() {}
 
 func (x *CreateBookmarkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[19]
+	mi := &file_post_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -949,7 +1045,7 @@ func (x *CreateBookmarkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBookmarkResponse.ProtoReflect.Descriptor instead.
 func (*CreateBookmarkResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{19}
+	return file_post_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CreateBookmarkResponse) GetMessage() string {
@@ -968,7 +1064,7 @@ type DeleteBookmarkRequest struct {
 
 func (x *DeleteBookmarkRequest) Reset() {
 	*x = DeleteBookmarkRequest{}
-	mi := &file_post_proto_msgTypes[20]
+	mi := &file_post_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -980,7 +1076,7 @@ func (x *DeleteBookmarkRequest) String() string {
 func (*DeleteBookmarkRequest) ProtoMessage() {}
 
 func (x *DeleteBookmarkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[20]
+	mi := &file_post_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -993,7 +1089,7 @@ func (x *DeleteBookmarkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBookmarkRequest.ProtoReflect.Descriptor instead.
 func (*DeleteBookmarkRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{20}
+	return file_post_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DeleteBookmarkRequest) GetPostId() string {
@@ -1012,7 +1108,7 @@ type DeleteBookmarkResponse struct {
 
 func (x *DeleteBookmarkResponse) Reset() {
 	*x = DeleteBookmarkResponse{}
-	mi := &file_post_proto_msgTypes[21]
+	mi := &file_post_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1024,7 +1120,7 @@ func (x *DeleteBookmarkResponse) String() string {
 func (*DeleteBookmarkResponse) ProtoMessage() {}
 
 func (x *DeleteBookmarkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[21]
+	mi := &file_post_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1037,7 +1133,7 @@ func (x *DeleteBookmarkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBookmarkResponse.ProtoReflect.Descriptor instead.
 func (*DeleteBookmarkResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{21}
+	return file_post_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DeleteBookmarkResponse) GetMessage() string {
@@ -1057,7 +1153,7 @@ type ListBookmarksRequest struct {
 
 func (x *ListBookmarksRequest) Reset() {
 	*x = ListBookmarksRequest{}
-	mi := &file_post_proto_msgTypes[22]
+	mi := &file_post_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1069,7 +1165,7 @@ func (x *ListBookmarksRequest) String() string {
 func (*ListBookmarksRequest) ProtoMessage() {}
 
 func (x *ListBookmarksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[22]
+	mi := &file_post_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1082,7 +1178,7 @@ func (x *ListBookmarksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBookmarksRequest.ProtoReflect.Descriptor instead.
 func (*ListBookmarksRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{22}
+	return file_post_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListBookmarksRequest) GetCursor() string {
@@ -1104,13 +1200,14 @@ type ListBookmarksResponse struct {
 	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
 	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,4,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListBookmarksResponse) Reset() {
 	*x = ListBookmarksResponse{}
-	mi := &file_post_proto_msgTypes[23]
+	mi := &file_post_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1122,7 +1219,7 @@ func (x *ListBookmarksResponse) String() string {
 func (*ListBookmarksResponse) ProtoMessage() {}
 
 func (x *ListBookmarksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[23]
+	mi := &file_post_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,7 +1232,7 @@ func (x *ListBookmarksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBookmarksResponse.ProtoReflect.Descriptor instead.
 func (*ListBookmarksResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{23}
+	return file_post_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ListBookmarksResponse) GetPosts() []*Post {
@@ -1159,6 +1256,13 @@ func (x *ListBookmarksResponse) GetHasMore() bool {
 	return false
 }
 
+func (x *ListBookmarksResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
 var File_post_proto protoreflect.FileDescriptor
 
 const file_post_proto_rawDesc = "" +
@@ -1178,19 +1282,25 @@ const file_post_proto_rawDesc = "" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x121\n" +
 	"\acontent\x18\x03 \x01(\v2\x17.google.protobuf.StructR\acontent\"\x14\n" +
-	"\x12UpdatePostResponse\",\n" +
+	"\x12UpdatePostResponse\"D\n" +
 	"\x11DeletePostRequest\x12\x17\n" +
-	"\apost_id\x18\x01 \x01(\tR\x06postId\"\x14\n" +
-	"\x12DeletePostResponse\"`\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x14\n" +
+	"\x12DeletePostResponse\"-\n" +
+	"\x12RestorePostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\"\x15\n" +
+	"\x13RestorePostResponse\"`\n" +
 	"\x17ListPostCommentsRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x16\n" +
 	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x82\x01\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xa3\x01\n" +
 	"\x18ListPostCommentsResponse\x12*\n" +
 	"\bcomments\x18\x01 \x03(\v2\x0e.proto.CommentR\bcomments\x12\x1f\n" +
 	"\vnext_cursor\x18\x02 \x01(\tR\n" +
 	"nextCursor\x12\x19\n" +
-	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"-\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vprev_cursor\x18\x04 \x01(\tR\n" +
+	"prevCursor\"-\n" +
 	"\x12PublishPostRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\"6\n" +
 	"\x13PublishPostResponse\x12\x1f\n" +
@@ -1219,17 +1329,21 @@ const file_post_proto_rawDesc = "" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"D\n" +
 	"\x14ListBookmarksRequest\x12\x16\n" +
 	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"v\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x97\x01\n" +
 	"\x15ListBookmarksResponse\x12!\n" +
 	"\x05posts\x18\x01 \x03(\v2\v.proto.PostR\x05posts\x12\x1f\n" +
 	"\vnext_cursor\x18\x02 \x01(\tR\n" +
 	"nextCursor\x12\x19\n" +
-	"\bhas_more\x18\x03 \x01(\bR\ahasMore2\xa8\t\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vprev_cursor\x18\x04 \x01(\tR\n" +
+	"prevCursor2\x8c\n" +
+	"\n" +
 	"\vPostService\x12P\n" +
 	"\x06Create\x12\x18.proto.CreatePostRequest\x1a\x19.proto.CreatePostResponse\"\x11\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/posts\x12N\n" +
 	"\x03Get\x12\x15.proto.GetPostRequest\x1a\x16.proto.GetPostResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/posts/{post_id}\x12Z\n" +
 	"\x06Update\x12\x18.proto.UpdatePostRequest\x1a\x19.proto.UpdatePostResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*2\x10/posts/{post_id}\x12W\n" +
-	"\x06Delete\x12\x18.proto.DeletePostRequest\x1a\x19.proto.DeletePostResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/posts/{post_id}\x12r\n" +
+	"\x06Delete\x12\x18.proto.DeletePostRequest\x1a\x19.proto.DeletePostResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/posts/{post_id}\x12b\n" +
+	"\aRestore\x12\x19.proto.RestorePostRequest\x1a\x1a.proto.RestorePostResponse\" \x82\xd3\xe4\x93\x02\x1a\"\x18/posts/{post_id}/restore\x12r\n" +
 	"\fListComments\x12\x1e.proto.ListPostCommentsRequest\x1a\x1f.proto.ListPostCommentsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/posts/{post_id}/comments\x12b\n" +
 	"\aPublish\x12\x19.proto.PublishPostRequest\x1a\x1a.proto.PublishPostResponse\" \x82\xd3\xe4\x93\x02\x1a\"\x18/posts/{post_id}/publish\x12j\n" +
 	"\tUnpublish\x12\x1b.proto.UnpublishPostRequest\x1a\x1c.proto.UnpublishPostResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x1a/posts/{post_id}/unpublish\x12V\n" +
@@ -1251,7 +1365,7 @@ func file_post_proto_rawDescGZIP() []byte {
 	return file_post_proto_rawDescData
 }
 
-var file_post_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_post_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_post_proto_goTypes = []any{
 	(*CreatePostRequest)(nil),        // 0: proto.CreatePostRequest
 	(*CreatePostResponse)(nil),       // 1: proto.CreatePostResponse
@@ -1261,60 +1375,64 @@ var file_post_proto_goTypes = []any{
 	(*UpdatePostResponse)(nil),       // 5: proto.UpdatePostResponse
 	(*DeletePostRequest)(nil),        // 6: proto.DeletePostRequest
 	(*DeletePostResponse)(nil),       // 7: proto.DeletePostResponse
-	(*ListPostCommentsRequest)(nil),  // 8: proto.ListPostCommentsRequest
-	(*ListPostCommentsResponse)(nil), // 9: proto.ListPostCommentsResponse
-	(*PublishPostRequest)(nil),       // 10: proto.PublishPostRequest
-	(*PublishPostResponse)(nil),      // 11: proto.PublishPostResponse
-	(*UnpublishPostRequest)(nil),     // 12: proto.UnpublishPostRequest
-	(*UnpublishPostResponse)(nil),    // 13: proto.UnpublishPostResponse
-	(*LikePostRequest)(nil),          // 14: proto.LikePostRequest
-	(*LikePostResponse)(nil),         // 15: proto.LikePostResponse
-	(*UnlikePostRequest)(nil),        // 16: proto.UnlikePostRequest
-	(*UnlikePostResponse)(nil),       // 17: proto.UnlikePostResponse
-	(*CreateBookmarkRequest)(nil),    // 18: proto.CreateBookmarkRequest
-	(*CreateBookmarkResponse)(nil),   // 19: proto.CreateBookmarkResponse
-	(*DeleteBookmarkRequest)(nil),    // 20: proto.DeleteBookmarkRequest
-	(*DeleteBookmarkResponse)(nil),   // 21: proto.DeleteBookmarkResponse
-	(*ListBookmarksRequest)(nil),     // 22: proto.ListBookmarksRequest
-	(*ListBookmarksResponse)(nil),    // 23: proto.ListBookmarksResponse
-	(*structpb.Struct)(nil),          // 24: google.protobuf.Struct
-	(*Post)(nil),                     // 25: proto.Post
-	(*Comment)(nil),                  // 26: proto.Comment
+	(*RestorePostRequest)(nil),       // 8: proto.RestorePostRequest
+	(*RestorePostResponse)(nil),      // 9: proto.RestorePostResponse
+	(*ListPostCommentsRequest)(nil),  // 10: proto.ListPostCommentsRequest
+	(*ListPostCommentsResponse)(nil), // 11: proto.ListPostCommentsResponse
+	(*PublishPostRequest)(nil),       // 12: proto.PublishPostRequest
+	(*PublishPostResponse)(nil),      // 13: proto.PublishPostResponse
+	(*UnpublishPostRequest)(nil),     // 14: proto.UnpublishPostRequest
+	(*UnpublishPostResponse)(nil),    // 15: proto.UnpublishPostResponse
+	(*LikePostRequest)(nil),          // 16: proto.LikePostRequest
+	(*LikePostResponse)(nil),         // 17: proto.LikePostResponse
+	(*UnlikePostRequest)(nil),        // 18: proto.UnlikePostRequest
+	(*UnlikePostResponse)(nil),       // 19: proto.UnlikePostResponse
+	(*CreateBookmarkRequest)(nil),    // 20: proto.CreateBookmarkRequest
+	(*CreateBookmarkResponse)(nil),   // 21: proto.CreateBookmarkResponse
+	(*DeleteBookmarkRequest)(nil),    // 22: proto.DeleteBookmarkRequest
+	(*DeleteBookmarkResponse)(nil),   // 23: proto.DeleteBookmarkResponse
+	(*ListBookmarksRequest)(nil),     // 24: proto.ListBookmarksRequest
+	(*ListBookmarksResponse)(nil),    // 25: proto.ListBookmarksResponse
+	(*structpb.Struct)(nil),          // 26: google.protobuf.Struct
+	(*Post)(nil),                     // 27: proto.Post
+	(*Comment)(nil),                  // 28: proto.Comment
 }
 var file_post_proto_depIdxs = []int32{
-	24, // 0: proto.CreatePostRequest.content:type_name -> google.protobuf.Struct
-	25, // 1: proto.GetPostResponse.post:type_name -> proto.Post
-	24, // 2: proto.UpdatePostRequest.content:type_name -> google.protobuf.Struct
-	26, // 3: proto.ListPostCommentsResponse.comments:type_name -> proto.Comment
-	25, // 4: proto.PublishPostResponse.post:type_name -> proto.Post
-	25, // 5: proto.UnpublishPostResponse.post:type_name -> proto.Post
-	25, // 6: proto.ListBookmarksResponse.posts:type_name -> proto.Post
+	26, // 0: proto.CreatePostRequest.content:type_name -> google.protobuf.Struct
+	27, // 1: proto.GetPostResponse.post:type_name -> proto.Post
+	26, // 2: proto.UpdatePostRequest.content:type_name -> google.protobuf.Struct
+	28, // 3: proto.ListPostCommentsResponse.comments:type_name -> proto.Comment
+	27, // 4: proto.PublishPostResponse.post:type_name -> proto.Post
+	27, // 5: proto.UnpublishPostResponse.post:type_name -> proto.Post
+	27, // 6: proto.ListBookmarksResponse.posts:type_name -> proto.Post
 	0,  // 7: proto.PostService.Create:input_type -> proto.CreatePostRequest
 	2,  // 8: proto.PostService.Get:input_type -> proto.GetPostRequest
 	4,  // 9: proto.PostService.Update:input_type -> proto.UpdatePostRequest
 	6,  // 10: proto.PostService.Delete:input_type -> proto.DeletePostRequest
-	8,  // 11: proto.PostService.ListComments:input_type -> proto.ListPostCommentsRequest
-	10, // 12: proto.PostService.Publish:input_type -> proto.PublishPostRequest
-	12, // 13: proto.PostService.Unpublish:input_type -> proto.UnpublishPostRequest
-	14, // 14: proto.PostService.Like:input_type -> proto.LikePostRequest
-	16, // 15: proto.PostService.Unlike:input_type -> proto.UnlikePostRequest
-	18, // 16: proto.PostService.CreateBookmark:input_type -> proto.CreateBookmarkRequest
-	20, // 17: proto.PostService.DeleteBookmark:input_type -> proto.DeleteBookmarkRequest
-	22, // 18: proto.PostService.ListBookmarks:input_type -> proto.ListBookmarksRequest
-	1,  // 19: proto.PostService.Create:output_type -> proto.CreatePostResponse
-	3,  // 20: proto.PostService.Get:output_type -> proto.GetPostResponse
-	5,  // 21: proto.PostService.Update:output_type -> proto.UpdatePostResponse
-	7,  // 22: proto.PostService.Delete:output_type -> proto.DeletePostResponse
-	9,  // 23: proto.PostService.ListComments:output_type -> proto.ListPostCommentsResponse
-	11, // 24: proto.PostService.Publish:output_type -> proto.PublishPostResponse
-	13, // 25: proto.PostService.Unpublish:output_type -> proto.UnpublishPostResponse
-	15, // 26: proto.PostService.Like:output_type -> proto.LikePostResponse
-	17, // 27: proto.PostService.Unlike:output_type -> proto.UnlikePostResponse
-	19, // 28: proto.PostService.CreateBookmark:output_type -> proto.CreateBookmarkResponse
-	21, // 29: proto.PostService.DeleteBookmark:output_type -> proto.DeleteBookmarkResponse
-	23, // 30: proto.PostService.ListBookmarks:output_type -> proto.ListBookmarksResponse
-	19, // [19:31] is the sub-list for method output_type
-	7,  // [7:19] is the sub-list for method input_type
+	8,  // 11: proto.PostService.Restore:input_type -> proto.RestorePostRequest
+	10, // 12: proto.PostService.ListComments:input_type -> proto.ListPostCommentsRequest
+	12, // 13: proto.PostService.Publish:input_type -> proto.PublishPostRequest
+	14, // 14: proto.PostService.Unpublish:input_type -> proto.UnpublishPostRequest
+	16, // 15: proto.PostService.Like:input_type -> proto.LikePostRequest
+	18, // 16: proto.PostService.Unlike:input_type -> proto.UnlikePostRequest
+	20, // 17: proto.PostService.CreateBookmark:input_type -> proto.CreateBookmarkRequest
+	22, // 18: proto.PostService.DeleteBookmark:input_type -> proto.DeleteBookmarkRequest
+	24, // 19: proto.PostService.ListBookmarks:input_type -> proto.ListBookmarksRequest
+	1,  // 20: proto.PostService.Create:output_type -> proto.CreatePostResponse
+	3,  // 21: proto.PostService.Get:output_type -> proto.GetPostResponse
+	5,  // 22: proto.PostService.Update:output_type -> proto.UpdatePostResponse
+	7,  // 23: proto.PostService.Delete:output_type -> proto.DeletePostResponse
+	9,  // 24: proto.PostService.Restore:output_type -> proto.RestorePostResponse
+	11, // 25: proto.PostService.ListComments:output_type -> proto.ListPostCommentsResponse
+	13, // 26: proto.PostService.Publish:output_type -> proto.PublishPostResponse
+	15, // 27: proto.PostService.Unpublish:output_type -> proto.UnpublishPostResponse
+	17, // 28: proto.PostService.Like:output_type -> proto.LikePostResponse
+	19, // 29: proto.PostService.Unlike:output_type -> proto.UnlikePostResponse
+	21, // 30: proto.PostService.CreateBookmark:output_type -> proto.CreateBookmarkResponse
+	23, // 31: proto.PostService.DeleteBookmark:output_type -> proto.DeleteBookmarkResponse
+	25, // 32: proto.PostService.ListBookmarks:output_type -> proto.ListBookmarksResponse
+	20, // [20:33] is the sub-list for method output_type
+	7,  // [7:20] is the sub-list for method input_type
 	7,  // [7:7] is the sub-list for extension type_name
 	7,  // [7:7] is the sub-list for extension extendee
 	0,  // [0:7] is the sub-list for field type_name
@@ -1332,7 +1450,7 @@ func file_post_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_post_proto_rawDesc), len(file_post_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
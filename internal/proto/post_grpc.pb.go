@@ -23,6 +23,7 @@ const (
 	PostService_Get_FullMethodName            = "/proto.PostService/Get"
 	PostService_Update_FullMethodName         = "/proto.PostService/Update"
 	PostService_Delete_FullMethodName         = "/proto.PostService/Delete"
+	PostService_Restore_FullMethodName        = "/proto.PostService/Restore"
 	PostService_ListComments_FullMethodName   = "/proto.PostService/ListComments"
 	PostService_Publish_FullMethodName        = "/proto.PostService/Publish"
 	PostService_Unpublish_FullMethodName      = "/proto.PostService/Unpublish"
@@ -42,6 +43,7 @@ type PostServiceClient interface {
 	Get(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*GetPostResponse, error)
 	Update(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error)
 	Delete(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
+	Restore(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*RestorePostResponse, error)
 	// List Operations
 	ListComments(ctx context.Context, in *ListPostCommentsRequest, opts ...grpc.CallOption) (*ListPostCommentsResponse, error)
 	// Status Management
@@ -104,6 +106,16 @@ func (c *postServiceClient) Delete(ctx context.Context, in *DeletePostRequest, o
 	return out, nil
 }
 
+func (c *postServiceClient) Restore(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*RestorePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestorePostResponse)
+	err := c.cc.Invoke(ctx, PostService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) ListComments(ctx context.Context, in *ListPostCommentsRequest, opts ...grpc.CallOption) (*ListPostCommentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPostCommentsResponse)
@@ -193,6 +205,7 @@ type PostServiceServer interface {
 	Get(context.Context, *GetPostRequest) (*GetPostResponse, error)
 	Update(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error)
 	Delete(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	Restore(context.Context, *RestorePostRequest) (*RestorePostResponse, error)
 	// List Operations
 	ListComments(context.Context, *ListPostCommentsRequest) (*ListPostCommentsResponse, error)
 	// Status Management
@@ -227,6 +240,9 @@ func (UnimplementedPostServiceServer) Update(context.Context, *UpdatePostRequest
 func (UnimplementedPostServiceServer) Delete(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedPostServiceServer) Restore(context.Context, *RestorePostRequest) (*RestorePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
 func (UnimplementedPostServiceServer) ListComments(context.Context, *ListPostCommentsRequest) (*ListPostCommentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
 }
@@ -344,6 +360,24 @@ func _PostService_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).Restore(ctx, req.(*RestorePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPostCommentsRequest)
 	if err := dec(in); err != nil {
@@ -511,6 +545,10 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _PostService_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _PostService_Restore_Handler,
+		},
 		{
 			MethodName: "ListComments",
 			Handler:    _PostService_ListComments_Handler,
@@ -23,6 +23,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PostSort int32
+
+const (
+	PostSort_POST_SORT_UNSPECIFIED PostSort = 0 // newest
+	PostSort_POST_SORT_NEWEST      PostSort = 1
+	PostSort_POST_SORT_TOP         PostSort = 2 // most liked first, newest among equals
+)
+
+// Enum value maps for PostSort.
+var (
+	PostSort_name = map[int32]string{
+		0: "POST_SORT_UNSPECIFIED",
+		1: "POST_SORT_NEWEST",
+		2: "POST_SORT_TOP",
+	}
+	PostSort_value = map[string]int32{
+		"POST_SORT_UNSPECIFIED": 0,
+		"POST_SORT_NEWEST":      1,
+		"POST_SORT_TOP":         2,
+	}
+)
+
+func (x PostSort) Enum() *PostSort {
+	p := new(PostSort)
+	*p = x
+	return p
+}
+
+func (x PostSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_proto_enumTypes[0].Descriptor()
+}
+
+func (PostSort) Type() protoreflect.EnumType {
+	return &file_user_proto_enumTypes[0]
+}
+
+func (x PostSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostSort.Descriptor instead.
+func (PostSort) EnumDescriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{0}
+}
+
 type GetUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // or username
@@ -196,6 +245,7 @@ type UpdateProfileRequest struct {
 	AvatarUrl     string                 `protobuf:"bytes,1,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"` // S3 URL from presigned upload
 	BannerUrl     string                 `protobuf:"bytes,2,opt,name=banner_url,json=bannerUrl,proto3" json:"banner_url,omitempty"` // S3 URL from presigned upload
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`              // max 500 chars
+	Name          *string                `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`                      // display name, same rules as registration
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -251,6 +301,13 @@ func (x *UpdateProfileRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateProfileRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
 type UpdateProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -375,6 +432,234 @@ func (x *GetUserStatisticsResponse) GetStatistics() *UserStatistics {
 	return nil
 }
 
+type ReputationEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`                                  // post_like, comment_like, adjustment; undone ones end in _removed
+	CommunityId   string                 `protobuf:"bytes,3,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"` // empty for platform-wide adjustments
+	SourceId      string                 `protobuf:"bytes,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`          // like the change came from, empty for adjustments
+	Delta         int64                  `protobuf:"varint,5,opt,name=delta,proto3" json:"delta,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"` // adjustments only
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReputationEvent) Reset() {
+	*x = ReputationEvent{}
+	mi := &file_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReputationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReputationEvent) ProtoMessage() {}
+
+func (x *ReputationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReputationEvent.ProtoReflect.Descriptor instead.
+func (*ReputationEvent) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReputationEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReputationEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReputationEvent) GetCommunityId() string {
+	if x != nil {
+		return x.CommunityId
+	}
+	return ""
+}
+
+func (x *ReputationEvent) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *ReputationEvent) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *ReputationEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReputationEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListReputationHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CommunityId   string                 `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"` // optional, only changes in this community
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReputationHistoryRequest) Reset() {
+	*x = ListReputationHistoryRequest{}
+	mi := &file_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReputationHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReputationHistoryRequest) ProtoMessage() {}
+
+func (x *ListReputationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReputationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListReputationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListReputationHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListReputationHistoryRequest) GetCommunityId() string {
+	if x != nil {
+		return x.CommunityId
+	}
+	return ""
+}
+
+func (x *ListReputationHistoryRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListReputationHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListReputationHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*ReputationEvent     `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,4,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReputationHistoryResponse) Reset() {
+	*x = ListReputationHistoryResponse{}
+	mi := &file_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReputationHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReputationHistoryResponse) ProtoMessage() {}
+
+func (x *ListReputationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReputationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListReputationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListReputationHistoryResponse) GetEvents() []*ReputationEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListReputationHistoryResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListReputationHistoryResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListReputationHistoryResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
 type ListUserCommunitiesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -386,7 +671,7 @@ type ListUserCommunitiesRequest struct {
 
 func (x *ListUserCommunitiesRequest) Reset() {
 	*x = ListUserCommunitiesRequest{}
-	mi := &file_user_proto_msgTypes[8]
+	mi := &file_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -398,7 +683,7 @@ func (x *ListUserCommunitiesRequest) String() string {
 func (*ListUserCommunitiesRequest) ProtoMessage() {}
 
 func (x *ListUserCommunitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[8]
+	mi := &file_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +696,7 @@ func (x *ListUserCommunitiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserCommunitiesRequest.ProtoReflect.Descriptor instead.
 func (*ListUserCommunitiesRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{8}
+	return file_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListUserCommunitiesRequest) GetUserId() string {
@@ -440,13 +725,14 @@ type ListUserCommunitiesResponse struct {
 	Communities   []*Community           `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities,omitempty"`
 	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,4,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUserCommunitiesResponse) Reset() {
 	*x = ListUserCommunitiesResponse{}
-	mi := &file_user_proto_msgTypes[9]
+	mi := &file_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -458,7 +744,7 @@ func (x *ListUserCommunitiesResponse) String() string {
 func (*ListUserCommunitiesResponse) ProtoMessage() {}
 
 func (x *ListUserCommunitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[9]
+	mi := &file_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,7 +757,7 @@ func (x *ListUserCommunitiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserCommunitiesResponse.ProtoReflect.Descriptor instead.
 func (*ListUserCommunitiesResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{9}
+	return file_user_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListUserCommunitiesResponse) GetCommunities() []*Community {
@@ -495,19 +781,27 @@ func (x *ListUserCommunitiesResponse) GetHasMore() bool {
 	return false
 }
 
+func (x *ListUserCommunitiesResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
 type ListUserPostsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	StatusFilter  *PostStatus            `protobuf:"varint,2,opt,name=status_filter,json=statusFilter,proto3,enum=proto.PostStatus,oneof" json:"status_filter,omitempty"` // all, draft, published
 	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Sort          PostSort               `protobuf:"varint,5,opt,name=sort,proto3,enum=proto.PostSort" json:"sort,omitempty"` // a cursor only continues the order it came from
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUserPostsRequest) Reset() {
 	*x = ListUserPostsRequest{}
-	mi := &file_user_proto_msgTypes[10]
+	mi := &file_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -519,7 +813,7 @@ func (x *ListUserPostsRequest) String() string {
 func (*ListUserPostsRequest) ProtoMessage() {}
 
 func (x *ListUserPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[10]
+	mi := &file_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +826,7 @@ func (x *ListUserPostsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserPostsRequest.ProtoReflect.Descriptor instead.
 func (*ListUserPostsRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{10}
+	return file_user_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListUserPostsRequest) GetUserId() string {
@@ -563,18 +857,26 @@ func (x *ListUserPostsRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListUserPostsRequest) GetSort() PostSort {
+	if x != nil {
+		return x.Sort
+	}
+	return PostSort_POST_SORT_UNSPECIFIED
+}
+
 type ListUserPostsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
 	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,4,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUserPostsResponse) Reset() {
 	*x = ListUserPostsResponse{}
-	mi := &file_user_proto_msgTypes[11]
+	mi := &file_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -586,7 +888,7 @@ func (x *ListUserPostsResponse) String() string {
 func (*ListUserPostsResponse) ProtoMessage() {}
 
 func (x *ListUserPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[11]
+	mi := &file_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,7 +901,7 @@ func (x *ListUserPostsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserPostsResponse.ProtoReflect.Descriptor instead.
 func (*ListUserPostsResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{11}
+	return file_user_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListUserPostsResponse) GetPosts() []*Post {
@@ -623,6 +925,13 @@ func (x *ListUserPostsResponse) GetHasMore() bool {
 	return false
 }
 
+func (x *ListUserPostsResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
 type CommentWithPostInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
@@ -634,7 +943,7 @@ type CommentWithPostInfo struct {
 
 func (x *CommentWithPostInfo) Reset() {
 	*x = CommentWithPostInfo{}
-	mi := &file_user_proto_msgTypes[12]
+	mi := &file_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -646,7 +955,7 @@ func (x *CommentWithPostInfo) String() string {
 func (*CommentWithPostInfo) ProtoMessage() {}
 
 func (x *CommentWithPostInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[12]
+	mi := &file_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -659,7 +968,7 @@ func (x *CommentWithPostInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommentWithPostInfo.ProtoReflect.Descriptor instead.
 func (*CommentWithPostInfo) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{12}
+	return file_user_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CommentWithPostInfo) GetComment() *Comment {
@@ -694,7 +1003,7 @@ type ListUserCommentsRequest struct {
 
 func (x *ListUserCommentsRequest) Reset() {
 	*x = ListUserCommentsRequest{}
-	mi := &file_user_proto_msgTypes[13]
+	mi := &file_user_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -706,7 +1015,7 @@ func (x *ListUserCommentsRequest) String() string {
 func (*ListUserCommentsRequest) ProtoMessage() {}
 
 func (x *ListUserCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[13]
+	mi := &file_user_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,7 +1028,7 @@ func (x *ListUserCommentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserCommentsRequest.ProtoReflect.Descriptor instead.
 func (*ListUserCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{13}
+	return file_user_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListUserCommentsRequest) GetUserId() string {
@@ -748,13 +1057,14 @@ type ListUserCommentsResponse struct {
 	Comments      []*CommentWithPostInfo `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
 	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,4,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUserCommentsResponse) Reset() {
 	*x = ListUserCommentsResponse{}
-	mi := &file_user_proto_msgTypes[14]
+	mi := &file_user_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -766,7 +1076,7 @@ func (x *ListUserCommentsResponse) String() string {
 func (*ListUserCommentsResponse) ProtoMessage() {}
 
 func (x *ListUserCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[14]
+	mi := &file_user_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,7 +1089,7 @@ func (x *ListUserCommentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserCommentsResponse.ProtoReflect.Descriptor instead.
 func (*ListUserCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{14}
+	return file_user_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListUserCommentsResponse) GetComments() []*CommentWithPostInfo {
@@ -803,6 +1113,13 @@ func (x *ListUserCommentsResponse) GetHasMore() bool {
 	return false
 }
 
+func (x *ListUserCommentsResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
 type FollowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -812,7 +1129,7 @@ type FollowRequest struct {
 
 func (x *FollowRequest) Reset() {
 	*x = FollowRequest{}
-	mi := &file_user_proto_msgTypes[15]
+	mi := &file_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -824,7 +1141,7 @@ func (x *FollowRequest) String() string {
 func (*FollowRequest) ProtoMessage() {}
 
 func (x *FollowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[15]
+	mi := &file_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -837,7 +1154,7 @@ func (x *FollowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FollowRequest.ProtoReflect.Descriptor instead.
 func (*FollowRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{15}
+	return file_user_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *FollowRequest) GetUserId() string {
@@ -855,7 +1172,7 @@ type FollowResponse struct {
 
 func (x *FollowResponse) Reset() {
 	*x = FollowResponse{}
-	mi := &file_user_proto_msgTypes[16]
+	mi := &file_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -867,7 +1184,7 @@ func (x *FollowResponse) String() string {
 func (*FollowResponse) ProtoMessage() {}
 
 func (x *FollowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[16]
+	mi := &file_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,7 +1197,7 @@ func (x *FollowResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FollowResponse.ProtoReflect.Descriptor instead.
 func (*FollowResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{16}
+	return file_user_proto_rawDescGZIP(), []int{19}
 }
 
 type UnfollowRequest struct {
@@ -892,7 +1209,7 @@ type UnfollowRequest struct {
 
 func (x *UnfollowRequest) Reset() {
 	*x = UnfollowRequest{}
-	mi := &file_user_proto_msgTypes[17]
+	mi := &file_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -904,7 +1221,7 @@ func (x *UnfollowRequest) String() string {
 func (*UnfollowRequest) ProtoMessage() {}
 
 func (x *UnfollowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[17]
+	mi := &file_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -917,7 +1234,7 @@ func (x *UnfollowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnfollowRequest.ProtoReflect.Descriptor instead.
 func (*UnfollowRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{17}
+	return file_user_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UnfollowRequest) GetUserId() string {
@@ -935,7 +1252,7 @@ type UnfollowResponse struct {
 
 func (x *UnfollowResponse) Reset() {
 	*x = UnfollowResponse{}
-	mi := &file_user_proto_msgTypes[18]
+	mi := &file_user_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -947,7 +1264,7 @@ func (x *UnfollowResponse) String() string {
 func (*UnfollowResponse) ProtoMessage() {}
 
 func (x *UnfollowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[18]
+	mi := &file_user_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,7 +1277,7 @@ func (x *UnfollowResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnfollowResponse.ProtoReflect.Descriptor instead.
 func (*UnfollowResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{18}
+	return file_user_proto_rawDescGZIP(), []int{21}
 }
 
 type ListFollowersRequest struct {
@@ -974,7 +1291,7 @@ type ListFollowersRequest struct {
 
 func (x *ListFollowersRequest) Reset() {
 	*x = ListFollowersRequest{}
-	mi := &file_user_proto_msgTypes[19]
+	mi := &file_user_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -986,7 +1303,7 @@ func (x *ListFollowersRequest) String() string {
 func (*ListFollowersRequest) ProtoMessage() {}
 
 func (x *ListFollowersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[19]
+	mi := &file_user_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -999,7 +1316,7 @@ func (x *ListFollowersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFollowersRequest.ProtoReflect.Descriptor instead.
 func (*ListFollowersRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{19}
+	return file_user_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListFollowersRequest) GetUserId() string {
@@ -1028,13 +1345,14 @@ type ListFollowersResponse struct {
 	Users         []*UserProfile         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,4,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListFollowersResponse) Reset() {
 	*x = ListFollowersResponse{}
-	mi := &file_user_proto_msgTypes[20]
+	mi := &file_user_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1046,7 +1364,7 @@ func (x *ListFollowersResponse) String() string {
 func (*ListFollowersResponse) ProtoMessage() {}
 
 func (x *ListFollowersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[20]
+	mi := &file_user_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1059,7 +1377,7 @@ func (x *ListFollowersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFollowersResponse.ProtoReflect.Descriptor instead.
 func (*ListFollowersResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{20}
+	return file_user_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListFollowersResponse) GetUsers() []*UserProfile {
@@ -1083,6 +1401,13 @@ func (x *ListFollowersResponse) GetHasMore() bool {
 	return false
 }
 
+func (x *ListFollowersResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
 type ListFollowingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1094,7 +1419,7 @@ type ListFollowingRequest struct {
 
 func (x *ListFollowingRequest) Reset() {
 	*x = ListFollowingRequest{}
-	mi := &file_user_proto_msgTypes[21]
+	mi := &file_user_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1106,7 +1431,7 @@ func (x *ListFollowingRequest) String() string {
 func (*ListFollowingRequest) ProtoMessage() {}
 
 func (x *ListFollowingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[21]
+	mi := &file_user_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1119,7 +1444,7 @@ func (x *ListFollowingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFollowingRequest.ProtoReflect.Descriptor instead.
 func (*ListFollowingRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{21}
+	return file_user_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListFollowingRequest) GetUserId() string {
@@ -1148,13 +1473,14 @@ type ListFollowingResponse struct {
 	Users         []*UserProfile         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,4,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListFollowingResponse) Reset() {
 	*x = ListFollowingResponse{}
-	mi := &file_user_proto_msgTypes[22]
+	mi := &file_user_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1166,7 +1492,7 @@ func (x *ListFollowingResponse) String() string {
 func (*ListFollowingResponse) ProtoMessage() {}
 
 func (x *ListFollowingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[22]
+	mi := &file_user_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1179,7 +1505,7 @@ func (x *ListFollowingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFollowingResponse.ProtoReflect.Descriptor instead.
 func (*ListFollowingResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{22}
+	return file_user_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ListFollowingResponse) GetUsers() []*UserProfile {
@@ -1203,6 +1529,13 @@ func (x *ListFollowingResponse) GetHasMore() bool {
 	return false
 }
 
+func (x *ListFollowingResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
 type HeartbeatRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1211,7 +1544,7 @@ type HeartbeatRequest struct {
 
 func (x *HeartbeatRequest) Reset() {
 	*x = HeartbeatRequest{}
-	mi := &file_user_proto_msgTypes[23]
+	mi := &file_user_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1223,7 +1556,7 @@ func (x *HeartbeatRequest) String() string {
 func (*HeartbeatRequest) ProtoMessage() {}
 
 func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[23]
+	mi := &file_user_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1236,7 +1569,7 @@ func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
 func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{23}
+	return file_user_proto_rawDescGZIP(), []int{26}
 }
 
 type HeartbeatResponse struct {
@@ -1248,7 +1581,7 @@ type HeartbeatResponse struct {
 
 func (x *HeartbeatResponse) Reset() {
 	*x = HeartbeatResponse{}
-	mi := &file_user_proto_msgTypes[24]
+	mi := &file_user_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1260,7 +1593,7 @@ func (x *HeartbeatResponse) String() string {
 func (*HeartbeatResponse) ProtoMessage() {}
 
 func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[24]
+	mi := &file_user_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1273,7 +1606,7 @@ func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
 func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{24}
+	return file_user_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *HeartbeatResponse) GetLastActivity() *timestamppb.Timestamp {
@@ -1283,6 +1616,346 @@ func (x *HeartbeatResponse) GetLastActivity() *timestamppb.Timestamp {
 	return nil
 }
 
+type ChangeSlugRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeSlugRequest) Reset() {
+	*x = ChangeSlugRequest{}
+	mi := &file_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeSlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSlugRequest) ProtoMessage() {}
+
+func (x *ChangeSlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSlugRequest.ProtoReflect.Descriptor instead.
+func (*ChangeSlugRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ChangeSlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type ChangeSlugResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	NextChangeAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=next_change_at,json=nextChangeAt,proto3" json:"next_change_at,omitempty"` // earliest time of the next change
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeSlugResponse) Reset() {
+	*x = ChangeSlugResponse{}
+	mi := &file_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeSlugResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSlugResponse) ProtoMessage() {}
+
+func (x *ChangeSlugResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSlugResponse.ProtoReflect.Descriptor instead.
+func (*ChangeSlugResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ChangeSlugResponse) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ChangeSlugResponse) GetNextChangeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextChangeAt
+	}
+	return nil
+}
+
+type DataExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`      // pending, processing, ready or failed
+	Progress      int32                  `protobuf:"varint,3,opt,name=progress,proto3" json:"progress,omitempty"` // percent
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // when the emailed download link stops working
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataExport) Reset() {
+	*x = DataExport{}
+	mi := &file_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExport) ProtoMessage() {}
+
+func (x *DataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataExport.ProtoReflect.Descriptor instead.
+func (*DataExport) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DataExport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataExport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DataExport) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *DataExport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DataExport) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *DataExport) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type RequestDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDataExportRequest) Reset() {
+	*x = RequestDataExportRequest{}
+	mi := &file_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDataExportRequest) ProtoMessage() {}
+
+func (x *RequestDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDataExportRequest.ProtoReflect.Descriptor instead.
+func (*RequestDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{31}
+}
+
+type RequestDataExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *DataExport            `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDataExportResponse) Reset() {
+	*x = RequestDataExportResponse{}
+	mi := &file_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDataExportResponse) ProtoMessage() {}
+
+func (x *RequestDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDataExportResponse.ProtoReflect.Descriptor instead.
+func (*RequestDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RequestDataExportResponse) GetExport() *DataExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type GetDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataExportRequest) Reset() {
+	*x = GetDataExportRequest{}
+	mi := &file_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportRequest) ProtoMessage() {}
+
+func (x *GetDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportRequest.ProtoReflect.Descriptor instead.
+func (*GetDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{33}
+}
+
+type GetDataExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *DataExport            `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"` // latest export
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataExportResponse) Reset() {
+	*x = GetDataExportResponse{}
+	mi := &file_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportResponse) ProtoMessage() {}
+
+func (x *GetDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportResponse.ProtoReflect.Descriptor instead.
+func (*GetDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetDataExportResponse) GetExport() *DataExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
 var File_user_proto protoreflect.FileDescriptor
 
 const file_user_proto_rawDesc = "" +
@@ -1295,40 +1968,68 @@ const file_user_proto_rawDesc = "" +
 	"\x04user\x18\x01 \x01(\v2\x12.proto.UserProfileR\x04user\"\x17\n" +
 	"\x15GetCurrentUserRequest\"G\n" +
 	"\x16GetCurrentUserResponse\x12-\n" +
-	"\x04user\x18\x01 \x01(\v2\x19.proto.CurrentUserProfileR\x04user\"v\n" +
+	"\x04user\x18\x01 \x01(\v2\x19.proto.CurrentUserProfileR\x04user\"\x98\x01\n" +
 	"\x14UpdateProfileRequest\x12\x1d\n" +
 	"\n" +
 	"avatar_url\x18\x01 \x01(\tR\tavatarUrl\x12\x1d\n" +
 	"\n" +
 	"banner_url\x18\x02 \x01(\tR\tbannerUrl\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"\x17\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x17\n" +
+	"\x04name\x18\x04 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"\x17\n" +
 	"\x15UpdateProfileResponse\"3\n" +
 	"\x18GetUserStatisticsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"R\n" +
 	"\x19GetUserStatisticsResponse\x125\n" +
 	"\n" +
 	"statistics\x18\x01 \x01(\v2\x15.proto.UserStatisticsR\n" +
-	"statistics\"c\n" +
+	"statistics\"\xde\x01\n" +
+	"\x0fReputationEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12!\n" +
+	"\fcommunity_id\x18\x03 \x01(\tR\vcommunityId\x12\x1b\n" +
+	"\tsource_id\x18\x04 \x01(\tR\bsourceId\x12\x14\n" +
+	"\x05delta\x18\x05 \x01(\x03R\x05delta\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x88\x01\n" +
+	"\x1cListReputationHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fcommunity_id\x18\x02 \x01(\tR\vcommunityId\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xac\x01\n" +
+	"\x1dListReputationHistoryResponse\x12.\n" +
+	"\x06events\x18\x01 \x03(\v2\x16.proto.ReputationEventR\x06events\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vprev_cursor\x18\x04 \x01(\tR\n" +
+	"prevCursor\"c\n" +
 	"\x1aListUserCommunitiesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x8d\x01\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xae\x01\n" +
 	"\x1bListUserCommunitiesResponse\x122\n" +
 	"\vcommunities\x18\x01 \x03(\v2\x10.proto.CommunityR\vcommunities\x12\x1f\n" +
 	"\vnext_cursor\x18\x02 \x01(\tR\n" +
 	"nextCursor\x12\x19\n" +
-	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\xac\x01\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vprev_cursor\x18\x04 \x01(\tR\n" +
+	"prevCursor\"\xd1\x01\n" +
 	"\x14ListUserPostsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12;\n" +
 	"\rstatus_filter\x18\x02 \x01(\x0e2\x11.proto.PostStatusH\x00R\fstatusFilter\x88\x01\x01\x12\x16\n" +
 	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limitB\x10\n" +
-	"\x0e_status_filter\"v\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12#\n" +
+	"\x04sort\x18\x05 \x01(\x0e2\x0f.proto.PostSortR\x04sortB\x10\n" +
+	"\x0e_status_filter\"\x97\x01\n" +
 	"\x15ListUserPostsResponse\x12!\n" +
 	"\x05posts\x18\x01 \x03(\v2\v.proto.PostR\x05posts\x12\x1f\n" +
 	"\vnext_cursor\x18\x02 \x01(\tR\n" +
 	"nextCursor\x12\x19\n" +
-	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"w\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vprev_cursor\x18\x04 \x01(\tR\n" +
+	"prevCursor\"w\n" +
 	"\x13CommentWithPostInfo\x12(\n" +
 	"\acomment\x18\x01 \x01(\v2\x0e.proto.CommentR\acomment\x12\x17\n" +
 	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x1d\n" +
@@ -1337,12 +2038,14 @@ const file_user_proto_rawDesc = "" +
 	"\x17ListUserCommentsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x8e\x01\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xaf\x01\n" +
 	"\x18ListUserCommentsResponse\x126\n" +
 	"\bcomments\x18\x01 \x03(\v2\x1a.proto.CommentWithPostInfoR\bcomments\x12\x1f\n" +
 	"\vnext_cursor\x18\x02 \x01(\tR\n" +
 	"nextCursor\x12\x19\n" +
-	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"(\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vprev_cursor\x18\x04 \x01(\tR\n" +
+	"prevCursor\"(\n" +
 	"\rFollowRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x10\n" +
 	"\x0eFollowResponse\"*\n" +
@@ -1352,37 +2055,71 @@ const file_user_proto_rawDesc = "" +
 	"\x14ListFollowersRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"}\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x9e\x01\n" +
 	"\x15ListFollowersResponse\x12(\n" +
 	"\x05users\x18\x01 \x03(\v2\x12.proto.UserProfileR\x05users\x12\x1f\n" +
 	"\vnext_cursor\x18\x02 \x01(\tR\n" +
 	"nextCursor\x12\x19\n" +
-	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"]\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vprev_cursor\x18\x04 \x01(\tR\n" +
+	"prevCursor\"]\n" +
 	"\x14ListFollowingRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"}\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x9e\x01\n" +
 	"\x15ListFollowingResponse\x12(\n" +
 	"\x05users\x18\x01 \x03(\v2\x12.proto.UserProfileR\x05users\x12\x1f\n" +
 	"\vnext_cursor\x18\x02 \x01(\tR\n" +
 	"nextCursor\x12\x19\n" +
-	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\x12\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vprev_cursor\x18\x04 \x01(\tR\n" +
+	"prevCursor\"\x12\n" +
 	"\x10HeartbeatRequest\"T\n" +
 	"\x11HeartbeatResponse\x12?\n" +
-	"\rlast_activity\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\flastActivity2\xe2\t\n" +
+	"\rlast_activity\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\flastActivity\"'\n" +
+	"\x11ChangeSlugRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"j\n" +
+	"\x12ChangeSlugResponse\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12@\n" +
+	"\x0enext_change_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\fnextChangeAt\"\x85\x02\n" +
+	"\n" +
+	"DataExport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
+	"\bprogress\x18\x03 \x01(\x05R\bprogress\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fcompleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x1a\n" +
+	"\x18RequestDataExportRequest\"F\n" +
+	"\x19RequestDataExportResponse\x12)\n" +
+	"\x06export\x18\x01 \x01(\v2\x11.proto.DataExportR\x06export\"\x16\n" +
+	"\x14GetDataExportRequest\"B\n" +
+	"\x15GetDataExportResponse\x12)\n" +
+	"\x06export\x18\x01 \x01(\v2\x11.proto.DataExportR\x06export*N\n" +
+	"\bPostSort\x12\x19\n" +
+	"\x15POST_SORT_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10POST_SORT_NEWEST\x10\x01\x12\x11\n" +
+	"\rPOST_SORT_TOP\x10\x022\xa2\r\n" +
 	"\vUserService\x12N\n" +
 	"\x03Get\x12\x15.proto.GetUserRequest\x1a\x16.proto.GetUserResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/users/{user_id}\x12\\\n" +
 	"\n" +
 	"GetCurrent\x12\x1c.proto.GetCurrentUserRequest\x1a\x1d.proto.GetCurrentUserResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/users/me\x12`\n" +
-	"\rUpdateProfile\x12\x1b.proto.UpdateProfileRequest\x1a\x1c.proto.UpdateProfileResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*2\t/users/me\x12w\n" +
-	"\rGetStatistics\x12\x1f.proto.GetUserStatisticsRequest\x1a .proto.GetUserStatisticsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/users/{user_id}/statistics\x12~\n" +
+	"\rUpdateProfile\x12\x1b.proto.UpdateProfileRequest\x1a\x1c.proto.UpdateProfileResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*2\t/users/me\x12\\\n" +
+	"\n" +
+	"ChangeSlug\x12\x18.proto.ChangeSlugRequest\x1a\x19.proto.ChangeSlugResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/users/me/slug\x12w\n" +
+	"\rGetStatistics\x12\x1f.proto.GetUserStatisticsRequest\x1a .proto.GetUserStatisticsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/users/{user_id}/statistics\x12\x87\x01\n" +
+	"\x15ListReputationHistory\x12#.proto.ListReputationHistoryRequest\x1a$.proto.ListReputationHistoryResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/users/{user_id}/reputation\x12~\n" +
 	"\x0fListCommunities\x12!.proto.ListUserCommunitiesRequest\x1a\".proto.ListUserCommunitiesResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/users/{user_id}/communities\x12f\n" +
 	"\tListPosts\x12\x1b.proto.ListUserPostsRequest\x1a\x1c.proto.ListUserPostsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/users/{user_id}/posts\x12r\n" +
 	"\fListComments\x12\x1e.proto.ListUserCommentsRequest\x1a\x1f.proto.ListUserCommentsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/users/{user_id}/comments\x12V\n" +
 	"\x06Follow\x12\x14.proto.FollowRequest\x1a\x15.proto.FollowResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\"\x17/users/{user_id}/follow\x12\\\n" +
 	"\bUnfollow\x12\x16.proto.UnfollowRequest\x1a\x17.proto.UnfollowResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/users/{user_id}/follow\x12n\n" +
 	"\rListFollowers\x12\x1b.proto.ListFollowersRequest\x1a\x1c.proto.ListFollowersResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/users/{user_id}/followers\x12n\n" +
-	"\rListFollowing\x12\x1b.proto.ListFollowingRequest\x1a\x1c.proto.ListFollowingResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/users/{user_id}/following\x12X\n" +
+	"\rListFollowing\x12\x1b.proto.ListFollowingRequest\x1a\x1c.proto.ListFollowingResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/users/{user_id}/following\x12p\n" +
+	"\x11RequestDataExport\x12\x1f.proto.RequestDataExportRequest\x1a .proto.RequestDataExportResponse\"\x18\x82\xd3\xe4\x93\x02\x12\"\x10/users/me/export\x12d\n" +
+	"\rGetDataExport\x12\x1b.proto.GetDataExportRequest\x1a\x1c.proto.GetDataExportResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/users/me/export\x12X\n" +
 	"\tHeartbeat\x12\x17.proto.HeartbeatRequest\x1a\x18.proto.HeartbeatResponse\"\x18\x82\xd3\xe4\x93\x02\x12\"\x10/users/heartbeatB\bZ\x06/protob\x06proto3"
 
 var (
@@ -1397,83 +2134,112 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_user_proto_goTypes = []any{
-	(*GetUserRequest)(nil),              // 0: proto.GetUserRequest
-	(*GetUserResponse)(nil),             // 1: proto.GetUserResponse
-	(*GetCurrentUserRequest)(nil),       // 2: proto.GetCurrentUserRequest
-	(*GetCurrentUserResponse)(nil),      // 3: proto.GetCurrentUserResponse
-	(*UpdateProfileRequest)(nil),        // 4: proto.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil),       // 5: proto.UpdateProfileResponse
-	(*GetUserStatisticsRequest)(nil),    // 6: proto.GetUserStatisticsRequest
-	(*GetUserStatisticsResponse)(nil),   // 7: proto.GetUserStatisticsResponse
-	(*ListUserCommunitiesRequest)(nil),  // 8: proto.ListUserCommunitiesRequest
-	(*ListUserCommunitiesResponse)(nil), // 9: proto.ListUserCommunitiesResponse
-	(*ListUserPostsRequest)(nil),        // 10: proto.ListUserPostsRequest
-	(*ListUserPostsResponse)(nil),       // 11: proto.ListUserPostsResponse
-	(*CommentWithPostInfo)(nil),         // 12: proto.CommentWithPostInfo
-	(*ListUserCommentsRequest)(nil),     // 13: proto.ListUserCommentsRequest
-	(*ListUserCommentsResponse)(nil),    // 14: proto.ListUserCommentsResponse
-	(*FollowRequest)(nil),               // 15: proto.FollowRequest
-	(*FollowResponse)(nil),              // 16: proto.FollowResponse
-	(*UnfollowRequest)(nil),             // 17: proto.UnfollowRequest
-	(*UnfollowResponse)(nil),            // 18: proto.UnfollowResponse
-	(*ListFollowersRequest)(nil),        // 19: proto.ListFollowersRequest
-	(*ListFollowersResponse)(nil),       // 20: proto.ListFollowersResponse
-	(*ListFollowingRequest)(nil),        // 21: proto.ListFollowingRequest
-	(*ListFollowingResponse)(nil),       // 22: proto.ListFollowingResponse
-	(*HeartbeatRequest)(nil),            // 23: proto.HeartbeatRequest
-	(*HeartbeatResponse)(nil),           // 24: proto.HeartbeatResponse
-	(*UserProfile)(nil),                 // 25: proto.UserProfile
-	(*CurrentUserProfile)(nil),          // 26: proto.CurrentUserProfile
-	(*UserStatistics)(nil),              // 27: proto.UserStatistics
-	(*Community)(nil),                   // 28: proto.Community
-	(PostStatus)(0),                     // 29: proto.PostStatus
-	(*Post)(nil),                        // 30: proto.Post
-	(*Comment)(nil),                     // 31: proto.Comment
-	(*timestamppb.Timestamp)(nil),       // 32: google.protobuf.Timestamp
+	(PostSort)(0),                         // 0: proto.PostSort
+	(*GetUserRequest)(nil),                // 1: proto.GetUserRequest
+	(*GetUserResponse)(nil),               // 2: proto.GetUserResponse
+	(*GetCurrentUserRequest)(nil),         // 3: proto.GetCurrentUserRequest
+	(*GetCurrentUserResponse)(nil),        // 4: proto.GetCurrentUserResponse
+	(*UpdateProfileRequest)(nil),          // 5: proto.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),         // 6: proto.UpdateProfileResponse
+	(*GetUserStatisticsRequest)(nil),      // 7: proto.GetUserStatisticsRequest
+	(*GetUserStatisticsResponse)(nil),     // 8: proto.GetUserStatisticsResponse
+	(*ReputationEvent)(nil),               // 9: proto.ReputationEvent
+	(*ListReputationHistoryRequest)(nil),  // 10: proto.ListReputationHistoryRequest
+	(*ListReputationHistoryResponse)(nil), // 11: proto.ListReputationHistoryResponse
+	(*ListUserCommunitiesRequest)(nil),    // 12: proto.ListUserCommunitiesRequest
+	(*ListUserCommunitiesResponse)(nil),   // 13: proto.ListUserCommunitiesResponse
+	(*ListUserPostsRequest)(nil),          // 14: proto.ListUserPostsRequest
+	(*ListUserPostsResponse)(nil),         // 15: proto.ListUserPostsResponse
+	(*CommentWithPostInfo)(nil),           // 16: proto.CommentWithPostInfo
+	(*ListUserCommentsRequest)(nil),       // 17: proto.ListUserCommentsRequest
+	(*ListUserCommentsResponse)(nil),      // 18: proto.ListUserCommentsResponse
+	(*FollowRequest)(nil),                 // 19: proto.FollowRequest
+	(*FollowResponse)(nil),                // 20: proto.FollowResponse
+	(*UnfollowRequest)(nil),               // 21: proto.UnfollowRequest
+	(*UnfollowResponse)(nil),              // 22: proto.UnfollowResponse
+	(*ListFollowersRequest)(nil),          // 23: proto.ListFollowersRequest
+	(*ListFollowersResponse)(nil),         // 24: proto.ListFollowersResponse
+	(*ListFollowingRequest)(nil),          // 25: proto.ListFollowingRequest
+	(*ListFollowingResponse)(nil),         // 26: proto.ListFollowingResponse
+	(*HeartbeatRequest)(nil),              // 27: proto.HeartbeatRequest
+	(*HeartbeatResponse)(nil),             // 28: proto.HeartbeatResponse
+	(*ChangeSlugRequest)(nil),             // 29: proto.ChangeSlugRequest
+	(*ChangeSlugResponse)(nil),            // 30: proto.ChangeSlugResponse
+	(*DataExport)(nil),                    // 31: proto.DataExport
+	(*RequestDataExportRequest)(nil),      // 32: proto.RequestDataExportRequest
+	(*RequestDataExportResponse)(nil),     // 33: proto.RequestDataExportResponse
+	(*GetDataExportRequest)(nil),          // 34: proto.GetDataExportRequest
+	(*GetDataExportResponse)(nil),         // 35: proto.GetDataExportResponse
+	(*UserProfile)(nil),                   // 36: proto.UserProfile
+	(*CurrentUserProfile)(nil),            // 37: proto.CurrentUserProfile
+	(*UserStatistics)(nil),                // 38: proto.UserStatistics
+	(*timestamppb.Timestamp)(nil),         // 39: google.protobuf.Timestamp
+	(*Community)(nil),                     // 40: proto.Community
+	(PostStatus)(0),                       // 41: proto.PostStatus
+	(*Post)(nil),                          // 42: proto.Post
+	(*Comment)(nil),                       // 43: proto.Comment
 }
 var file_user_proto_depIdxs = []int32{
-	25, // 0: proto.GetUserResponse.user:type_name -> proto.UserProfile
-	26, // 1: proto.GetCurrentUserResponse.user:type_name -> proto.CurrentUserProfile
-	27, // 2: proto.GetUserStatisticsResponse.statistics:type_name -> proto.UserStatistics
-	28, // 3: proto.ListUserCommunitiesResponse.communities:type_name -> proto.Community
-	29, // 4: proto.ListUserPostsRequest.status_filter:type_name -> proto.PostStatus
-	30, // 5: proto.ListUserPostsResponse.posts:type_name -> proto.Post
-	31, // 6: proto.CommentWithPostInfo.comment:type_name -> proto.Comment
-	12, // 7: proto.ListUserCommentsResponse.comments:type_name -> proto.CommentWithPostInfo
-	25, // 8: proto.ListFollowersResponse.users:type_name -> proto.UserProfile
-	25, // 9: proto.ListFollowingResponse.users:type_name -> proto.UserProfile
-	32, // 10: proto.HeartbeatResponse.last_activity:type_name -> google.protobuf.Timestamp
-	0,  // 11: proto.UserService.Get:input_type -> proto.GetUserRequest
-	2,  // 12: proto.UserService.GetCurrent:input_type -> proto.GetCurrentUserRequest
-	4,  // 13: proto.UserService.UpdateProfile:input_type -> proto.UpdateProfileRequest
-	6,  // 14: proto.UserService.GetStatistics:input_type -> proto.GetUserStatisticsRequest
-	8,  // 15: proto.UserService.ListCommunities:input_type -> proto.ListUserCommunitiesRequest
-	10, // 16: proto.UserService.ListPosts:input_type -> proto.ListUserPostsRequest
-	13, // 17: proto.UserService.ListComments:input_type -> proto.ListUserCommentsRequest
-	15, // 18: proto.UserService.Follow:input_type -> proto.FollowRequest
-	17, // 19: proto.UserService.Unfollow:input_type -> proto.UnfollowRequest
-	19, // 20: proto.UserService.ListFollowers:input_type -> proto.ListFollowersRequest
-	21, // 21: proto.UserService.ListFollowing:input_type -> proto.ListFollowingRequest
-	23, // 22: proto.UserService.Heartbeat:input_type -> proto.HeartbeatRequest
-	1,  // 23: proto.UserService.Get:output_type -> proto.GetUserResponse
-	3,  // 24: proto.UserService.GetCurrent:output_type -> proto.GetCurrentUserResponse
-	5,  // 25: proto.UserService.UpdateProfile:output_type -> proto.UpdateProfileResponse
-	7,  // 26: proto.UserService.GetStatistics:output_type -> proto.GetUserStatisticsResponse
-	9,  // 27: proto.UserService.ListCommunities:output_type -> proto.ListUserCommunitiesResponse
-	11, // 28: proto.UserService.ListPosts:output_type -> proto.ListUserPostsResponse
-	14, // 29: proto.UserService.ListComments:output_type -> proto.ListUserCommentsResponse
-	16, // 30: proto.UserService.Follow:output_type -> proto.FollowResponse
-	18, // 31: proto.UserService.Unfollow:output_type -> proto.UnfollowResponse
-	20, // 32: proto.UserService.ListFollowers:output_type -> proto.ListFollowersResponse
-	22, // 33: proto.UserService.ListFollowing:output_type -> proto.ListFollowingResponse
-	24, // 34: proto.UserService.Heartbeat:output_type -> proto.HeartbeatResponse
-	23, // [23:35] is the sub-list for method output_type
-	11, // [11:23] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	36, // 0: proto.GetUserResponse.user:type_name -> proto.UserProfile
+	37, // 1: proto.GetCurrentUserResponse.user:type_name -> proto.CurrentUserProfile
+	38, // 2: proto.GetUserStatisticsResponse.statistics:type_name -> proto.UserStatistics
+	39, // 3: proto.ReputationEvent.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 4: proto.ListReputationHistoryResponse.events:type_name -> proto.ReputationEvent
+	40, // 5: proto.ListUserCommunitiesResponse.communities:type_name -> proto.Community
+	41, // 6: proto.ListUserPostsRequest.status_filter:type_name -> proto.PostStatus
+	0,  // 7: proto.ListUserPostsRequest.sort:type_name -> proto.PostSort
+	42, // 8: proto.ListUserPostsResponse.posts:type_name -> proto.Post
+	43, // 9: proto.CommentWithPostInfo.comment:type_name -> proto.Comment
+	16, // 10: proto.ListUserCommentsResponse.comments:type_name -> proto.CommentWithPostInfo
+	36, // 11: proto.ListFollowersResponse.users:type_name -> proto.UserProfile
+	36, // 12: proto.ListFollowingResponse.users:type_name -> proto.UserProfile
+	39, // 13: proto.HeartbeatResponse.last_activity:type_name -> google.protobuf.Timestamp
+	39, // 14: proto.ChangeSlugResponse.next_change_at:type_name -> google.protobuf.Timestamp
+	39, // 15: proto.DataExport.created_at:type_name -> google.protobuf.Timestamp
+	39, // 16: proto.DataExport.completed_at:type_name -> google.protobuf.Timestamp
+	39, // 17: proto.DataExport.expires_at:type_name -> google.protobuf.Timestamp
+	31, // 18: proto.RequestDataExportResponse.export:type_name -> proto.DataExport
+	31, // 19: proto.GetDataExportResponse.export:type_name -> proto.DataExport
+	1,  // 20: proto.UserService.Get:input_type -> proto.GetUserRequest
+	3,  // 21: proto.UserService.GetCurrent:input_type -> proto.GetCurrentUserRequest
+	5,  // 22: proto.UserService.UpdateProfile:input_type -> proto.UpdateProfileRequest
+	29, // 23: proto.UserService.ChangeSlug:input_type -> proto.ChangeSlugRequest
+	7,  // 24: proto.UserService.GetStatistics:input_type -> proto.GetUserStatisticsRequest
+	10, // 25: proto.UserService.ListReputationHistory:input_type -> proto.ListReputationHistoryRequest
+	12, // 26: proto.UserService.ListCommunities:input_type -> proto.ListUserCommunitiesRequest
+	14, // 27: proto.UserService.ListPosts:input_type -> proto.ListUserPostsRequest
+	17, // 28: proto.UserService.ListComments:input_type -> proto.ListUserCommentsRequest
+	19, // 29: proto.UserService.Follow:input_type -> proto.FollowRequest
+	21, // 30: proto.UserService.Unfollow:input_type -> proto.UnfollowRequest
+	23, // 31: proto.UserService.ListFollowers:input_type -> proto.ListFollowersRequest
+	25, // 32: proto.UserService.ListFollowing:input_type -> proto.ListFollowingRequest
+	32, // 33: proto.UserService.RequestDataExport:input_type -> proto.RequestDataExportRequest
+	34, // 34: proto.UserService.GetDataExport:input_type -> proto.GetDataExportRequest
+	27, // 35: proto.UserService.Heartbeat:input_type -> proto.HeartbeatRequest
+	2,  // 36: proto.UserService.Get:output_type -> proto.GetUserResponse
+	4,  // 37: proto.UserService.GetCurrent:output_type -> proto.GetCurrentUserResponse
+	6,  // 38: proto.UserService.UpdateProfile:output_type -> proto.UpdateProfileResponse
+	30, // 39: proto.UserService.ChangeSlug:output_type -> proto.ChangeSlugResponse
+	8,  // 40: proto.UserService.GetStatistics:output_type -> proto.GetUserStatisticsResponse
+	11, // 41: proto.UserService.ListReputationHistory:output_type -> proto.ListReputationHistoryResponse
+	13, // 42: proto.UserService.ListCommunities:output_type -> proto.ListUserCommunitiesResponse
+	15, // 43: proto.UserService.ListPosts:output_type -> proto.ListUserPostsResponse
+	18, // 44: proto.UserService.ListComments:output_type -> proto.ListUserCommentsResponse
+	20, // 45: proto.UserService.Follow:output_type -> proto.FollowResponse
+	22, // 46: proto.UserService.Unfollow:output_type -> proto.UnfollowResponse
+	24, // 47: proto.UserService.ListFollowers:output_type -> proto.ListFollowersResponse
+	26, // 48: proto.UserService.ListFollowing:output_type -> proto.ListFollowingResponse
+	33, // 49: proto.UserService.RequestDataExport:output_type -> proto.RequestDataExportResponse
+	35, // 50: proto.UserService.GetDataExport:output_type -> proto.GetDataExportResponse
+	28, // 51: proto.UserService.Heartbeat:output_type -> proto.HeartbeatResponse
+	36, // [36:52] is the sub-list for method output_type
+	20, // [20:36] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -1482,19 +2248,21 @@ func file_user_proto_init() {
 		return
 	}
 	file_entity_proto_init()
-	file_user_proto_msgTypes[10].OneofWrappers = []any{}
+	file_user_proto_msgTypes[4].OneofWrappers = []any{}
+	file_user_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   25,
+			NumEnums:      1,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_user_proto_goTypes,
 		DependencyIndexes: file_user_proto_depIdxs,
+		EnumInfos:         file_user_proto_enumTypes,
 		MessageInfos:      file_user_proto_msgTypes,
 	}.Build()
 	File_user_proto = out.File
@@ -19,18 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_Get_FullMethodName             = "/proto.UserService/Get"
-	UserService_GetCurrent_FullMethodName      = "/proto.UserService/GetCurrent"
-	UserService_UpdateProfile_FullMethodName   = "/proto.UserService/UpdateProfile"
-	UserService_GetStatistics_FullMethodName   = "/proto.UserService/GetStatistics"
-	UserService_ListCommunities_FullMethodName = "/proto.UserService/ListCommunities"
-	UserService_ListPosts_FullMethodName       = "/proto.UserService/ListPosts"
-	UserService_ListComments_FullMethodName    = "/proto.UserService/ListComments"
-	UserService_Follow_FullMethodName          = "/proto.UserService/Follow"
-	UserService_Unfollow_FullMethodName        = "/proto.UserService/Unfollow"
-	UserService_ListFollowers_FullMethodName   = "/proto.UserService/ListFollowers"
-	UserService_ListFollowing_FullMethodName   = "/proto.UserService/ListFollowing"
-	UserService_Heartbeat_FullMethodName       = "/proto.UserService/Heartbeat"
+	UserService_Get_FullMethodName                   = "/proto.UserService/Get"
+	UserService_GetCurrent_FullMethodName            = "/proto.UserService/GetCurrent"
+	UserService_UpdateProfile_FullMethodName         = "/proto.UserService/UpdateProfile"
+	UserService_ChangeSlug_FullMethodName            = "/proto.UserService/ChangeSlug"
+	UserService_GetStatistics_FullMethodName         = "/proto.UserService/GetStatistics"
+	UserService_ListReputationHistory_FullMethodName = "/proto.UserService/ListReputationHistory"
+	UserService_ListCommunities_FullMethodName       = "/proto.UserService/ListCommunities"
+	UserService_ListPosts_FullMethodName             = "/proto.UserService/ListPosts"
+	UserService_ListComments_FullMethodName          = "/proto.UserService/ListComments"
+	UserService_Follow_FullMethodName                = "/proto.UserService/Follow"
+	UserService_Unfollow_FullMethodName              = "/proto.UserService/Unfollow"
+	UserService_ListFollowers_FullMethodName         = "/proto.UserService/ListFollowers"
+	UserService_ListFollowing_FullMethodName         = "/proto.UserService/ListFollowing"
+	UserService_RequestDataExport_FullMethodName     = "/proto.UserService/RequestDataExport"
+	UserService_GetDataExport_FullMethodName         = "/proto.UserService/GetDataExport"
+	UserService_Heartbeat_FullMethodName             = "/proto.UserService/Heartbeat"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -41,7 +45,9 @@ type UserServiceClient interface {
 	Get(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	GetCurrent(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*GetCurrentUserResponse, error)
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	ChangeSlug(ctx context.Context, in *ChangeSlugRequest, opts ...grpc.CallOption) (*ChangeSlugResponse, error)
 	GetStatistics(ctx context.Context, in *GetUserStatisticsRequest, opts ...grpc.CallOption) (*GetUserStatisticsResponse, error)
+	ListReputationHistory(ctx context.Context, in *ListReputationHistoryRequest, opts ...grpc.CallOption) (*ListReputationHistoryResponse, error)
 	// List Operations
 	ListCommunities(ctx context.Context, in *ListUserCommunitiesRequest, opts ...grpc.CallOption) (*ListUserCommunitiesResponse, error)
 	ListPosts(ctx context.Context, in *ListUserPostsRequest, opts ...grpc.CallOption) (*ListUserPostsResponse, error)
@@ -51,6 +57,9 @@ type UserServiceClient interface {
 	Unfollow(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowResponse, error)
 	ListFollowers(ctx context.Context, in *ListFollowersRequest, opts ...grpc.CallOption) (*ListFollowersResponse, error)
 	ListFollowing(ctx context.Context, in *ListFollowingRequest, opts ...grpc.CallOption) (*ListFollowingResponse, error)
+	// Data Export
+	RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error)
+	GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*GetDataExportResponse, error)
 	// Online Status
 	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
 }
@@ -93,6 +102,16 @@ func (c *userServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfile
 	return out, nil
 }
 
+func (c *userServiceClient) ChangeSlug(ctx context.Context, in *ChangeSlugRequest, opts ...grpc.CallOption) (*ChangeSlugResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeSlugResponse)
+	err := c.cc.Invoke(ctx, UserService_ChangeSlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetStatistics(ctx context.Context, in *GetUserStatisticsRequest, opts ...grpc.CallOption) (*GetUserStatisticsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserStatisticsResponse)
@@ -103,6 +122,16 @@ func (c *userServiceClient) GetStatistics(ctx context.Context, in *GetUserStatis
 	return out, nil
 }
 
+func (c *userServiceClient) ListReputationHistory(ctx context.Context, in *ListReputationHistoryRequest, opts ...grpc.CallOption) (*ListReputationHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReputationHistoryResponse)
+	err := c.cc.Invoke(ctx, UserService_ListReputationHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ListCommunities(ctx context.Context, in *ListUserCommunitiesRequest, opts ...grpc.CallOption) (*ListUserCommunitiesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListUserCommunitiesResponse)
@@ -173,6 +202,26 @@ func (c *userServiceClient) ListFollowing(ctx context.Context, in *ListFollowing
 	return out, nil
 }
 
+func (c *userServiceClient) RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestDataExportResponse)
+	err := c.cc.Invoke(ctx, UserService_RequestDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*GetDataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataExportResponse)
+	err := c.cc.Invoke(ctx, UserService_GetDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HeartbeatResponse)
@@ -191,7 +240,9 @@ type UserServiceServer interface {
 	Get(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	GetCurrent(context.Context, *GetCurrentUserRequest) (*GetCurrentUserResponse, error)
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	ChangeSlug(context.Context, *ChangeSlugRequest) (*ChangeSlugResponse, error)
 	GetStatistics(context.Context, *GetUserStatisticsRequest) (*GetUserStatisticsResponse, error)
+	ListReputationHistory(context.Context, *ListReputationHistoryRequest) (*ListReputationHistoryResponse, error)
 	// List Operations
 	ListCommunities(context.Context, *ListUserCommunitiesRequest) (*ListUserCommunitiesResponse, error)
 	ListPosts(context.Context, *ListUserPostsRequest) (*ListUserPostsResponse, error)
@@ -201,6 +252,9 @@ type UserServiceServer interface {
 	Unfollow(context.Context, *UnfollowRequest) (*UnfollowResponse, error)
 	ListFollowers(context.Context, *ListFollowersRequest) (*ListFollowersResponse, error)
 	ListFollowing(context.Context, *ListFollowingRequest) (*ListFollowingResponse, error)
+	// Data Export
+	RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error)
+	GetDataExport(context.Context, *GetDataExportRequest) (*GetDataExportResponse, error)
 	// Online Status
 	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
@@ -222,9 +276,15 @@ func (UnimplementedUserServiceServer) GetCurrent(context.Context, *GetCurrentUse
 func (UnimplementedUserServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
 }
+func (UnimplementedUserServiceServer) ChangeSlug(context.Context, *ChangeSlugRequest) (*ChangeSlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSlug not implemented")
+}
 func (UnimplementedUserServiceServer) GetStatistics(context.Context, *GetUserStatisticsRequest) (*GetUserStatisticsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStatistics not implemented")
 }
+func (UnimplementedUserServiceServer) ListReputationHistory(context.Context, *ListReputationHistoryRequest) (*ListReputationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReputationHistory not implemented")
+}
 func (UnimplementedUserServiceServer) ListCommunities(context.Context, *ListUserCommunitiesRequest) (*ListUserCommunitiesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCommunities not implemented")
 }
@@ -246,6 +306,12 @@ func (UnimplementedUserServiceServer) ListFollowers(context.Context, *ListFollow
 func (UnimplementedUserServiceServer) ListFollowing(context.Context, *ListFollowingRequest) (*ListFollowingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFollowing not implemented")
 }
+func (UnimplementedUserServiceServer) RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDataExport not implemented")
+}
+func (UnimplementedUserServiceServer) GetDataExport(context.Context, *GetDataExportRequest) (*GetDataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataExport not implemented")
+}
 func (UnimplementedUserServiceServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
 }
@@ -324,6 +390,24 @@ func _UserService_UpdateProfile_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ChangeSlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeSlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ChangeSlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ChangeSlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ChangeSlug(ctx, req.(*ChangeSlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserStatisticsRequest)
 	if err := dec(in); err != nil {
@@ -342,6 +426,24 @@ func _UserService_GetStatistics_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListReputationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReputationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListReputationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListReputationHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListReputationHistory(ctx, req.(*ListReputationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ListCommunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListUserCommunitiesRequest)
 	if err := dec(in); err != nil {
@@ -468,6 +570,42 @@ func _UserService_ListFollowing_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_RequestDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RequestDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RequestDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RequestDataExport(ctx, req.(*RequestDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetDataExport(ctx, req.(*GetDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HeartbeatRequest)
 	if err := dec(in); err != nil {
@@ -505,10 +643,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProfile",
 			Handler:    _UserService_UpdateProfile_Handler,
 		},
+		{
+			MethodName: "ChangeSlug",
+			Handler:    _UserService_ChangeSlug_Handler,
+		},
 		{
 			MethodName: "GetStatistics",
 			Handler:    _UserService_GetStatistics_Handler,
 		},
+		{
+			MethodName: "ListReputationHistory",
+			Handler:    _UserService_ListReputationHistory_Handler,
+		},
 		{
 			MethodName: "ListCommunities",
 			Handler:    _UserService_ListCommunities_Handler,
@@ -537,6 +683,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListFollowing",
 			Handler:    _UserService_ListFollowing_Handler,
 		},
+		{
+			MethodName: "RequestDataExport",
+			Handler:    _UserService_RequestDataExport_Handler,
+		},
+		{
+			MethodName: "GetDataExport",
+			Handler:    _UserService_GetDataExport_Handler,
+		},
 		{
 			MethodName: "Heartbeat",
 			Handler:    _UserService_Heartbeat_Handler,
@@ -0,0 +1,173 @@
+package security
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrChallengeRequired = errors.New("challenge required")
+var ErrChallengeInvalid = errors.New("challenge invalid")
+var ErrChallengeExpired = errors.New("challenge expired")
+var ErrChallengeTooEasy = errors.New("challenge difficulty too low, request a new one")
+
+const CHALLENGE_KIND_NONE = "none"
+const CHALLENGE_KIND_PROOF_OF_WORK = "proof_of_work"
+const CHALLENGE_KIND_CAPTCHA = "captcha"
+
+const CHALLENGE_FAILURE_IP = "challenge_failure_ip"
+
+// Proof of work difficulty is the number of leading zero bits required in
+// SHA-256(challenge ":" solution). Every bit doubles the expected work; the
+// base takes well under a second in a browser. Each
+// PROOF_OF_WORK_FAILURES_PER_STEP failures of an ip address in
+// CHALLENGE_FAILURE_WINDOW add one bit, up to the maximum.
+const PROOF_OF_WORK_TTL = 5 * time.Minute
+const PROOF_OF_WORK_BASE_DIFFICULTY = 16
+const PROOF_OF_WORK_MAX_DIFFICULTY = 24
+const PROOF_OF_WORK_FAILURES_PER_STEP = 5
+const CHALLENGE_FAILURE_WINDOW = time.Hour
+
+// Challenge tells a client what to solve before calling a protected method.
+type Challenge struct {
+	Kind       string
+	Token      string // proof of work challenge, empty for CAPTCHA
+	Difficulty int
+	SiteKey    string // CAPTCHA widget key, empty for proof of work
+	ExpiresAt  time.Time
+}
+
+// VerifiedChallenge identifies a solved challenge so that it can be
+// redeemed only once. Nonce is empty when the provider already enforces
+// single use.
+type VerifiedChallenge struct {
+	Nonce     string
+	ExpiresAt time.Time
+}
+
+// ChallengeVerifier issues and checks challenges. Implemented by
+// ProofOfWork and by CAPTCHA provider clients. difficulty is the proof of
+// work difficulty currently required from the caller; verifiers without a
+// notion of difficulty ignore it.
+type ChallengeVerifier interface {
+	Issue(ipAddress string, difficulty int) (*Challenge, error)
+	Verify(ctx context.Context, response string, ipAddress string, difficulty int) (*VerifiedChallenge, error)
+}
+
+// ChallengeDifficulty returns the proof of work difficulty for an ip
+// address with the given number of recent failures, between the base and
+// the maximum difficulty.
+func ChallengeDifficulty(failures int) int {
+	if failures < 0 {
+		failures = 0
+	}
+
+	difficulty := PROOF_OF_WORK_BASE_DIFFICULTY + failures/PROOF_OF_WORK_FAILURES_PER_STEP
+	if difficulty > PROOF_OF_WORK_MAX_DIFFICULTY {
+		return PROOF_OF_WORK_MAX_DIFFICULTY
+	}
+	return difficulty
+}
+
+// ProofOfWork is a stateless hashcash-style challenge. The challenge is
+// "nonce.difficulty.expires.signature", signed with HMAC-SHA256 over those
+// fields and the client ip address, so it needs no storage until it is
+// redeemed. The response is "challenge:solution".
+type ProofOfWork struct {
+	secret []byte
+}
+
+func NewProofOfWork(secret string) *ProofOfWork {
+	return &ProofOfWork{
+		secret: []byte(secret),
+	}
+}
+
+func (p *ProofOfWork) Issue(ipAddress string, difficulty int) (*Challenge, error) {
+	bytes := make([]byte, 16)
+	_, err := rand.Read(bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := base64.RawURLEncoding.EncodeToString(bytes)
+	expiresAt := time.Now().Add(PROOF_OF_WORK_TTL).Truncate(time.Second)
+	fields := fmt.Sprintf("%s.%d.%d", nonce, difficulty, expiresAt.Unix())
+
+	return &Challenge{
+		Kind:       CHALLENGE_KIND_PROOF_OF_WORK,
+		Token:      fields + "." + p.sign(fields, ipAddress),
+		Difficulty: difficulty,
+		ExpiresAt:  expiresAt,
+	}, nil
+}
+
+func (p *ProofOfWork) Verify(ctx context.Context, response string, ipAddress string, difficulty int) (*VerifiedChallenge, error) {
+	separator := strings.LastIndex(response, ":")
+	if separator < 0 {
+		return nil, ErrChallengeInvalid
+	}
+	token := response[:separator]
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 4 {
+		return nil, ErrChallengeInvalid
+	}
+
+	fields := strings.Join(parts[:3], ".")
+	if !hmac.Equal([]byte(parts[3]), []byte(p.sign(fields, ipAddress))) {
+		return nil, ErrChallengeInvalid
+	}
+
+	tokenDifficulty, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, ErrChallengeInvalid
+	}
+	expires, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, ErrChallengeInvalid
+	}
+
+	expiresAt := time.Unix(expires, 0)
+	if !time.Now().Before(expiresAt) {
+		return nil, ErrChallengeExpired
+	}
+	if tokenDifficulty < difficulty {
+		return nil, ErrChallengeTooEasy
+	}
+
+	hash := sha256.Sum256([]byte(response))
+	if leadingZeroBits(hash[:]) < tokenDifficulty {
+		return nil, ErrChallengeInvalid
+	}
+
+	return &VerifiedChallenge{
+		Nonce:     parts[0],
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (p *ProofOfWork) sign(fields string, ipAddress string) string {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write([]byte(fields + "." + ipAddress))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func leadingZeroBits(hash []byte) int {
+	count := 0
+	for _, b := range hash {
+		if b != 0 {
+			return count + bits.LeadingZeros8(b)
+		}
+		count += 8
+	}
+	return count
+}
@@ -0,0 +1,238 @@
+package security
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testDifficulty keeps solving fast; the verification is the same at any
+// difficulty.
+const testDifficulty = 8
+
+// solveProofOfWork returns the first response to token with at least
+// difficulty leading zero bits among the solutions starting with prefix.
+func solveProofOfWork(t *testing.T, token string, difficulty int, prefix string) string {
+	t.Helper()
+	for solution := 0; solution < 1<<24; solution++ {
+		response := token + ":" + prefix + strconv.Itoa(solution)
+		hash := sha256.Sum256([]byte(response))
+		if leadingZeroBits(hash[:]) >= difficulty {
+			return response
+		}
+	}
+	t.Fatalf("no solution found for %q", token)
+	return ""
+}
+
+// unsolvedProofOfWork returns a response to token that does not reach the
+// difficulty.
+func unsolvedProofOfWork(t *testing.T, token string, difficulty int) string {
+	t.Helper()
+	for solution := 0; solution < 1<<16; solution++ {
+		response := token + ":" + strconv.Itoa(solution)
+		hash := sha256.Sum256([]byte(response))
+		if leadingZeroBits(hash[:]) < difficulty {
+			return response
+		}
+	}
+	t.Fatalf("every response solves %q", token)
+	return ""
+}
+
+// signedProofOfWorkToken builds a token with chosen fields, as Issue would
+// sign it.
+func signedProofOfWorkToken(p *ProofOfWork, nonce string, difficulty int, expiresAt time.Time, ipAddress string) string {
+	fields := fmt.Sprintf("%s.%d.%d", nonce, difficulty, expiresAt.Unix())
+	return fields + "." + p.sign(fields, ipAddress)
+}
+
+func TestProofOfWorkValidSolution(t *testing.T) {
+	p := NewProofOfWork("secret")
+
+	challenge, err := p.Issue("192.0.2.1", testDifficulty)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	if challenge.Kind != CHALLENGE_KIND_PROOF_OF_WORK || challenge.Difficulty != testDifficulty {
+		t.Fatalf("challenge: got %+v", challenge)
+	}
+	if !challenge.ExpiresAt.After(time.Now()) {
+		t.Fatalf("challenge already expired at %v", challenge.ExpiresAt)
+	}
+
+	response := solveProofOfWork(t, challenge.Token, testDifficulty, "")
+	verified, err := p.Verify(context.Background(), response, "192.0.2.1", testDifficulty)
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if verified.Nonce != strings.Split(challenge.Token, ".")[0] {
+		t.Fatalf("nonce: got %q, token %q", verified.Nonce, challenge.Token)
+	}
+	if !verified.ExpiresAt.Equal(challenge.ExpiresAt) {
+		t.Fatalf("expires at: got %v, want %v", verified.ExpiresAt, challenge.ExpiresAt)
+	}
+
+	// A token of a higher difficulty than required is fine
+	_, err = p.Verify(context.Background(), response, "192.0.2.1", testDifficulty-1)
+	if err != nil {
+		t.Fatalf("verify below the token difficulty: %v", err)
+	}
+}
+
+func TestProofOfWorkWrongSolution(t *testing.T) {
+	p := NewProofOfWork("secret")
+
+	challenge, err := p.Issue("192.0.2.1", testDifficulty)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	solved := solveProofOfWork(t, challenge.Token, testDifficulty, "")
+	parts := strings.Split(challenge.Token, ".")
+
+	tests := []struct {
+		name      string
+		response  string
+		ipAddress string
+	}{
+		{"unsolved", unsolvedProofOfWork(t, challenge.Token, testDifficulty), "192.0.2.1"},
+		{"no solution", challenge.Token, "192.0.2.1"},
+		{"other ip address", solved, "192.0.2.2"},
+		{"other nonce", strings.Replace(solved, parts[0], "AAAAAAAAAAAAAAAAAAAAAA", 1), "192.0.2.1"},
+		{"lowered difficulty", strings.Replace(solved, "."+parts[1]+".", ".0.", 1), "192.0.2.1"},
+		{"extended expiry", strings.Replace(solved, "."+parts[2]+".", "."+strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)+".", 1), "192.0.2.1"},
+		{"forged signature", strings.Replace(solved, parts[3], "forged", 1), "192.0.2.1"},
+		{"missing field", strings.Join(parts[1:], ".") + ":0", "192.0.2.1"},
+		{"empty", "", "192.0.2.1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := p.Verify(context.Background(), test.response, test.ipAddress, testDifficulty)
+			if !errors.Is(err, ErrChallengeInvalid) {
+				t.Fatalf("got %v, want %v", err, ErrChallengeInvalid)
+			}
+		})
+	}
+
+	_, err = NewProofOfWork("other secret").Verify(context.Background(), solved, "192.0.2.1", testDifficulty)
+	if !errors.Is(err, ErrChallengeInvalid) {
+		t.Fatalf("other secret: got %v, want %v", err, ErrChallengeInvalid)
+	}
+}
+
+func TestProofOfWorkExpired(t *testing.T) {
+	p := NewProofOfWork("secret")
+
+	token := signedProofOfWorkToken(p, "nonce", testDifficulty, time.Now().Add(-time.Second), "192.0.2.1")
+	_, err := p.Verify(context.Background(), solveProofOfWork(t, token, testDifficulty, ""), "192.0.2.1", testDifficulty)
+	if !errors.Is(err, ErrChallengeExpired) {
+		t.Fatalf("got %v, want %v", err, ErrChallengeExpired)
+	}
+}
+
+// The verifier is stateless, so a replayed response verifies again. It must
+// come back with the same nonce and expiry for the redemption record to
+// reject it, and a fresh challenge must get another nonce.
+func TestProofOfWorkReplay(t *testing.T) {
+	p := NewProofOfWork("secret")
+
+	challenge, err := p.Issue("192.0.2.1", testDifficulty)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	response := solveProofOfWork(t, challenge.Token, testDifficulty, "")
+
+	first, err := p.Verify(context.Background(), response, "192.0.2.1", testDifficulty)
+	if err != nil {
+		t.Fatalf("first verify: %v", err)
+	}
+	replayed, err := p.Verify(context.Background(), response, "192.0.2.1", testDifficulty)
+	if err != nil {
+		t.Fatalf("replayed verify: %v", err)
+	}
+	if *first != *replayed {
+		t.Fatalf("replay: got %+v, want %+v", replayed, first)
+	}
+
+	// Another solution of the same challenge is the same challenge
+	other := solveProofOfWork(t, challenge.Token, testDifficulty, "other")
+	again, err := p.Verify(context.Background(), other, "192.0.2.1", testDifficulty)
+	if err != nil {
+		t.Fatalf("other solution: %v", err)
+	}
+	if again.Nonce != first.Nonce {
+		t.Fatalf("other solution nonce: got %q, want %q", again.Nonce, first.Nonce)
+	}
+
+	next, err := p.Issue("192.0.2.1", testDifficulty)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	if strings.Split(next.Token, ".")[0] == first.Nonce {
+		t.Fatalf("two challenges share the nonce %q", first.Nonce)
+	}
+}
+
+func TestProofOfWorkTooEasy(t *testing.T) {
+	p := NewProofOfWork("secret")
+
+	challenge, err := p.Issue("192.0.2.1", testDifficulty)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	response := solveProofOfWork(t, challenge.Token, testDifficulty, "")
+
+	// The caller failed since the challenge was issued
+	_, err = p.Verify(context.Background(), response, "192.0.2.1", testDifficulty+1)
+	if !errors.Is(err, ErrChallengeTooEasy) {
+		t.Fatalf("got %v, want %v", err, ErrChallengeTooEasy)
+	}
+}
+
+func TestChallengeDifficulty(t *testing.T) {
+	tests := []struct {
+		failures int
+		want     int
+	}{
+		{-1, PROOF_OF_WORK_BASE_DIFFICULTY},
+		{0, PROOF_OF_WORK_BASE_DIFFICULTY},
+		{PROOF_OF_WORK_FAILURES_PER_STEP - 1, PROOF_OF_WORK_BASE_DIFFICULTY},
+		{PROOF_OF_WORK_FAILURES_PER_STEP, PROOF_OF_WORK_BASE_DIFFICULTY + 1},
+		{3 * PROOF_OF_WORK_FAILURES_PER_STEP, PROOF_OF_WORK_BASE_DIFFICULTY + 3},
+		{(PROOF_OF_WORK_MAX_DIFFICULTY - PROOF_OF_WORK_BASE_DIFFICULTY) * PROOF_OF_WORK_FAILURES_PER_STEP, PROOF_OF_WORK_MAX_DIFFICULTY},
+		{1000000, PROOF_OF_WORK_MAX_DIFFICULTY},
+	}
+
+	for _, test := range tests {
+		got := ChallengeDifficulty(test.failures)
+		if got != test.want {
+			t.Fatalf("%d failures: got %d, want %d", test.failures, got, test.want)
+		}
+	}
+}
+
+func TestLeadingZeroBits(t *testing.T) {
+	tests := []struct {
+		hash []byte
+		want int
+	}{
+		{[]byte{0x80, 0x00}, 0},
+		{[]byte{0x01, 0xff}, 7},
+		{[]byte{0x00, 0x40}, 9},
+		{[]byte{0x00, 0x00}, 16},
+		{[]byte{}, 0},
+	}
+
+	for _, test := range tests {
+		got := leadingZeroBits(test.hash)
+		if got != test.want {
+			t.Fatalf("%x: got %d, want %d", test.hash, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package security
+
+import (
+	"time"
+)
+
+const LOGIN_THROTTLE_ACCOUNT = "login_account"
+const LOGIN_THROTTLE_IP = "login_ip"
+const MAGIC_LINK_THROTTLE_EMAIL = "magic_link_email"
+const VERIFICATION_THROTTLE_EMAIL = "verification_email"
+const EMAIL_CHANGE_THROTTLE_USER = "email_change_user"
+
+// LoginThrottlePolicy describes how failed login attempts for a single key
+// (account or IP address) are slowed down and eventually locked out.
+type LoginThrottlePolicy struct {
+	Kind             string
+	Window           time.Duration // failures older than this are forgotten
+	FreeAttempts     int           // failures allowed before backoff starts
+	BaseDelay        time.Duration
+	MaxDelay         time.Duration
+	LockoutThreshold int // failures that trigger a full lockout
+	LockoutDuration  time.Duration
+}
+
+var AccountLoginThrottlePolicy = LoginThrottlePolicy{
+	Kind:             LOGIN_THROTTLE_ACCOUNT,
+	Window:           24 * time.Hour,
+	FreeAttempts:     3,
+	BaseDelay:        time.Second,
+	MaxDelay:         5 * time.Minute,
+	LockoutThreshold: 10,
+	LockoutDuration:  30 * time.Minute,
+}
+
+var IPLoginThrottlePolicy = LoginThrottlePolicy{
+	Kind:             LOGIN_THROTTLE_IP,
+	Window:           time.Hour,
+	FreeAttempts:     20,
+	BaseDelay:        time.Second,
+	MaxDelay:         15 * time.Minute,
+	LockoutThreshold: 100,
+	LockoutDuration:  time.Hour,
+}
+
+// MagicLinkThrottlePolicy limits how often a magic link can be emailed to
+// the same address. Lockout here only means no more emails for a while.
+var MagicLinkThrottlePolicy = LoginThrottlePolicy{
+	Kind:             MAGIC_LINK_THROTTLE_EMAIL,
+	Window:           time.Hour,
+	FreeAttempts:     3,
+	BaseDelay:        time.Minute,
+	MaxDelay:         15 * time.Minute,
+	LockoutThreshold: 10,
+	LockoutDuration:  time.Hour,
+}
+
+// VerificationThrottlePolicy limits how often the verification email can
+// be resent to the same address.
+var VerificationThrottlePolicy = LoginThrottlePolicy{
+	Kind:             VERIFICATION_THROTTLE_EMAIL,
+	Window:           time.Hour,
+	FreeAttempts:     3,
+	BaseDelay:        time.Minute,
+	MaxDelay:         15 * time.Minute,
+	LockoutThreshold: 10,
+	LockoutDuration:  time.Hour,
+}
+
+// EmailChangeThrottlePolicy limits how often a user can start an email
+// change, each of which sends mail to an address of their choosing.
+var EmailChangeThrottlePolicy = LoginThrottlePolicy{
+	Kind:             EMAIL_CHANGE_THROTTLE_USER,
+	Window:           24 * time.Hour,
+	FreeAttempts:     3,
+	BaseDelay:        5 * time.Minute,
+	MaxDelay:         time.Hour,
+	LockoutThreshold: 10,
+	LockoutDuration:  24 * time.Hour,
+}
+
+// Delay returns how long further attempts are refused after the given
+// number of consecutive failures. The delay doubles with every failure past
+// FreeAttempts and becomes LockoutDuration once LockoutThreshold is reached.
+func (p LoginThrottlePolicy) Delay(failures int) time.Duration {
+	if failures >= p.LockoutThreshold {
+		return p.LockoutDuration
+	}
+
+	if failures <= p.FreeAttempts {
+		return 0
+	}
+
+	delay := p.BaseDelay
+	for i := p.FreeAttempts + 1; i < failures; i++ {
+		delay *= 2
+		if delay >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+
+	return delay
+}
+
+// IsLockout reports whether the given number of failures reaches the
+// lockout threshold. Every failure past it locks again once the previous
+// lockout is over, so callers record a lockout only if none is active.
+func (p LoginThrottlePolicy) IsLockout(failures int) bool {
+	return failures >= p.LockoutThreshold
+}
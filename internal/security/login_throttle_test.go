@@ -0,0 +1,48 @@
+package security
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoginThrottleDelay(t *testing.T) {
+	policy := AccountLoginThrottlePolicy
+
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{0, 0},
+		{3, 0},
+		{4, time.Second},
+		{5, 2 * time.Second},
+		{8, 16 * time.Second},
+		{9, 32 * time.Second},
+		{10, 30 * time.Minute},
+		{11, 30 * time.Minute},
+	}
+
+	for _, test := range tests {
+		got := policy.Delay(test.failures)
+		if got != test.want {
+			t.Fatalf("%d failures: got %v, want %v", test.failures, got, test.want)
+		}
+	}
+
+	capped := LoginThrottlePolicy{FreeAttempts: 0, BaseDelay: time.Minute, MaxDelay: 5 * time.Minute, LockoutThreshold: 100}
+	if got := capped.Delay(50); got != 5*time.Minute {
+		t.Fatalf("capped delay: got %v", got)
+	}
+}
+
+// Every failure from the threshold on is a lockout, so a failure after the
+// first lockout ended locks again within the same window.
+func TestLoginThrottleIsLockout(t *testing.T) {
+	policy := AccountLoginThrottlePolicy
+
+	for failures, want := range map[int]bool{1: false, 9: false, 10: true, 11: true, 25: true} {
+		if got := policy.IsLockout(failures); got != want {
+			t.Fatalf("%d failures: got %v, want %v", failures, got, want)
+		}
+	}
+}
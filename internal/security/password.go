@@ -1,25 +1,219 @@
 package security
 
 import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
 
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 )
 
-func ComparePasswords(hashEncoded string, password string, salt string) error {
-	hash, err := base64.StdEncoding.DecodeString(hashEncoded)
+var ErrPasswordMismatch = errors.New("password mismatch")
+var ErrInvalidPasswordHash = errors.New("invalid password hash")
+
+const argon2idPrefix = "$argon2id$"
+
+// Bounds of the parameters accepted from a stored hash. Outside them argon2
+// panics, runs for too long, or an empty key would match any password.
+const (
+	argon2MaxMemory     = 1024 * 1024 // KiB
+	argon2MaxIterations = 64
+	argon2MinSaltLength = 8
+	argon2MinKeyLength  = 16
+)
+
+// Argon2Params are the argon2id cost parameters. They are stored in every
+// encoded hash, so changing them only affects new hashes; older ones are
+// upgraded on the next successful login.
+type Argon2Params struct {
+	Memory      uint32 // KiB
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2Params follow the OWASP recommendation for argon2id.
+var DefaultArgon2Params = Argon2Params{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// PasswordHasher hashes passwords with argon2id in PHC string format
+// ($argon2id$v=19$m=...,t=...,p=...$salt$hash) and verifies both these and
+// legacy bcrypt hashes.
+//
+// When a pepper is configured the password is HMAC-ed with it before
+// hashing. The pepper is not recorded in the hash, so it must be set before
+// the first argon2id hash is written and never changed afterwards.
+type PasswordHasher struct {
+	params Argon2Params
+	pepper []byte
+
+	dummyOnce sync.Once
+	dummyHash string
+}
+
+func NewPasswordHasher(pepper string) *PasswordHasher {
+	return &PasswordHasher{
+		params: DefaultArgon2Params,
+		pepper: []byte(pepper),
+	}
+}
+
+// Hash returns the encoded argon2id hash of the password. The salt is part
+// of the encoded hash, so the separate user salt column stays empty.
+func (h *PasswordHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	_, err := rand.Read(salt)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	return bcrypt.CompareHashAndPassword(hash, []byte(password+salt))
+	key := argon2.IDKey(
+		h.peppered(password),
+		salt,
+		h.params.Iterations,
+		h.params.Memory,
+		h.params.Parallelism,
+		h.params.KeyLength,
+	)
+
+	return fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		h.params.Memory,
+		h.params.Iterations,
+		h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
 }
 
-func HashPassword(password string, salt string) (string, error) {
-	hash, err := bcrypt.GenerateFromPassword([]byte(password+salt), bcrypt.DefaultCost)
+// Verify checks the password against an argon2id or legacy bcrypt hash.
+// salt is only used by legacy hashes. needsRehash reports whether the
+// password matched but the hash should be replaced with Hash output.
+func (h *PasswordHasher) Verify(encoded string, password string, salt string) (needsRehash bool, err error) {
+	if !strings.HasPrefix(encoded, argon2idPrefix) {
+		err = ComparePasswords(encoded, password, salt)
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, ErrPasswordMismatch
+		}
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	params, version, hashSalt, key, err := decodeArgon2id(encoded)
 	if err != nil {
-		return "", err
+		return false, err
+	}
+
+	candidate := argon2.IDKey(
+		h.peppered(password),
+		hashSalt,
+		params.Iterations,
+		params.Memory,
+		params.Parallelism,
+		params.KeyLength,
+	)
+	if subtle.ConstantTimeCompare(candidate, key) != 1 {
+		return false, ErrPasswordMismatch
+	}
+
+	needsRehash = version != argon2.Version ||
+		params.Memory != h.params.Memory ||
+		params.Iterations != h.params.Iterations ||
+		params.Parallelism != h.params.Parallelism ||
+		params.SaltLength != h.params.SaltLength ||
+		params.KeyLength != h.params.KeyLength
+
+	return needsRehash, nil
+}
+
+// VerifyDummy spends the same amount of work as Verify for a login attempt
+// with an unknown email, so response timing does not reveal whether an
+// account exists.
+func (h *PasswordHasher) VerifyDummy(password string) {
+	h.dummyOnce.Do(func() {
+		h.dummyHash, _ = h.Hash(GenerateToken())
+	})
+
+	_, _ = h.Verify(h.dummyHash, password, "")
+}
+
+func (h *PasswordHasher) peppered(password string) []byte {
+	if len(h.pepper) == 0 {
+		return []byte(password)
+	}
+
+	mac := hmac.New(sha256.New, h.pepper)
+	mac.Write([]byte(password))
+	return mac.Sum(nil)
+}
+
+func decodeArgon2id(encoded string) (Argon2Params, int, []byte, []byte, error) {
+	var params Argon2Params
+
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, hash
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return params, 0, nil, nil, ErrInvalidPasswordHash
+	}
+
+	var version int
+	_, err := fmt.Sscanf(parts[2], "v=%d", &version)
+	if err != nil || parts[2] != fmt.Sprintf("v=%d", version) {
+		return params, 0, nil, nil, ErrInvalidPasswordHash
+	}
+
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism)
+	if err != nil || parts[3] != fmt.Sprintf("m=%d,t=%d,p=%d", params.Memory, params.Iterations, params.Parallelism) {
+		return params, 0, nil, nil, ErrInvalidPasswordHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, 0, nil, nil, ErrInvalidPasswordHash
 	}
 
-	return base64.StdEncoding.EncodeToString(hash), nil
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return params, 0, nil, nil, ErrInvalidPasswordHash
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+
+	if params.Iterations < 1 || params.Iterations > argon2MaxIterations ||
+		params.Parallelism < 1 ||
+		params.Memory < 8*uint32(params.Parallelism) || params.Memory > argon2MaxMemory ||
+		params.SaltLength < argon2MinSaltLength ||
+		params.KeyLength < argon2MinKeyLength {
+		return params, 0, nil, nil, ErrInvalidPasswordHash
+	}
+
+	return params, version, salt, key, nil
+}
+
+// ComparePasswords verifies a legacy bcrypt hash of password+salt.
+func ComparePasswords(hashEncoded string, password string, salt string) error {
+	hash, err := base64.StdEncoding.DecodeString(hashEncoded)
+	if err != nil {
+		return err
+	}
+
+	return bcrypt.CompareHashAndPassword(hash, []byte(password+salt))
 }
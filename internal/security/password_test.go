@@ -0,0 +1,180 @@
+package security
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testArgon2Params keep the tests fast, they are not a safe choice.
+var testArgon2Params = Argon2Params{
+	Memory:      64,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func newTestPasswordHasher(pepper string) *PasswordHasher {
+	hasher := NewPasswordHasher(pepper)
+	hasher.params = testArgon2Params
+	return hasher
+}
+
+func TestPasswordHashVerify(t *testing.T) {
+	hasher := newTestPasswordHasher("")
+
+	encoded, err := hasher.Hash("correct horse battery staple")
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+	if !strings.HasPrefix(encoded, "$argon2id$v=19$m=64,t=1,p=1$") {
+		t.Fatalf("encoded hash: got %q", encoded)
+	}
+
+	needsRehash, err := hasher.Verify(encoded, "correct horse battery staple", "")
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if needsRehash {
+		t.Fatalf("fresh hash needs rehash")
+	}
+
+	other, err := hasher.Hash("correct horse battery staple")
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+	if other == encoded {
+		t.Fatalf("two hashes of a password share the salt")
+	}
+}
+
+func TestPasswordMismatch(t *testing.T) {
+	hasher := newTestPasswordHasher("")
+
+	encoded, err := hasher.Hash("correct horse battery staple")
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+
+	needsRehash, err := hasher.Verify(encoded, "correct horse battery stapler", "")
+	if !errors.Is(err, ErrPasswordMismatch) {
+		t.Fatalf("wrong password: got %v, want %v", err, ErrPasswordMismatch)
+	}
+	if needsRehash {
+		t.Fatalf("mismatch needs rehash")
+	}
+}
+
+func TestPasswordPepper(t *testing.T) {
+	encoded, err := newTestPasswordHasher("pepper").Hash("password")
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+
+	_, err = newTestPasswordHasher("pepper").Verify(encoded, "password", "")
+	if err != nil {
+		t.Fatalf("same pepper: %v", err)
+	}
+
+	for _, pepper := range []string{"", "other pepper"} {
+		_, err = newTestPasswordHasher(pepper).Verify(encoded, "password", "")
+		if !errors.Is(err, ErrPasswordMismatch) {
+			t.Fatalf("pepper %q: got %v, want %v", pepper, err, ErrPasswordMismatch)
+		}
+	}
+}
+
+func TestPasswordRehashOnParamsChange(t *testing.T) {
+	encoded, err := newTestPasswordHasher("").Hash("password")
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+
+	hasher := newTestPasswordHasher("")
+	hasher.params.Iterations = 2
+	needsRehash, err := hasher.Verify(encoded, "password", "")
+	if err != nil {
+		t.Fatalf("verify with the old params: %v", err)
+	}
+	if !needsRehash {
+		t.Fatalf("hash with old params does not need rehash")
+	}
+}
+
+func TestPasswordLegacyBcrypt(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"+"salt"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("bcrypt: %v", err)
+	}
+	encoded := base64.StdEncoding.EncodeToString(hash)
+	hasher := newTestPasswordHasher("")
+
+	needsRehash, err := hasher.Verify(encoded, "password", "salt")
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if !needsRehash {
+		t.Fatalf("legacy hash does not need rehash")
+	}
+
+	needsRehash, err = hasher.Verify(encoded, "password", "other salt")
+	if !errors.Is(err, ErrPasswordMismatch) {
+		t.Fatalf("wrong salt: got %v, want %v", err, ErrPasswordMismatch)
+	}
+	if needsRehash {
+		t.Fatalf("mismatch needs rehash")
+	}
+}
+
+func TestPasswordMalformedHash(t *testing.T) {
+	salt := base64.RawStdEncoding.EncodeToString([]byte("0123456789abcdef"))
+	key := base64.RawStdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+
+	tests := []struct {
+		name    string
+		encoded string
+	}{
+		{"prefix only", "$argon2id$"},
+		{"missing hash", "$argon2id$v=19$m=64,t=1,p=1$" + salt},
+		{"extra part", "$argon2id$v=19$m=64,t=1,p=1$" + salt + "$" + key + "$"},
+		{"bad version", "$argon2id$v=x$m=64,t=1,p=1$" + salt + "$" + key},
+		{"version with trailing data", "$argon2id$v=19x$m=64,t=1,p=1$" + salt + "$" + key},
+		{"bad params", "$argon2id$v=19$m=64;t=1;p=1$" + salt + "$" + key},
+		{"params with trailing data", "$argon2id$v=19$m=64,t=1,p=1,k=2$" + salt + "$" + key},
+		{"negative memory", "$argon2id$v=19$m=-64,t=1,p=1$" + salt + "$" + key},
+		{"parallelism overflow", "$argon2id$v=19$m=64,t=1,p=256$" + salt + "$" + key},
+		{"zero iterations", "$argon2id$v=19$m=64,t=0,p=1$" + salt + "$" + key},
+		{"too many iterations", "$argon2id$v=19$m=64,t=1000000,p=1$" + salt + "$" + key},
+		{"zero parallelism", "$argon2id$v=19$m=64,t=1,p=0$" + salt + "$" + key},
+		{"memory below parallelism", "$argon2id$v=19$m=8,t=1,p=4$" + salt + "$" + key},
+		{"too much memory", "$argon2id$v=19$m=4294967295,t=1,p=1$" + salt + "$" + key},
+		{"salt not base64", "$argon2id$v=19$m=64,t=1,p=1$!!!$" + key},
+		{"hash not base64", "$argon2id$v=19$m=64,t=1,p=1$" + salt + "$!!!"},
+		{"empty salt", "$argon2id$v=19$m=64,t=1,p=1$$" + key},
+		{"empty hash", "$argon2id$v=19$m=64,t=1,p=1$" + salt + "$"},
+		{"short hash", "$argon2id$v=19$m=64,t=1,p=1$" + salt + "$AAAA"},
+	}
+
+	hasher := newTestPasswordHasher("")
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			needsRehash, err := hasher.Verify(test.encoded, "password", "")
+			if !errors.Is(err, ErrInvalidPasswordHash) {
+				t.Fatalf("got %v, want %v", err, ErrInvalidPasswordHash)
+			}
+			if needsRehash {
+				t.Fatalf("malformed hash needs rehash")
+			}
+		})
+	}
+
+	// Anything without the argon2id prefix is taken for a legacy hash
+	_, err := hasher.Verify("not a hash", "password", "")
+	if err == nil || errors.Is(err, ErrPasswordMismatch) {
+		t.Fatalf("garbage legacy hash: got %v", err)
+	}
+}
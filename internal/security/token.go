@@ -2,9 +2,22 @@ package security
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
+	"encoding/hex"
+	"time"
 )
 
+// Lifetimes of the single use tokens sent by email. The worker quotes them
+// in the emails, so they are kept here rather than in each handler.
+const VERIFICATION_TOKEN_TTL = 24 * time.Hour
+const PASSWORD_RESET_TOKEN_TTL = time.Hour
+const UNLOCK_TOKEN_TTL = 24 * time.Hour
+const MAGIC_LINK_TOKEN_TTL = 15 * time.Minute
+const EMAIL_CHANGE_TOKEN_TTL = 24 * time.Hour
+const EMAIL_CHANGE_REVERT_TTL = 7 * 24 * time.Hour
+
 func GenerateToken() string {
 	bytes := make([]byte, 16)
 	_, err := rand.Read(bytes)
@@ -14,3 +27,28 @@ func GenerateToken() string {
 
 	return base64.StdEncoding.EncodeToString(bytes)
 }
+
+// GenerateURLSafeToken returns a 256-bit random token that can be used in
+// headers and URLs without escaping.
+func GenerateURLSafeToken() string {
+	bytes := make([]byte, 32)
+	_, err := rand.Read(bytes)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes)
+}
+
+// HashToken returns the hex SHA-256 of a random token. High-entropy tokens
+// need no salt or slow hash, and the digest can be looked up directly.
+func HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// VerifyTokenHash reports whether token matches a digest produced by
+// HashToken, in constant time.
+func VerifyTokenHash(token string, tokenHash string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashToken(token)), []byte(tokenHash)) == 1
+}
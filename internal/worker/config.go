@@ -1,6 +1,11 @@
 package worker
 
 type Config struct {
-	VerificationURL   string
+	VerificationURL  string
 	PasswordResetURL string
+	UnlockURL        string
+	MagicLinkURL     string
+	EmailChangeURL   string
+	EmailRevertURL   string
+	DataExportBucket string
 }
@@ -0,0 +1,494 @@
+package worker
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	eventpkg "github.com/stormhead-org/backend/internal/event"
+	templatepkg "github.com/stormhead-org/backend/internal/template"
+)
+
+// DATA_EXPORT_LINK_TTL is how long the emailed download link works.
+const DATA_EXPORT_LINK_TTL = 48 * time.Hour
+
+// dataExportSection is one JSON file of the export archive.
+type dataExportSection struct {
+	name    string
+	collect func(userID string) (interface{}, error)
+}
+
+func (this *Worker) UserRequestDataExportHandler(data []byte) error {
+	var message eventpkg.UserRequestDataExportMessage
+	err := json.Unmarshal(data, &message)
+	if err != nil {
+		return err
+	}
+
+	err = this.database.StartDataExport(message.ExportID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Already handled
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = this.buildDataExport(message.ID, message.ExportID)
+	if err != nil {
+		failErr := this.database.FailDataExport(message.ExportID)
+		if failErr != nil {
+			this.logger.Error("error marking data export failed", zap.Error(failErr))
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (this *Worker) buildDataExport(userID string, exportID string) error {
+	user, err := this.database.SelectUserByID(userID)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp("", "data-export-*.zip")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	sections := this.dataExportSections()
+	archive := zip.NewWriter(file)
+	for i, section := range sections {
+		records, err := section.collect(userID)
+		if err != nil {
+			return fmt.Errorf("collecting %s: %w", section.name, err)
+		}
+
+		writer, err := archive.Create(section.name + ".json")
+		if err != nil {
+			return err
+		}
+
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(records)
+		if err != nil {
+			return err
+		}
+
+		// The upload is the last step
+		err = this.database.UpdateDataExportProgress(exportID, (i+1)*100/(len(sections)+1))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = archive.Close()
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Seek(0, 0)
+	if err != nil {
+		return err
+	}
+
+	objectKey := fmt.Sprintf("data-export/%s/%s.zip", userID, exportID)
+	err = this.s3Client.UploadFile(this.context, this.config.DataExportBucket, objectKey, file)
+	if err != nil {
+		return err
+	}
+
+	downloadURL, err := this.s3Client.PresignGetURL(this.context, this.config.DataExportBucket, objectKey, DATA_EXPORT_LINK_TTL)
+	if err != nil {
+		return err
+	}
+
+	err = this.database.CompleteDataExport(exportID, objectKey, time.Now().Add(DATA_EXPORT_LINK_TTL))
+	if err != nil {
+		return err
+	}
+
+	fromEmail := "no-reply@stormhead.org" // Placeholder, should be configurable
+	subject := "Your Data Export"
+
+	templateData := struct {
+		User string
+		URL  string
+		Time string
+	}{
+		User: user.Name,
+		URL:  downloadURL,
+		Time: formatDuration(DATA_EXPORT_LINK_TTL),
+	}
+
+	content, err := templatepkg.Render("template/mail_data_export.html", templateData)
+	if err != nil {
+		return err
+	}
+
+	err = this.mailClient.SendHTML(fromEmail, user.Email, subject, content)
+	if err != nil {
+		return err
+	}
+
+	this.logger.Info("sent data export email", zap.String("email", user.Email))
+	return nil
+}
+
+// dataExportSections lists the archive contents. Notifications are not
+// stored by this service yet; add them here once they are.
+func (this *Worker) dataExportSections() []dataExportSection {
+	return []dataExportSection{
+		{"profile", this.collectExportProfile},
+		{"sessions", this.collectExportSessions},
+		{"posts", this.collectExportPosts},
+		{"comments", this.collectExportComments},
+		{"likes", this.collectExportLikes},
+		{"bookmarks", this.collectExportBookmarks},
+		{"follows", this.collectExportFollows},
+		{"communities", this.collectExportCommunities},
+		{"roles", this.collectExportRoles},
+		{"media", this.collectExportMedia},
+	}
+}
+
+type exportProfile struct {
+	ID           string    `json:"id"`
+	Slug         string    `json:"slug"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	Email        string    `json:"email"`
+	IsVerified   bool      `json:"is_verified"`
+	Reputation   int64     `json:"reputation"`
+	LastActivity time.Time `json:"last_activity"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (this *Worker) collectExportProfile(userID string) (interface{}, error) {
+	user, err := this.database.SelectUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return exportProfile{
+		ID:           user.ID.String(),
+		Slug:         user.Slug,
+		Name:         user.Name,
+		Description:  user.Description,
+		Email:        user.Email,
+		IsVerified:   user.IsVerified,
+		Reputation:   user.Reputation,
+		LastActivity: user.LastActivity,
+		CreatedAt:    user.CreatedAt,
+	}, nil
+}
+
+type exportSession struct {
+	ID        string    `json:"id"`
+	UserAgent string    `json:"user_agent"`
+	IpAddress string    `json:"ip_address"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (this *Worker) collectExportSessions(userID string) (interface{}, error) {
+	sessions, _, err := this.database.SelectSessionsByUserID(userID, "", -1)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []exportSession{}
+	for _, session := range sessions {
+		result = append(result, exportSession{
+			ID:        session.ID.String(),
+			UserAgent: session.UserAgent,
+			IpAddress: session.IpAddress,
+			CreatedAt: session.CreatedAt,
+			UpdatedAt: session.UpdatedAt,
+		})
+	}
+	return result, nil
+}
+
+type exportPost struct {
+	ID          string          `json:"id"`
+	CommunityID string          `json:"community_id"`
+	Title       string          `json:"title"`
+	Content     json.RawMessage `json:"content"`
+	Status      int             `json:"status"`
+	LikeCount   int             `json:"like_count"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+	PublishedAt time.Time       `json:"published_at"`
+}
+
+func (this *Worker) collectExportPosts(userID string) (interface{}, error) {
+	posts, err := this.database.SelectPostsByAuthorID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []exportPost{}
+	for _, post := range posts {
+		content := post.Content
+		if len(content) == 0 {
+			content = json.RawMessage("null")
+		}
+		result = append(result, exportPost{
+			ID:          post.ID.String(),
+			CommunityID: post.CommunityID.String(),
+			Title:       post.Title,
+			Content:     content,
+			Status:      post.Status,
+			LikeCount:   post.LikeCount,
+			CreatedAt:   post.CreatedAt,
+			UpdatedAt:   post.UpdatedAt,
+			PublishedAt: post.PublishedAt,
+		})
+	}
+	return result, nil
+}
+
+type exportComment struct {
+	ID              string    `json:"id"`
+	PostID          string    `json:"post_id"`
+	ParentCommentID string    `json:"parent_comment_id,omitempty"`
+	Content         string    `json:"content"`
+	LikeCount       int       `json:"like_count"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+func (this *Worker) collectExportComments(userID string) (interface{}, error) {
+	comments, err := this.database.SelectCommentsByAuthorID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []exportComment{}
+	for _, comment := range comments {
+		parentCommentID := ""
+		if comment.ParentCommentID != nil {
+			parentCommentID = comment.ParentCommentID.String()
+		}
+		result = append(result, exportComment{
+			ID:              comment.ID.String(),
+			PostID:          comment.PostID.String(),
+			ParentCommentID: parentCommentID,
+			Content:         comment.Content,
+			LikeCount:       comment.LikeCount,
+			CreatedAt:       comment.CreatedAt,
+			UpdatedAt:       comment.UpdatedAt,
+		})
+	}
+	return result, nil
+}
+
+type exportLike struct {
+	PostID    string    `json:"post_id,omitempty"`
+	CommentID string    `json:"comment_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (this *Worker) collectExportLikes(userID string) (interface{}, error) {
+	postLikes, err := this.database.SelectPostLikesByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	commentLikes, err := this.database.SelectCommentLikesByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []exportLike{}
+	for _, like := range postLikes {
+		result = append(result, exportLike{
+			PostID:    like.PostID.String(),
+			CreatedAt: like.CreatedAt,
+		})
+	}
+	for _, like := range commentLikes {
+		result = append(result, exportLike{
+			CommentID: like.CommentID.String(),
+			CreatedAt: like.CreatedAt,
+		})
+	}
+	return result, nil
+}
+
+type exportBookmark struct {
+	PostID    string    `json:"post_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (this *Worker) collectExportBookmarks(userID string) (interface{}, error) {
+	bookmarks, err := this.database.SelectBookmarksByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []exportBookmark{}
+	for _, bookmark := range bookmarks {
+		result = append(result, exportBookmark{
+			PostID:    bookmark.PostID.String(),
+			CreatedAt: bookmark.CreatedAt,
+		})
+	}
+	return result, nil
+}
+
+type exportFollows struct {
+	Following []exportFollow `json:"following"`
+	Followers []exportFollow `json:"followers"`
+}
+
+type exportFollow struct {
+	UserID    string    `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (this *Worker) collectExportFollows(userID string) (interface{}, error) {
+	follows, err := this.database.SelectFollowsByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := exportFollows{
+		Following: []exportFollow{},
+		Followers: []exportFollow{},
+	}
+	for _, follow := range follows {
+		if follow.FollowerID.String() == userID {
+			result.Following = append(result.Following, exportFollow{
+				UserID:    follow.UserID.String(),
+				CreatedAt: follow.CreatedAt,
+			})
+		} else {
+			result.Followers = append(result.Followers, exportFollow{
+				UserID:    follow.FollowerID.String(),
+				CreatedAt: follow.CreatedAt,
+			})
+		}
+	}
+	return result, nil
+}
+
+type exportCommunities struct {
+	Owned       []exportCommunity  `json:"owned"`
+	Memberships []exportMembership `json:"memberships"`
+}
+
+type exportCommunity struct {
+	ID          string    `json:"id"`
+	Slug        string    `json:"slug"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Rules       string    `json:"rules"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type exportMembership struct {
+	CommunityID string    `json:"community_id"`
+	Slug        string    `json:"slug"`
+	Name        string    `json:"name"`
+	JoinedAt    time.Time `json:"joined_at"`
+}
+
+func (this *Worker) collectExportCommunities(userID string) (interface{}, error) {
+	owned, _, err := this.database.SelectCommunitiesWithPagination(userID, -1, "")
+	if err != nil {
+		return nil, err
+	}
+
+	memberships, err := this.database.SelectCommunityUsersByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := exportCommunities{
+		Owned:       []exportCommunity{},
+		Memberships: []exportMembership{},
+	}
+	for _, community := range owned {
+		result.Owned = append(result.Owned, exportCommunity{
+			ID:          community.ID.String(),
+			Slug:        community.Slug,
+			Name:        community.Name,
+			Description: community.Description,
+			Rules:       community.Rules,
+			CreatedAt:   community.CreatedAt,
+		})
+	}
+	for _, membership := range memberships {
+		result.Memberships = append(result.Memberships, exportMembership{
+			CommunityID: membership.CommunityID.String(),
+			Slug:        membership.Community.Slug,
+			Name:        membership.Community.Name,
+			JoinedAt:    membership.CreatedAt,
+		})
+	}
+	return result, nil
+}
+
+type exportRole struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	CommunityID string          `json:"community_id,omitempty"`
+	Permissions json.RawMessage `json:"permissions"`
+}
+
+func (this *Worker) collectExportRoles(userID string) (interface{}, error) {
+	roles, err := this.database.SelectRolesByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []exportRole{}
+	for _, role := range roles {
+		communityID := ""
+		if role.CommunityID != nil {
+			communityID = role.CommunityID.String()
+		}
+		permissions := role.Permissions
+		if len(permissions) == 0 {
+			permissions = json.RawMessage("{}")
+		}
+		result = append(result, exportRole{
+			ID:          role.ID.String(),
+			Name:        role.Name,
+			CommunityID: communityID,
+			Permissions: permissions,
+		})
+	}
+	return result, nil
+}
+
+// exportMedia is the metadata of an upload, the fields MediaService accepts
+// in proto/media.proto. The files themselves stay in S3.
+type exportMedia struct {
+	ID           string    `json:"id"`
+	RelationType string    `json:"relation_type"`
+	RelationID   string    `json:"relation_id"`
+	FileType     string    `json:"file_type"`
+	URL          string    `json:"url"`
+	SizeBytes    int64     `json:"size_bytes"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// collectExportMedia lists the user's uploads. MediaService is declared but
+// not registered by the gRPC server or the gateway and no upload is
+// recorded, so the section is an empty list until uploads get a table.
+func (this *Worker) collectExportMedia(userID string) (interface{}, error) {
+	return []exportMedia{}, nil
+}
@@ -3,19 +3,40 @@ package worker
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 
 	clientpkg "github.com/stormhead-org/backend/internal/client"
 	eventpkg "github.com/stormhead-org/backend/internal/event"
-	templatepkg "github.com/stormhead-org/backend/internal/template"
+	"github.com/stormhead-org/backend/internal/lib"
+	metricpkg "github.com/stormhead-org/backend/internal/metric"
 	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	securitypkg "github.com/stormhead-org/backend/internal/security"
+	templatepkg "github.com/stormhead-org/backend/internal/template"
 )
 
+const ACCOUNT_DELETION_INTERVAL = time.Hour
+const ACCOUNT_DELETION_BATCH_SIZE = 100
+const COUNTER_RECONCILIATION_INTERVAL = 6 * time.Hour
+const DELETED_CONTENT_PURGE_INTERVAL = time.Hour
+const DELETED_CONTENT_PURGE_BATCH_SIZE = 100
+
+// The statistics rollup recomputes the buckets of the last
+// STATISTICS_ROLLUP_LOOKBACK, so that the current bucket fills up and rows
+// written late into the previous one are counted. Hourly buckets are kept
+// for STATISTICS_HOURLY_RETENTION, daily ones forever.
+const STATISTICS_ROLLUP_INTERVAL = 15 * time.Minute
+const STATISTICS_ROLLUP_LOOKBACK = 2 * time.Hour
+const STATISTICS_HOURLY_RETENTION = 90 * 24 * time.Hour
+
 type Worker struct {
 	context      context.Context
 	cancel       func()
@@ -24,11 +45,12 @@ type Worker struct {
 	router       *Router
 	brokerClient *eventpkg.KafkaClient
 	mailClient   *clientpkg.MailClient
+	s3Client     *clientpkg.S3Client
 	database     *ormpkg.PostgresClient
 	config       *Config
 }
 
-func NewWorker(logger *zap.Logger, brokerClient *eventpkg.KafkaClient, mailClient *clientpkg.MailClient, database *ormpkg.PostgresClient, config *Config) *Worker {
+func NewWorker(logger *zap.Logger, brokerClient *eventpkg.KafkaClient, mailClient *clientpkg.MailClient, s3Client *clientpkg.S3Client, database *ormpkg.PostgresClient, config *Config) *Worker {
 	context, cancel := context.WithCancel(context.Background())
 	this := &Worker{
 		context:      context,
@@ -36,6 +58,7 @@ func NewWorker(logger *zap.Logger, brokerClient *eventpkg.KafkaClient, mailClien
 		logger:       logger,
 		brokerClient: brokerClient,
 		mailClient:   mailClient,
+		s3Client:     s3Client,
 		database:     database,
 		config:       config,
 	}
@@ -50,6 +73,30 @@ func NewWorker(logger *zap.Logger, brokerClient *eventpkg.KafkaClient, mailClien
 			eventpkg.AUTHORIZATION_REGISTER: {
 				this.AuthorizationRegisterHandler,
 			},
+			eventpkg.AUTHORIZATION_ACCOUNT_LOCKED: {
+				this.AuthorizationAccountLockedHandler,
+			},
+			eventpkg.AUTHORIZATION_REQUEST_MAGIC_LINK: {
+				this.AuthorizationRequestMagicLinkHandler,
+			},
+			eventpkg.AUTHORIZATION_REQUEST_VERIFICATION: {
+				this.AuthorizationRequestVerificationHandler,
+			},
+			eventpkg.AUTHORIZATION_REQUEST_EMAIL_CHANGE: {
+				this.AuthorizationRequestEmailChangeHandler,
+			},
+			eventpkg.AUTHORIZATION_REQUEST_ACCOUNT_DELETION: {
+				this.AuthorizationRequestAccountDeletionHandler,
+			},
+			eventpkg.PLATFORM_REGISTRATION_APPROVED: {
+				this.PlatformRegistrationApprovedHandler,
+			},
+			eventpkg.PLATFORM_REGISTRATION_REJECTED: {
+				this.PlatformRegistrationRejectedHandler,
+			},
+			eventpkg.USER_REQUEST_DATA_EXPORT: {
+				this.UserRequestDataExportHandler,
+			},
 		},
 	)
 	return this
@@ -58,8 +105,12 @@ func NewWorker(logger *zap.Logger, brokerClient *eventpkg.KafkaClient, mailClien
 func (this *Worker) Start() error {
 	this.logger.Info("starting mail worker")
 
-	this.waitGroup.Add(1)
+	this.waitGroup.Add(5)
 	go this.worker()
+	go this.accountDeletionWorker()
+	go this.counterReconciliationWorker()
+	go this.deletedContentPurgeWorker()
+	go this.statisticsRollupWorker()
 	return nil
 }
 
@@ -95,6 +146,163 @@ func (this *Worker) worker() {
 	}
 }
 
+// accountDeletionWorker periodically deletes accounts whose deletion grace
+// period is over.
+func (this *Worker) accountDeletionWorker() {
+	defer this.waitGroup.Done()
+
+	for {
+		this.deleteDueAccounts()
+
+		select {
+		case <-this.context.Done():
+			return
+		case <-time.After(ACCOUNT_DELETION_INTERVAL):
+		}
+	}
+}
+
+func (this *Worker) deleteDueAccounts() {
+	users, err := this.database.SelectUsersDueForDeletion(time.Now(), ACCOUNT_DELETION_BATCH_SIZE)
+	if err != nil {
+		this.logger.Error("error selecting accounts due for deletion", zap.Error(err))
+		return
+	}
+
+	for _, user := range users {
+		err = this.database.DeleteUserAccount(user.ID.String())
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Cancelled by a login in the meantime
+			continue
+		}
+		if err != nil {
+			this.logger.Error("error deleting account", zap.Error(err), zap.String("id", user.ID.String()))
+			continue
+		}
+
+		this.logger.Info("deleted account", zap.String("id", user.ID.String()))
+	}
+}
+
+// counterReconciliationWorker periodically recomputes denormalized counters
+// and reports how far they drifted.
+func (this *Worker) counterReconciliationWorker() {
+	defer this.waitGroup.Done()
+
+	for {
+		select {
+		case <-this.context.Done():
+			return
+		case <-time.After(COUNTER_RECONCILIATION_INTERVAL):
+		}
+
+		this.reconcileCounters()
+	}
+}
+
+func (this *Worker) reconcileCounters() {
+	drifts, err := this.database.ReconcileCounters(this.context)
+	if err != nil {
+		this.logger.Error("error reconciling counters", zap.Error(err))
+		return
+	}
+
+	for _, drift := range drifts {
+		metricpkg.ReportCounterDrift(drift.Counter, drift.Rows, drift.Delta)
+		if drift.Rows > 0 {
+			this.logger.Warn(
+				"counter drift fixed",
+				zap.String("counter", drift.Counter),
+				zap.Int64("rows", drift.Rows),
+				zap.Int64("delta", drift.Delta),
+			)
+		}
+	}
+}
+
+// deletedContentPurgeWorker periodically removes posts, comments and
+// communities deleted longer than the retention period ago.
+func (this *Worker) deletedContentPurgeWorker() {
+	defer this.waitGroup.Done()
+
+	for {
+		this.purgeDeletedContent()
+
+		select {
+		case <-this.context.Done():
+			return
+		case <-time.After(DELETED_CONTENT_PURGE_INTERVAL):
+		}
+	}
+}
+
+func (this *Worker) purgeDeletedContent() {
+	deletedBefore := time.Now().Add(-ormpkg.DELETED_CONTENT_RETENTION)
+
+	purges := []struct {
+		kind  string
+		purge func(deletedBefore time.Time, limit int) (int, error)
+	}{
+		{"comments", this.database.PurgeDeletedComments},
+		{"posts", this.database.PurgeDeletedPosts},
+		{"communities", this.database.PurgeDeletedCommunities},
+	}
+
+	for _, purge := range purges {
+		count, err := purge.purge(deletedBefore, DELETED_CONTENT_PURGE_BATCH_SIZE)
+		if err != nil {
+			this.logger.Error("error purging deleted content", zap.Error(err), zap.String("kind", purge.kind))
+			continue
+		}
+
+		if count > 0 {
+			this.logger.Info("purged deleted content", zap.String("kind", purge.kind), zap.Int("count", count))
+		}
+	}
+}
+
+// statisticsRollupWorker periodically recomputes the recent platform and
+// community statistics buckets and drops expired hourly ones.
+func (this *Worker) statisticsRollupWorker() {
+	defer this.waitGroup.Done()
+
+	for {
+		this.rollupStatistics()
+
+		select {
+		case <-this.context.Done():
+			return
+		case <-time.After(STATISTICS_ROLLUP_INTERVAL):
+		}
+	}
+}
+
+func (this *Worker) rollupStatistics() {
+	now := time.Now()
+	for _, granularity := range []string{ormpkg.STATISTICS_HOUR, ormpkg.STATISTICS_DAY} {
+		for _, bucket := range ormpkg.StatisticsBuckets(granularity, now.Add(-STATISTICS_ROLLUP_LOOKBACK), now) {
+			err := this.database.RollupStatistics(this.context, granularity, bucket)
+			if err != nil {
+				this.logger.Error(
+					"error rolling up statistics",
+					zap.Error(err),
+					zap.String("granularity", granularity),
+					zap.Time("bucket", bucket),
+				)
+			}
+		}
+	}
+
+	count, err := this.database.DeleteStatisticsBefore(ormpkg.STATISTICS_HOUR, now.Add(-STATISTICS_HOURLY_RETENTION))
+	if err != nil {
+		this.logger.Error("error deleting expired statistics", zap.Error(err))
+		return
+	}
+	if count > 0 {
+		this.logger.Info("deleted expired statistics", zap.Int64("count", count))
+	}
+}
+
 func (this *Worker) AuthorizationRegisterHandler(data []byte) error {
 	var message eventpkg.AuthorizationRegisterMessage
 	err := json.Unmarshal(data, &message)
@@ -102,7 +310,21 @@ func (this *Worker) AuthorizationRegisterHandler(data []byte) error {
 		return err
 	}
 
-	userID, err := uuid.Parse(message.ID)
+	return this.sendVerificationEmail(message.ID, message.VerificationToken)
+}
+
+func (this *Worker) AuthorizationRequestVerificationHandler(data []byte) error {
+	var message eventpkg.AuthorizationRequestVerificationMessage
+	err := json.Unmarshal(data, &message)
+	if err != nil {
+		return err
+	}
+
+	return this.sendVerificationEmail(message.ID, message.VerificationToken)
+}
+
+func (this *Worker) sendVerificationEmail(ID string, verificationToken string) error {
+	userID, err := uuid.Parse(ID)
 	if err != nil {
 		return err
 	}
@@ -112,7 +334,7 @@ func (this *Worker) AuthorizationRegisterHandler(data []byte) error {
 		return err
 	}
 
-	verificationURL := fmt.Sprintf("%s?token=%s", this.config.VerificationURL, user.VerificationToken)
+	verificationURL := fmt.Sprintf("%s?token=%s", this.config.VerificationURL, url.QueryEscape(verificationToken))
 
 	fromEmail := "no-reply@stormhead.org" // Placeholder, should be configurable
 	subject := "Email Verification"
@@ -124,7 +346,7 @@ func (this *Worker) AuthorizationRegisterHandler(data []byte) error {
 	}{
 		User: user.Name,
 		URL:  verificationURL,
-		Time: "24", // Placeholder for expiration time
+		Time: formatDuration(securitypkg.VERIFICATION_TOKEN_TTL),
 	}
 
 	content, err := templatepkg.Render("template/mail_confirm.html", templateData)
@@ -149,9 +371,101 @@ func (this *Worker) AuthorizationLoginHandler(data []byte) error {
 	}
 
 	this.logger.Info("user logged in", zap.String("id", message.ID))
+
+	// Older messages carry no session
+	if message.SessionID == "" {
+		return nil
+	}
+
+	session, err := this.database.SelectSessionByID(message.SessionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	device := lib.DeviceInfo{
+		Browser: session.Browser,
+		OS:      session.OS,
+		Device:  session.Device,
+	}
+	isNew, isFirst, err := this.database.RecordKnownDevice(session.UserID, lib.DeviceFingerprint(device, session.Country))
+	if err != nil {
+		return err
+	}
+
+	// The very first device recorded is not worth an alert
+	if !isNew || isFirst {
+		return nil
+	}
+
+	user, err := this.database.SelectUserByID(session.UserID.String())
+	if err != nil {
+		return err
+	}
+
+	fromEmail := "no-reply@stormhead.org" // Placeholder, should be configurable
+	subject := "New Login to Your Account"
+
+	templateData := struct {
+		User      string
+		Device    string
+		Location  string
+		IpAddress string
+		Time      string
+	}{
+		User:      user.Name,
+		Device:    describeDevice(session),
+		Location:  describeLocation(session),
+		IpAddress: session.IpAddress,
+		Time:      session.CreatedAt.UTC().Format("02.01.2006 15:04 MST"),
+	}
+
+	content, err := templatepkg.Render("template/mail_new_device.html", templateData)
+	if err != nil {
+		return err
+	}
+
+	err = this.mailClient.SendHTML(fromEmail, user.Email, subject, content)
+	if err != nil {
+		return err
+	}
+
+	this.logger.Info("sent new device login email", zap.String("email", user.Email))
 	return nil
 }
 
+// describeDevice renders e.g. "Chrome, Windows (desktop)" for emails.
+func describeDevice(session *ormpkg.Session) string {
+	parts := []string{}
+	for _, part := range []string{session.Browser, session.OS} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	description := strings.Join(parts, ", ")
+	if description == "" {
+		description = "неизвестное устройство"
+	}
+	if session.Device != "" {
+		description += " (" + session.Device + ")"
+	}
+	return description
+}
+
+func describeLocation(session *ormpkg.Session) string {
+	switch {
+	case session.City != "" && session.Country != "":
+		return session.City + ", " + session.Country
+	case session.Country != "":
+		return session.Country
+	default:
+		return "неизвестно"
+	}
+}
+
 func (this *Worker) AuthorizationRequestPasswordResetHandler(data []byte) error {
 	var message eventpkg.AuthorizationRequestPasswordReset
 	err := json.Unmarshal(data, &message)
@@ -169,7 +483,7 @@ func (this *Worker) AuthorizationRequestPasswordResetHandler(data []byte) error
 		return err
 	}
 
-	resetURL := fmt.Sprintf("%s?token=%s", this.config.PasswordResetURL, user.ResetToken)
+	resetURL := fmt.Sprintf("%s?token=%s", this.config.PasswordResetURL, url.QueryEscape(message.Token))
 
 	fromEmail := "no-reply@stormhead.org" // Placeholder, should be configurable
 	subject := "Password Reset Request"
@@ -181,7 +495,7 @@ func (this *Worker) AuthorizationRequestPasswordResetHandler(data []byte) error
 	}{
 		User: user.Name,
 		URL:  resetURL,
-		Time: "1", // Placeholder for expiration time
+		Time: formatDuration(securitypkg.PASSWORD_RESET_TOKEN_TTL),
 	}
 
 	content, err := templatepkg.Render("template/mail_recover.html", templateData)
@@ -197,3 +511,319 @@ func (this *Worker) AuthorizationRequestPasswordResetHandler(data []byte) error
 	this.logger.Info("sent password reset email", zap.String("email", user.Email))
 	return nil
 }
+
+func (this *Worker) AuthorizationAccountLockedHandler(data []byte) error {
+	var message eventpkg.AuthorizationAccountLockedMessage
+	err := json.Unmarshal(data, &message)
+	if err != nil {
+		return err
+	}
+
+	userID, err := uuid.Parse(message.ID)
+	if err != nil {
+		return err
+	}
+
+	user, err := this.database.SelectUserByID(userID.String())
+	if err != nil {
+		return err
+	}
+
+	unlockURL := fmt.Sprintf("%s?token=%s", this.config.UnlockURL, url.QueryEscape(message.Token))
+
+	fromEmail := "no-reply@stormhead.org" // Placeholder, should be configurable
+	subject := "Account Locked"
+
+	templateData := struct {
+		User string
+		URL  string
+		Time string
+	}{
+		User: user.Name,
+		URL:  unlockURL,
+		Time: formatDuration(securitypkg.UNLOCK_TOKEN_TTL),
+	}
+
+	content, err := templatepkg.Render("template/mail_unlock.html", templateData)
+	if err != nil {
+		return err
+	}
+
+	err = this.mailClient.SendHTML(fromEmail, user.Email, subject, content)
+	if err != nil {
+		return err
+	}
+
+	this.logger.Info("sent account unlock email", zap.String("email", user.Email))
+	return nil
+}
+
+func (this *Worker) AuthorizationRequestMagicLinkHandler(data []byte) error {
+	var message eventpkg.AuthorizationRequestMagicLinkMessage
+	err := json.Unmarshal(data, &message)
+	if err != nil {
+		return err
+	}
+
+	userID, err := uuid.Parse(message.ID)
+	if err != nil {
+		return err
+	}
+
+	user, err := this.database.SelectUserByID(userID.String())
+	if err != nil {
+		return err
+	}
+
+	magicLinkURL := fmt.Sprintf("%s?token=%s", this.config.MagicLinkURL, url.QueryEscape(message.Token))
+
+	fromEmail := "no-reply@stormhead.org" // Placeholder, should be configurable
+	subject := "Login Link"
+
+	templateData := struct {
+		User string
+		URL  string
+		Time string
+	}{
+		User: user.Name,
+		URL:  magicLinkURL,
+		Time: formatDuration(securitypkg.MAGIC_LINK_TOKEN_TTL),
+	}
+
+	content, err := templatepkg.Render("template/mail_magic_link.html", templateData)
+	if err != nil {
+		return err
+	}
+
+	err = this.mailClient.SendHTML(fromEmail, user.Email, subject, content)
+	if err != nil {
+		return err
+	}
+
+	this.logger.Info("sent magic link email", zap.String("email", user.Email))
+	return nil
+}
+
+func (this *Worker) AuthorizationRequestEmailChangeHandler(data []byte) error {
+	var message eventpkg.AuthorizationRequestEmailChangeMessage
+	err := json.Unmarshal(data, &message)
+	if err != nil {
+		return err
+	}
+
+	userID, err := uuid.Parse(message.ID)
+	if err != nil {
+		return err
+	}
+
+	user, err := this.database.SelectUserByID(userID.String())
+	if err != nil {
+		return err
+	}
+
+	change, err := this.database.SelectEmailChangeByID(message.EmailChangeID)
+	if err != nil {
+		return err
+	}
+
+	fromEmail := "no-reply@stormhead.org" // Placeholder, should be configurable
+
+	// Confirmation link to the new address
+	confirmData := struct {
+		User string
+		URL  string
+		Time string
+	}{
+		User: user.Name,
+		URL:  fmt.Sprintf("%s?token=%s", this.config.EmailChangeURL, url.QueryEscape(message.Token)),
+		Time: formatDuration(securitypkg.EMAIL_CHANGE_TOKEN_TTL),
+	}
+
+	content, err := templatepkg.Render("template/mail_email_change.html", confirmData)
+	if err != nil {
+		return err
+	}
+
+	err = this.mailClient.SendHTML(fromEmail, change.NewEmail, "Confirm Email Change", content)
+	if err != nil {
+		return err
+	}
+
+	// Notice with a revert link to the old address
+	noticeData := struct {
+		User  string
+		Email string
+		URL   string
+		Time  string
+	}{
+		User:  user.Name,
+		Email: change.NewEmail,
+		URL:   fmt.Sprintf("%s?token=%s", this.config.EmailRevertURL, url.QueryEscape(message.RevertToken)),
+		Time:  formatDuration(securitypkg.EMAIL_CHANGE_REVERT_TTL),
+	}
+
+	content, err = templatepkg.Render("template/mail_email_change_notice.html", noticeData)
+	if err != nil {
+		return err
+	}
+
+	err = this.mailClient.SendHTML(fromEmail, change.OldEmail, "Email Change Requested", content)
+	if err != nil {
+		return err
+	}
+
+	this.logger.Info("sent email change emails", zap.String("email", change.NewEmail))
+	return nil
+}
+
+func (this *Worker) AuthorizationRequestAccountDeletionHandler(data []byte) error {
+	var message eventpkg.AuthorizationRequestAccountDeletionMessage
+	err := json.Unmarshal(data, &message)
+	if err != nil {
+		return err
+	}
+
+	userID, err := uuid.Parse(message.ID)
+	if err != nil {
+		return err
+	}
+
+	user, err := this.database.SelectUserByID(userID.String())
+	if err != nil {
+		return err
+	}
+
+	// Already cancelled by logging in
+	if user.DeletionScheduledAt == nil {
+		return nil
+	}
+
+	fromEmail := "no-reply@stormhead.org" // Placeholder, should be configurable
+	subject := "Account Deletion Scheduled"
+
+	templateData := struct {
+		User string
+		Time string
+	}{
+		User: user.Name,
+		Time: user.DeletionScheduledAt.Format("02.01.2006"),
+	}
+
+	content, err := templatepkg.Render("template/mail_account_deletion.html", templateData)
+	if err != nil {
+		return err
+	}
+
+	err = this.mailClient.SendHTML(fromEmail, user.Email, subject, content)
+	if err != nil {
+		return err
+	}
+
+	this.logger.Info("sent account deletion email", zap.String("email", user.Email))
+	return nil
+}
+
+func (this *Worker) PlatformRegistrationApprovedHandler(data []byte) error {
+	var message eventpkg.PlatformRegistrationApprovedMessage
+	err := json.Unmarshal(data, &message)
+	if err != nil {
+		return err
+	}
+
+	userID, err := uuid.Parse(message.ID)
+	if err != nil {
+		return err
+	}
+
+	user, err := this.database.SelectUserByID(userID.String())
+	if err != nil {
+		return err
+	}
+
+	fromEmail := "no-reply@stormhead.org" // Placeholder, should be configurable
+	subject := "Registration Approved"
+
+	templateData := struct {
+		User     string
+		Approved bool
+	}{
+		User:     user.Name,
+		Approved: true,
+	}
+
+	content, err := templatepkg.Render("template/mail_registration_review.html", templateData)
+	if err != nil {
+		return err
+	}
+
+	err = this.mailClient.SendHTML(fromEmail, user.Email, subject, content)
+	if err != nil {
+		return err
+	}
+
+	this.logger.Info("sent registration approved email", zap.String("email", user.Email))
+	return nil
+}
+
+func (this *Worker) PlatformRegistrationRejectedHandler(data []byte) error {
+	var message eventpkg.PlatformRegistrationRejectedMessage
+	err := json.Unmarshal(data, &message)
+	if err != nil {
+		return err
+	}
+
+	fromEmail := "no-reply@stormhead.org" // Placeholder, should be configurable
+	subject := "Registration Declined"
+
+	templateData := struct {
+		User     string
+		Approved bool
+	}{
+		User:     message.Name,
+		Approved: false,
+	}
+
+	content, err := templatepkg.Render("template/mail_registration_review.html", templateData)
+	if err != nil {
+		return err
+	}
+
+	err = this.mailClient.SendHTML(fromEmail, message.Email, subject, content)
+	if err != nil {
+		return err
+	}
+
+	this.logger.Info("sent registration rejected email", zap.String("email", message.Email))
+	return nil
+}
+
+// formatDuration spells out a token lifetime in Russian for the email
+// templates, e.g. "24 часа", "1 час" or "15 минут".
+func formatDuration(duration time.Duration) string {
+	switch {
+	case duration >= 48*time.Hour && duration%(24*time.Hour) == 0:
+		days := int(duration / (24 * time.Hour))
+		return fmt.Sprintf("%d %s", days, pluralRu(days, "день", "дня", "дней"))
+	case duration >= time.Hour && duration%time.Hour == 0:
+		hours := int(duration / time.Hour)
+		return fmt.Sprintf("%d %s", hours, pluralRu(hours, "час", "часа", "часов"))
+	default:
+		minutes := int(duration / time.Minute)
+		return fmt.Sprintf("%d %s", minutes, pluralRu(minutes, "минуту", "минуты", "минут"))
+	}
+}
+
+func pluralRu(n int, one string, few string, many string) string {
+	if n%100 >= 11 && n%100 <= 14 {
+		return many
+	}
+
+	switch n % 10 {
+	case 1:
+		return one
+	case 2, 3, 4:
+		return few
+	default:
+		return many
+	}
+}
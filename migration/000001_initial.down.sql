@@ -7,3 +7,4 @@ DROP TABLE IF EXISTS "post_like";
 DROP TABLE IF EXISTS "bookmark";
 DROP TABLE IF EXISTS "comment";
 DROP TABLE IF EXISTS "comment_like";
+DROP TABLE IF EXISTS "follower";
@@ -1 +1,3 @@
-DROP TABLE IF EXISTS comments;
+DROP INDEX IF EXISTS idx_comment_parent_comment_id;
+DROP INDEX IF EXISTS idx_comment_author_id;
+DROP INDEX IF EXISTS idx_comment_post_id;
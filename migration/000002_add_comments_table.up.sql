@@ -1,17 +1,6 @@
-CREATE TABLE IF NOT EXISTS comments (
-    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
-    post_id UUID NOT NULL,
-    user_id UUID NOT NULL,
-    parent_id UUID,
-    content TEXT NOT NULL,
-    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-    deleted_at TIMESTAMPTZ,
-    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE,
-    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
-    FOREIGN KEY (parent_id) REFERENCES comments(id) ON DELETE CASCADE
-);
-
-CREATE INDEX IF NOT EXISTS idx_comments_post_id ON comments(post_id);
-CREATE INDEX IF NOT EXISTS idx_comments_user_id ON comments(user_id);
-CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments(parent_id);
+-- The comment table itself is created by 000001. This migration used to
+-- create a second, never used "comments" table referencing tables that do
+-- not exist; it now only adds the indexes that table was meant to have
+CREATE INDEX IF NOT EXISTS idx_comment_post_id ON "comment"(post_id);
+CREATE INDEX IF NOT EXISTS idx_comment_author_id ON "comment"(author_id);
+CREATE INDEX IF NOT EXISTS idx_comment_parent_comment_id ON "comment"(parent_comment_id);
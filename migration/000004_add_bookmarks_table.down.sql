@@ -1 +1,2 @@
-DROP TABLE IF EXISTS bookmarks;
+DROP INDEX IF EXISTS idx_bookmark_post_id;
+DROP INDEX IF EXISTS idx_bookmark_user_id;
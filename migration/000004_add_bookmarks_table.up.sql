@@ -1,11 +1,5 @@
-CREATE TABLE IF NOT EXISTS bookmarks (
-    user_id UUID NOT NULL,
-    post_id UUID NOT NULL,
-    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-    PRIMARY KEY (user_id, post_id),
-    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
-    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
-);
-
-CREATE INDEX IF NOT EXISTS idx_bookmarks_user_id ON bookmarks(user_id);
-CREATE INDEX IF NOT EXISTS idx_bookmarks_post_id ON bookmarks(post_id);
+-- The bookmark table itself is created by 000001. This migration used to
+-- create a second, never used "bookmarks" table referencing tables that do
+-- not exist; it now only adds the indexes that table was meant to have
+CREATE INDEX IF NOT EXISTS idx_bookmark_user_id ON "bookmark"(user_id);
+CREATE INDEX IF NOT EXISTS idx_bookmark_post_id ON "bookmark"(post_id);
@@ -0,0 +1,4 @@
+ALTER TABLE "user" DROP COLUMN IF EXISTS "unlock_token_expires_at";
+ALTER TABLE "user" DROP COLUMN IF EXISTS "unlock_token";
+DROP TABLE IF EXISTS "login_lockout";
+DROP TABLE IF EXISTS "auth_throttle";
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS "auth_throttle" (
+    kind TEXT NOT NULL,
+    key TEXT NOT NULL,
+    count INTEGER NOT NULL DEFAULT 0,
+    window_started_at TIMESTAMPTZ NOT NULL,
+    locked_until TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (kind, key)
+);
+
+CREATE TABLE IF NOT EXISTS "login_lockout" (
+    id UUID PRIMARY KEY,
+    kind TEXT NOT NULL,
+    user_id UUID,
+    email TEXT NOT NULL,
+    ip_address TEXT NOT NULL,
+    failure_count INTEGER NOT NULL,
+    locked_until TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_lockout_created_at ON "login_lockout"(created_at DESC, id DESC);
+
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS "unlock_token" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS "unlock_token_expires_at" TIMESTAMPTZ;
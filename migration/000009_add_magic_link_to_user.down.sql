@@ -0,0 +1,2 @@
+ALTER TABLE "user" DROP COLUMN "magic_link_expires_at";
+ALTER TABLE "user" DROP COLUMN "magic_link_token";
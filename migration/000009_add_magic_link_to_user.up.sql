@@ -0,0 +1,2 @@
+ALTER TABLE "user" ADD COLUMN "magic_link_token" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "user" ADD COLUMN "magic_link_expires_at" timestamptz;
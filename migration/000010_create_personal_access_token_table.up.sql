@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "personal_access_token" (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    token_prefix TEXT NOT NULL,
+    scopes JSONB NOT NULL DEFAULT '[]',
+    community_id UUID REFERENCES "community"(id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_personal_access_token_user_id ON "personal_access_token"(user_id);
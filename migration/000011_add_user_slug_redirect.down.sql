@@ -0,0 +1,5 @@
+-- Generated slugs are kept, the original email slugs are not restored
+DROP TABLE IF EXISTS "user_slug_redirect";
+DROP INDEX IF EXISTS idx_user_slug_skeleton;
+ALTER TABLE "user" DROP COLUMN "slug_changed_at";
+ALTER TABLE "user" DROP COLUMN "slug_skeleton";
@@ -0,0 +1,21 @@
+ALTER TABLE "user" ADD COLUMN "slug_skeleton" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "user" ADD COLUMN "slug_changed_at" timestamptz;
+
+-- Before slugs were chosen at registration both slug and name were set to the
+-- email address. Replace them with a generated slug so emails stop leaking.
+UPDATE "user" SET "name" = 'user_' || substr(replace(id::text, '-', ''), 1, 12) WHERE "name" = "email";
+UPDATE "user" SET "slug" = 'user_' || substr(replace(id::text, '-', ''), 1, 12) WHERE "slug" = "email";
+
+-- Same mapping as lib.UserSlugSkeleton
+UPDATE "user" SET "slug_skeleton" = replace(replace(translate(lower("slug"), '01i-_', 'oll'), 'rn', 'm'), 'vv', 'w');
+
+CREATE INDEX IF NOT EXISTS idx_user_slug_skeleton ON "user"(slug_skeleton);
+
+CREATE TABLE IF NOT EXISTS "user_slug_redirect" (
+    slug TEXT PRIMARY KEY,
+    slug_skeleton TEXT NOT NULL,
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_slug_redirect_slug_skeleton ON "user_slug_redirect"(slug_skeleton);
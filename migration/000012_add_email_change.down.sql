@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "email_change";
+
+-- Hashed verification tokens cannot be restored, pending users have to
+-- request a new verification email
+ALTER TABLE "user" DROP COLUMN "verification_token_expires_at";
@@ -0,0 +1,25 @@
+ALTER TABLE "user" ADD COLUMN "verification_token_expires_at" timestamptz;
+
+-- Verification tokens are stored as their SHA-256 from now on. Pending ones
+-- are hashed in place and get a fresh lifetime, used ones are dropped.
+UPDATE "user"
+SET "verification_token" = encode(sha256(convert_to("verification_token", 'UTF8')), 'hex'),
+    "verification_token_expires_at" = now() + interval '24 hours'
+WHERE "verification_token" <> '' AND NOT "is_verified";
+UPDATE "user" SET "verification_token" = '' WHERE "is_verified";
+
+CREATE TABLE IF NOT EXISTS "email_change" (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    old_email TEXT NOT NULL,
+    new_email TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    revert_token_hash TEXT NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revert_expires_at TIMESTAMPTZ NOT NULL,
+    confirmed_at TIMESTAMPTZ,
+    reverted_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_change_user_id ON "email_change"(user_id);
@@ -0,0 +1,27 @@
+ALTER TABLE "session" DROP COLUMN "refresh_token_hash";
+
+-- Email change tokens cannot be put back per change, pending changes are dropped
+DELETE FROM "email_change";
+ALTER TABLE "email_change" ADD COLUMN "token_hash" TEXT NOT NULL UNIQUE;
+ALTER TABLE "email_change" ADD COLUMN "revert_token_hash" TEXT NOT NULL UNIQUE;
+ALTER TABLE "email_change" ADD COLUMN "expires_at" TIMESTAMPTZ NOT NULL;
+ALTER TABLE "email_change" ADD COLUMN "revert_expires_at" TIMESTAMPTZ NOT NULL;
+
+ALTER TABLE "user" ADD COLUMN "verification_token" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "user" ADD COLUMN "verification_token_expires_at" timestamptz;
+ALTER TABLE "user" ADD COLUMN "reset_token" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "user" ADD COLUMN "reset_token_expires_at" timestamptz;
+ALTER TABLE "user" ADD COLUMN "unlock_token" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "user" ADD COLUMN "unlock_token_expires_at" timestamptz;
+ALTER TABLE "user" ADD COLUMN "magic_link_token" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "user" ADD COLUMN "magic_link_expires_at" timestamptz;
+
+-- Verification tokens were hashed before as well and can be restored, the
+-- other plaintext tokens cannot and have to be requested again
+UPDATE "user" u
+SET verification_token = t.token_hash,
+    verification_token_expires_at = t.expires_at
+FROM "one_time_token" t
+WHERE t.user_id = u.id AND t.purpose = 'verification' AND t.consumed_at IS NULL;
+
+DROP TABLE IF EXISTS "one_time_token";
@@ -0,0 +1,63 @@
+CREATE TABLE IF NOT EXISTS "one_time_token" (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    purpose TEXT NOT NULL,
+    subject_id UUID,
+    token_hash TEXT NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    consumed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_one_time_token_user_id_purpose ON "one_time_token"(user_id, purpose);
+CREATE INDEX IF NOT EXISTS idx_one_time_token_subject_id ON "one_time_token"(subject_id);
+
+-- Move pending tokens over. Verification tokens are already hashed, the
+-- others were stored in plaintext and are hashed on the way.
+INSERT INTO "one_time_token" (id, user_id, purpose, token_hash, expires_at, created_at)
+SELECT gen_random_uuid(), id, 'verification', verification_token, verification_token_expires_at, now()
+FROM "user"
+WHERE verification_token <> '' AND verification_token_expires_at > now();
+
+INSERT INTO "one_time_token" (id, user_id, purpose, token_hash, expires_at, created_at)
+SELECT gen_random_uuid(), id, 'password_reset', encode(sha256(convert_to(reset_token, 'UTF8')), 'hex'), reset_token_expires_at, now()
+FROM "user"
+WHERE reset_token <> '' AND reset_token_expires_at > now();
+
+INSERT INTO "one_time_token" (id, user_id, purpose, token_hash, expires_at, created_at)
+SELECT gen_random_uuid(), id, 'unlock', encode(sha256(convert_to(unlock_token, 'UTF8')), 'hex'), unlock_token_expires_at, now()
+FROM "user"
+WHERE unlock_token <> '' AND unlock_token_expires_at > now();
+
+INSERT INTO "one_time_token" (id, user_id, purpose, token_hash, expires_at, created_at)
+SELECT gen_random_uuid(), id, 'magic_link', encode(sha256(convert_to(magic_link_token, 'UTF8')), 'hex'), magic_link_expires_at, now()
+FROM "user"
+WHERE magic_link_token <> '' AND magic_link_expires_at > now();
+
+INSERT INTO "one_time_token" (id, user_id, purpose, subject_id, token_hash, expires_at, created_at)
+SELECT gen_random_uuid(), user_id, 'email_change', id, token_hash, expires_at, created_at
+FROM "email_change"
+WHERE confirmed_at IS NULL AND reverted_at IS NULL AND expires_at > now();
+
+INSERT INTO "one_time_token" (id, user_id, purpose, subject_id, token_hash, expires_at, created_at)
+SELECT gen_random_uuid(), user_id, 'email_change_revert', id, revert_token_hash, revert_expires_at, created_at
+FROM "email_change"
+WHERE reverted_at IS NULL AND revert_expires_at > now();
+
+ALTER TABLE "user" DROP COLUMN "verification_token";
+ALTER TABLE "user" DROP COLUMN "verification_token_expires_at";
+ALTER TABLE "user" DROP COLUMN "reset_token";
+ALTER TABLE "user" DROP COLUMN "reset_token_expires_at";
+ALTER TABLE "user" DROP COLUMN "unlock_token";
+ALTER TABLE "user" DROP COLUMN "unlock_token_expires_at";
+ALTER TABLE "user" DROP COLUMN "magic_link_token";
+ALTER TABLE "user" DROP COLUMN "magic_link_expires_at";
+
+ALTER TABLE "email_change" DROP COLUMN "token_hash";
+ALTER TABLE "email_change" DROP COLUMN "revert_token_hash";
+ALTER TABLE "email_change" DROP COLUMN "expires_at";
+ALTER TABLE "email_change" DROP COLUMN "revert_expires_at";
+
+-- Refresh tokens are rotated from now on; existing sessions have no hash
+-- yet and accept their current refresh token once.
+ALTER TABLE "session" ADD COLUMN "refresh_token_hash" TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,8 @@
+ALTER TABLE platform_settings DROP COLUMN tombstone_user_id;
+ALTER TABLE platform_settings DROP COLUMN deleted_user_content;
+
+ALTER TABLE "community" DROP COLUMN "archived_at";
+
+DROP INDEX IF EXISTS idx_user_deletion_scheduled_at;
+ALTER TABLE "user" DROP COLUMN "deletion_scheduled_at";
+ALTER TABLE "user" DROP COLUMN "deletion_requested_at";
@@ -0,0 +1,12 @@
+ALTER TABLE "user" ADD COLUMN "deletion_requested_at" timestamptz;
+ALTER TABLE "user" ADD COLUMN "deletion_scheduled_at" timestamptz;
+
+CREATE INDEX IF NOT EXISTS idx_user_deletion_scheduled_at ON "user"(deletion_scheduled_at) WHERE deletion_scheduled_at IS NOT NULL;
+
+-- Communities of deleted owners without any other member to take them over
+ALTER TABLE "community" ADD COLUMN "archived_at" timestamptz;
+
+-- What happens to posts and comments of deleted accounts: 'reassign' keeps
+-- them under the tombstone user, 'delete' removes them
+ALTER TABLE platform_settings ADD COLUMN deleted_user_content TEXT NOT NULL DEFAULT 'reassign';
+ALTER TABLE platform_settings ADD COLUMN tombstone_user_id UUID;
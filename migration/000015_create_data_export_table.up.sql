@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "data_export" (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    status TEXT NOT NULL,
+    progress INT NOT NULL DEFAULT 0,
+    object_key TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_data_export_user_id_created_at ON "data_export"(user_id, created_at DESC);
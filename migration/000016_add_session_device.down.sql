@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS "known_device";
+
+ALTER TABLE "session" DROP COLUMN "city";
+ALTER TABLE "session" DROP COLUMN "country";
+ALTER TABLE "session" DROP COLUMN "device";
+ALTER TABLE "session" DROP COLUMN "os";
+ALTER TABLE "session" DROP COLUMN "browser";
+ALTER TABLE "session" DROP COLUMN "name";
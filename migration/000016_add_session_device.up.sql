@@ -0,0 +1,18 @@
+-- Device and approximate location of a session, resolved at login from the
+-- user agent and the GeoIP database, plus a name the user can give it
+ALTER TABLE "session" ADD COLUMN "name" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "session" ADD COLUMN "browser" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "session" ADD COLUMN "os" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "session" ADD COLUMN "device" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "session" ADD COLUMN "country" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "session" ADD COLUMN "city" TEXT NOT NULL DEFAULT '';
+
+-- Device and location combinations a user has logged in from, a login from
+-- a new one triggers an email alert
+CREATE TABLE IF NOT EXISTS "known_device" (
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    fingerprint TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    last_seen_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, fingerprint)
+);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_user_pending_approval;
+ALTER TABLE "user" DROP COLUMN "invite_code_id";
+ALTER TABLE "user" DROP COLUMN "is_approved";
+
+DROP TABLE IF EXISTS "invite_code";
+
+ALTER TABLE platform_settings DROP COLUMN registration_mode;
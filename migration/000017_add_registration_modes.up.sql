@@ -0,0 +1,24 @@
+-- Who may sign up: 'open', 'invite_only', 'approval' (accounts wait for an
+-- administrator) or 'closed'
+ALTER TABLE platform_settings ADD COLUMN registration_mode TEXT NOT NULL DEFAULT 'open';
+
+-- Invite codes, only their hash is stored. max_uses NULL means unlimited
+CREATE TABLE IF NOT EXISTS "invite_code" (
+    id UUID PRIMARY KEY,
+    code_hash TEXT NOT NULL UNIQUE,
+    code_prefix TEXT NOT NULL,
+    created_by UUID REFERENCES "user"(id) ON DELETE SET NULL,
+    max_uses INTEGER,
+    use_count INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_invite_code_created_at ON "invite_code"(created_at DESC);
+
+-- Accounts registered in approval mode cannot log in until approved
+ALTER TABLE "user" ADD COLUMN "is_approved" BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE "user" ADD COLUMN "invite_code_id" UUID REFERENCES "invite_code"(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_user_pending_approval ON "user"(created_at) WHERE is_approved = FALSE;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_user_email_lower;
+
+DROP TABLE IF EXISTS "email_domain_rule";
@@ -0,0 +1,12 @@
+-- Administrator managed email domain policy, 'allow' or 'deny'. Rules also
+-- match subdomains; once any allow rule exists only allowed domains may
+-- register
+CREATE TABLE IF NOT EXISTS "email_domain_rule" (
+    domain TEXT PRIMARY KEY,
+    kind TEXT NOT NULL,
+    created_by UUID REFERENCES "user"(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+-- Emails are looked up case-insensitively, older rows may be mixed case
+CREATE INDEX IF NOT EXISTS idx_user_email_lower ON "user"(LOWER(email));
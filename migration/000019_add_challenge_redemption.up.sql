@@ -0,0 +1,8 @@
+-- Solved proof of work challenges, so that each can be used only once.
+-- Rows are useless after expires_at and are removed by the cleanup command
+CREATE TABLE IF NOT EXISTS "challenge_redemption" (
+    nonce TEXT PRIMARY KEY,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_challenge_redemption_expires_at ON "challenge_redemption"(expires_at);
@@ -0,0 +1,14 @@
+ALTER TABLE "community" ALTER COLUMN post_count DROP NOT NULL;
+ALTER TABLE "community" ALTER COLUMN member_count DROP NOT NULL;
+
+ALTER TABLE "comment" ALTER COLUMN like_count DROP NOT NULL;
+ALTER TABLE "comment" ALTER COLUMN like_count DROP DEFAULT;
+
+ALTER TABLE "post" ALTER COLUMN comment_count DROP NOT NULL;
+ALTER TABLE "post" ALTER COLUMN comment_count DROP DEFAULT;
+ALTER TABLE "post" ALTER COLUMN like_count DROP NOT NULL;
+ALTER TABLE "post" ALTER COLUMN like_count DROP DEFAULT;
+
+ALTER TABLE "community_user" DROP CONSTRAINT IF EXISTS community_user_community_id_user_id_key;
+ALTER TABLE "comment_like" DROP CONSTRAINT IF EXISTS comment_like_comment_id_user_id_key;
+ALTER TABLE "post_like" DROP CONSTRAINT IF EXISTS post_like_post_id_user_id_key;
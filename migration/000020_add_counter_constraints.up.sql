@@ -0,0 +1,32 @@
+-- Likes and memberships are unique per user, so counters can be bumped only
+-- when a row was really inserted or deleted. Drop duplicates left by
+-- concurrent requests first
+DELETE FROM "post_like" a USING "post_like" b
+    WHERE a.post_id = b.post_id AND a.user_id = b.user_id AND a.ctid > b.ctid;
+ALTER TABLE "post_like" ADD CONSTRAINT post_like_post_id_user_id_key UNIQUE (post_id, user_id);
+
+DELETE FROM "comment_like" a USING "comment_like" b
+    WHERE a.comment_id = b.comment_id AND a.user_id = b.user_id AND a.ctid > b.ctid;
+ALTER TABLE "comment_like" ADD CONSTRAINT comment_like_comment_id_user_id_key UNIQUE (comment_id, user_id);
+
+DELETE FROM "community_user" a USING "community_user" b
+    WHERE a.community_id = b.community_id AND a.user_id = b.user_id AND a.ctid > b.ctid;
+ALTER TABLE "community_user" ADD CONSTRAINT community_user_community_id_user_id_key UNIQUE (community_id, user_id);
+
+-- Counters are incremented in SQL, NULL would stay NULL. Values are fixed by
+-- the reconcile-counters command
+UPDATE "post" SET like_count = 0 WHERE like_count IS NULL;
+UPDATE "post" SET comment_count = 0 WHERE comment_count IS NULL;
+ALTER TABLE "post" ALTER COLUMN like_count SET DEFAULT 0;
+ALTER TABLE "post" ALTER COLUMN like_count SET NOT NULL;
+ALTER TABLE "post" ALTER COLUMN comment_count SET DEFAULT 0;
+ALTER TABLE "post" ALTER COLUMN comment_count SET NOT NULL;
+
+UPDATE "comment" SET like_count = 0 WHERE like_count IS NULL;
+ALTER TABLE "comment" ALTER COLUMN like_count SET DEFAULT 0;
+ALTER TABLE "comment" ALTER COLUMN like_count SET NOT NULL;
+
+UPDATE "community" SET member_count = 0 WHERE member_count IS NULL;
+UPDATE "community" SET post_count = 0 WHERE post_count IS NULL;
+ALTER TABLE "community" ALTER COLUMN member_count SET NOT NULL;
+ALTER TABLE "community" ALTER COLUMN post_count SET NOT NULL;
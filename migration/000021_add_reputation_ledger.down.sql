@@ -0,0 +1,5 @@
+ALTER TABLE "community" ALTER COLUMN reputation DROP NOT NULL;
+ALTER TABLE "community" ALTER COLUMN reputation TYPE INTEGER USING round(reputation);
+
+DROP TABLE IF EXISTS "community_karma";
+DROP TABLE IF EXISTS "reputation_event";
@@ -0,0 +1,38 @@
+-- Every reputation change as a signed delta. user_delta goes to the user's
+-- reputation and, when the event happened in a community, to their karma
+-- there; community_delta goes to the community rating. source_id is the
+-- like or comment that caused the change, undoing it records the opposite
+-- deltas with a "_removed" kind
+CREATE TABLE IF NOT EXISTS "reputation_event" (
+    id UUID PRIMARY KEY,
+    user_id UUID REFERENCES "user"(id) ON DELETE CASCADE,
+    community_id UUID REFERENCES "community"(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    source_id UUID,
+    actor_id UUID REFERENCES "user"(id) ON DELETE SET NULL,
+    user_delta BIGINT NOT NULL DEFAULT 0,
+    community_delta NUMERIC(14, 1) NOT NULL DEFAULT 0,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_reputation_event_user ON "reputation_event"(user_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_reputation_event_source ON "reputation_event"(source_id);
+
+-- Reputation a user earned in a community, materialized from the ledger
+CREATE TABLE IF NOT EXISTS "community_karma" (
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    community_id UUID NOT NULL REFERENCES "community"(id) ON DELETE CASCADE,
+    karma BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id, community_id)
+);
+
+-- Comments weigh 0.1 in the community rating
+UPDATE "community" SET reputation = 0 WHERE reputation IS NULL;
+ALTER TABLE "community" ALTER COLUMN reputation TYPE NUMERIC(14, 1);
+ALTER TABLE "community" ALTER COLUMN reputation SET NOT NULL;
+
+-- Materialized total of user_delta. Older databases may have the column
+-- from before migrations tracked it
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS "reputation" BIGINT NOT NULL DEFAULT 0;
+UPDATE "user" SET reputation = 0 WHERE reputation IS NULL;
@@ -0,0 +1,12 @@
+-- Only the constraints are relaxed: the user columns may have existed
+-- before 000022 and the old tables and foreign keys were never usable
+ALTER TABLE "post" ALTER COLUMN status DROP NOT NULL;
+ALTER TABLE "post" ALTER COLUMN status DROP DEFAULT;
+
+ALTER TABLE "community" ALTER COLUMN ban_reason DROP NOT NULL;
+ALTER TABLE "community" ALTER COLUMN ban_reason DROP DEFAULT;
+ALTER TABLE "community" ALTER COLUMN is_banned DROP NOT NULL;
+ALTER TABLE "community" ALTER COLUMN is_banned DROP DEFAULT;
+
+ALTER TABLE "user" ALTER COLUMN is_verified DROP NOT NULL;
+ALTER TABLE "user" ALTER COLUMN is_verified DROP DEFAULT;
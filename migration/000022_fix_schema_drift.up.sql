@@ -0,0 +1,46 @@
+-- Brings databases created by the old 000002-000004 and 000007, which
+-- referenced plural tables that never existed, and databases whose columns
+-- were added outside of migrations in line with the models in internal/orm.
+-- Every statement is safe to run on a database built by the fixed migrations
+
+-- Leftovers of the old 000002 and 000004, never used by the application
+DROP TABLE IF EXISTS comments;
+DROP TABLE IF EXISTS bookmarks;
+
+CREATE INDEX IF NOT EXISTS idx_comment_post_id ON "comment"(post_id);
+CREATE INDEX IF NOT EXISTS idx_comment_author_id ON "comment"(author_id);
+CREATE INDEX IF NOT EXISTS idx_comment_parent_comment_id ON "comment"(parent_comment_id);
+CREATE INDEX IF NOT EXISTS idx_bookmark_user_id ON "bookmark"(user_id);
+CREATE INDEX IF NOT EXISTS idx_bookmark_post_id ON "bookmark"(post_id);
+
+-- Point the foreign keys of 000003 and 000007 at the real tables. NOT VALID
+-- keeps rows written against the plural tables, new rows are checked
+ALTER TABLE roles DROP CONSTRAINT IF EXISTS fk_community;
+ALTER TABLE roles ADD CONSTRAINT fk_community FOREIGN KEY (community_id) REFERENCES "community"(id) ON DELETE CASCADE NOT VALID;
+ALTER TABLE user_roles DROP CONSTRAINT IF EXISTS user_roles_user_id_fkey;
+ALTER TABLE user_roles ADD CONSTRAINT user_roles_user_id_fkey FOREIGN KEY (user_id) REFERENCES "user"(id) ON DELETE CASCADE NOT VALID;
+ALTER TABLE platform_settings DROP CONSTRAINT IF EXISTS platform_settings_platform_owner_id_fkey;
+ALTER TABLE platform_settings ADD CONSTRAINT platform_settings_platform_owner_id_fkey FOREIGN KEY (platform_owner_id) REFERENCES "user"(id) ON DELETE SET NULL NOT VALID;
+
+INSERT INTO platform_settings (id) VALUES (1) ON CONFLICT (id) DO NOTHING;
+
+-- Columns the models use that no migration created
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS "last_activity" TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS "is_banned" BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS "ban_reason" TEXT NOT NULL DEFAULT '';
+
+-- NULL cannot be read into the non-pointer fields of the models
+UPDATE "user" SET is_verified = FALSE WHERE is_verified IS NULL;
+ALTER TABLE "user" ALTER COLUMN is_verified SET DEFAULT FALSE;
+ALTER TABLE "user" ALTER COLUMN is_verified SET NOT NULL;
+
+UPDATE "community" SET is_banned = FALSE WHERE is_banned IS NULL;
+ALTER TABLE "community" ALTER COLUMN is_banned SET DEFAULT FALSE;
+ALTER TABLE "community" ALTER COLUMN is_banned SET NOT NULL;
+UPDATE "community" SET ban_reason = '' WHERE ban_reason IS NULL;
+ALTER TABLE "community" ALTER COLUMN ban_reason SET DEFAULT '';
+ALTER TABLE "community" ALTER COLUMN ban_reason SET NOT NULL;
+
+UPDATE "post" SET status = 0 WHERE status IS NULL;
+ALTER TABLE "post" ALTER COLUMN status SET DEFAULT 0;
+ALTER TABLE "post" ALTER COLUMN status SET NOT NULL;
@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_community_deleted_at;
+ALTER TABLE "community" DROP COLUMN IF EXISTS "deletion_reason";
+ALTER TABLE "community" DROP COLUMN IF EXISTS "deleted_by";
+ALTER TABLE "community" DROP COLUMN IF EXISTS "deleted_at";
+
+DROP INDEX IF EXISTS idx_comment_deleted_at;
+ALTER TABLE "comment" DROP COLUMN IF EXISTS "deletion_reason";
+ALTER TABLE "comment" DROP COLUMN IF EXISTS "deleted_by";
+ALTER TABLE "comment" DROP COLUMN IF EXISTS "deleted_at";
+
+DROP INDEX IF EXISTS idx_post_deleted_at;
+ALTER TABLE "post" DROP COLUMN IF EXISTS "deletion_reason";
+ALTER TABLE "post" DROP COLUMN IF EXISTS "deleted_by";
+ALTER TABLE "post" DROP COLUMN IF EXISTS "deleted_at";
@@ -0,0 +1,16 @@
+-- Deleted posts, comments and communities are kept for a retention window,
+-- so their authors and moderators can restore them, and purged afterwards
+ALTER TABLE "post" ADD COLUMN IF NOT EXISTS "deleted_at" timestamptz;
+ALTER TABLE "post" ADD COLUMN IF NOT EXISTS "deleted_by" UUID REFERENCES "user"(id) ON DELETE SET NULL;
+ALTER TABLE "post" ADD COLUMN IF NOT EXISTS "deletion_reason" TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_post_deleted_at ON "post"(deleted_at) WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE "comment" ADD COLUMN IF NOT EXISTS "deleted_at" timestamptz;
+ALTER TABLE "comment" ADD COLUMN IF NOT EXISTS "deleted_by" UUID REFERENCES "user"(id) ON DELETE SET NULL;
+ALTER TABLE "comment" ADD COLUMN IF NOT EXISTS "deletion_reason" TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_comment_deleted_at ON "comment"(deleted_at) WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE "community" ADD COLUMN IF NOT EXISTS "deleted_at" timestamptz;
+ALTER TABLE "community" ADD COLUMN IF NOT EXISTS "deleted_by" UUID REFERENCES "user"(id) ON DELETE SET NULL;
+ALTER TABLE "community" ADD COLUMN IF NOT EXISTS "deletion_reason" TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_community_deleted_at ON "community"(deleted_at) WHERE deleted_at IS NOT NULL;
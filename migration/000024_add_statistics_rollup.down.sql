@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_session_created_at;
+DROP INDEX IF EXISTS idx_community_user_created_at;
+DROP INDEX IF EXISTS idx_comment_like_created_at;
+DROP INDEX IF EXISTS idx_post_like_created_at;
+DROP INDEX IF EXISTS idx_comment_created_at;
+DROP INDEX IF EXISTS idx_post_published_at;
+DROP INDEX IF EXISTS idx_community_created_at;
+DROP INDEX IF EXISTS idx_user_created_at;
+
+DROP TABLE IF EXISTS "community_statistics";
+DROP TABLE IF EXISTS "platform_statistics";
@@ -0,0 +1,39 @@
+-- Time-bucketed activity counts, recomputed by the worker so that
+-- statistics don't scan the content tables. granularity is hour or day,
+-- bucket_start is the start of the bucket in UTC
+CREATE TABLE IF NOT EXISTS "platform_statistics" (
+    granularity TEXT NOT NULL,
+    bucket_start TIMESTAMPTZ NOT NULL,
+    new_users BIGINT NOT NULL DEFAULT 0,
+    active_users BIGINT NOT NULL DEFAULT 0,
+    new_communities BIGINT NOT NULL DEFAULT 0,
+    posts BIGINT NOT NULL DEFAULT 0,
+    comments BIGINT NOT NULL DEFAULT 0,
+    likes BIGINT NOT NULL DEFAULT 0,
+    joins BIGINT NOT NULL DEFAULT 0,
+    computed_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (granularity, bucket_start)
+);
+
+CREATE TABLE IF NOT EXISTS "community_statistics" (
+    community_id UUID NOT NULL REFERENCES "community"(id) ON DELETE CASCADE,
+    granularity TEXT NOT NULL,
+    bucket_start TIMESTAMPTZ NOT NULL,
+    active_users BIGINT NOT NULL DEFAULT 0,
+    posts BIGINT NOT NULL DEFAULT 0,
+    comments BIGINT NOT NULL DEFAULT 0,
+    likes BIGINT NOT NULL DEFAULT 0,
+    joins BIGINT NOT NULL DEFAULT 0,
+    computed_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (community_id, granularity, bucket_start)
+);
+
+-- The rollup reads the content tables by creation time
+CREATE INDEX IF NOT EXISTS idx_user_created_at ON "user"(created_at);
+CREATE INDEX IF NOT EXISTS idx_community_created_at ON "community"(created_at);
+CREATE INDEX IF NOT EXISTS idx_post_published_at ON "post"(published_at);
+CREATE INDEX IF NOT EXISTS idx_comment_created_at ON "comment"(created_at);
+CREATE INDEX IF NOT EXISTS idx_post_like_created_at ON "post_like"(created_at);
+CREATE INDEX IF NOT EXISTS idx_comment_like_created_at ON "comment_like"(created_at);
+CREATE INDEX IF NOT EXISTS idx_community_user_created_at ON "community_user"(created_at);
+CREATE INDEX IF NOT EXISTS idx_session_created_at ON "session"(created_at);
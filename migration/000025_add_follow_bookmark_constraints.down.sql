@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_bookmark_user_id_created_at;
+
+ALTER TABLE "bookmark" DROP CONSTRAINT IF EXISTS bookmark_post_id_user_id_key;
+ALTER TABLE "follower" DROP CONSTRAINT IF EXISTS follower_user_id_follower_id_key;
@@ -0,0 +1,15 @@
+-- Follows and bookmarks are unique per user, so that a repeated request
+-- can't insert a second row. Drop duplicates left by concurrent requests
+-- first, keeping the oldest
+DELETE FROM "follower" a USING "follower" b
+    WHERE a.user_id = b.user_id AND a.follower_id = b.follower_id
+    AND (a.created_at, a.id) > (b.created_at, b.id);
+ALTER TABLE "follower" ADD CONSTRAINT follower_user_id_follower_id_key UNIQUE (user_id, follower_id);
+
+DELETE FROM "bookmark" a USING "bookmark" b
+    WHERE a.post_id = b.post_id AND a.user_id = b.user_id
+    AND (a.created_at, a.id) > (b.created_at, b.id);
+ALTER TABLE "bookmark" ADD CONSTRAINT bookmark_post_id_user_id_key UNIQUE (post_id, user_id);
+
+-- Bookmarks are listed per user, newest first
+CREATE INDEX IF NOT EXISTS idx_bookmark_user_id_created_at ON "bookmark"(user_id, created_at DESC, id DESC);
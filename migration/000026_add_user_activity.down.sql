@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_user_activity_hour;
+
+DROP TABLE IF EXISTS "user_activity";
@@ -0,0 +1,17 @@
+-- The hours in which a user logged in or refreshed a session. Rows are only
+-- ever added: sessions are deleted on logout and by the session purge, so
+-- the statistics rollup reads logins from here to recompute past buckets
+CREATE TABLE IF NOT EXISTS "user_activity" (
+    user_id UUID NOT NULL,
+    hour TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, hour)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_activity_hour ON "user_activity"(hour);
+
+-- Keep the logins of the sessions that still exist
+INSERT INTO "user_activity" (user_id, hour)
+SELECT user_id, date_trunc('hour', created_at, 'UTC') FROM "session"
+UNION
+SELECT user_id, date_trunc('hour', updated_at, 'UTC') FROM "session"
+ON CONFLICT DO NOTHING;
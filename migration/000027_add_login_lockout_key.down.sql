@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_login_lockout_kind_key;
+
+ALTER TABLE "login_lockout" DROP COLUMN IF EXISTS key;
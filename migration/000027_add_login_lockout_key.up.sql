@@ -0,0 +1,10 @@
+-- The throttle key a lockout was recorded for: the email of account lockouts
+-- and the ip address of ip lockouts. A failure past the threshold records a
+-- new lockout only when none is active for the key
+ALTER TABLE "login_lockout" ADD COLUMN IF NOT EXISTS key TEXT NOT NULL DEFAULT '';
+
+UPDATE "login_lockout" SET key = CASE WHEN kind = 'login_ip' THEN ip_address ELSE email END;
+
+ALTER TABLE "login_lockout" ALTER COLUMN key DROP DEFAULT;
+
+CREATE INDEX IF NOT EXISTS idx_login_lockout_kind_key ON "login_lockout"(kind, key, locked_until);
@@ -0,0 +1,8 @@
+// Package migration embeds the SQL migrations into the backend binary, they
+// are applied with `backend migrate up`.
+package migration
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	migrationpkg "github.com/stormhead-org/backend/migration"
+)
+
+// A login counts as activity of its hour even after the session is gone,
+// and refreshing a session counts as activity of the hour of the refresh.
+func TestRollupStatisticsLoginActivity(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := ormpkg.NewPostgresClientWithDSN(pgConnStr)
+	if err != nil {
+		t.Fatalf("failed to connect to database: %v", err)
+	}
+
+	migrations, err := ormpkg.LoadMigrations(migrationpkg.FS)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+
+	_, err = client.MigrateUp(ctx, migrations)
+	if err != nil {
+		t.Fatalf("failed to apply migrations: %v", err)
+	}
+
+	insertUser := func(slug string) *ormpkg.User {
+		user := &ormpkg.User{
+			Slug:         slug,
+			SlugSkeleton: slug,
+			Name:         slug,
+			Email:        slug + "@rollup.example",
+			Password:     "password",
+			LastActivity: time.Now(),
+		}
+		err := client.InsertUser(user)
+		if err != nil {
+			t.Fatalf("failed to insert user %s: %v", slug, err)
+		}
+		return user
+	}
+
+	// Rows of other tests may share the current hour, so the test compares
+	// against the count before its own activity
+	bucket := time.Now().UTC().Truncate(time.Hour)
+	activeUsers := func() int64 {
+		err := client.RollupStatistics(ctx, ormpkg.STATISTICS_HOUR, bucket)
+		if err != nil {
+			t.Fatalf("failed to roll up statistics: %v", err)
+		}
+
+		statistics, err := client.SelectPlatformStatistics(ormpkg.STATISTICS_HOUR, bucket, bucket.Add(time.Hour))
+		if err != nil {
+			t.Fatalf("failed to select statistics: %v", err)
+		}
+		if len(statistics) != 1 {
+			t.Fatalf("got %d buckets, want 1", len(statistics))
+		}
+		return statistics[0].ActiveUsers
+	}
+	before := activeUsers()
+
+	suffix := uuid.NewString()[:8]
+	loggedOut := insertUser("rollup-out-" + suffix)
+	refreshed := insertUser("rollup-refresh-" + suffix)
+	insertUser("rollup-idle-" + suffix)
+
+	session := &ormpkg.Session{UserID: loggedOut.ID}
+	err = client.InsertSession(session)
+	if err != nil {
+		t.Fatalf("failed to insert session: %v", err)
+	}
+	err = client.DeleteSession(session)
+	if err != nil {
+		t.Fatalf("failed to delete session: %v", err)
+	}
+
+	// A session from the last hour only counts for this one when refreshed
+	old := &ormpkg.Session{UserID: refreshed.ID, RefreshTokenHash: "hash0", CreatedAt: bucket.Add(-time.Hour)}
+	err = client.InsertSession(old)
+	if err != nil {
+		t.Fatalf("failed to insert session: %v", err)
+	}
+	rotated, err := client.RotateSessionRefreshToken(old.ID.String(), "hash0", "hash1")
+	if err != nil || !rotated {
+		t.Fatalf("failed to rotate refresh token: %v, rotated %v", err, rotated)
+	}
+	err = client.DeleteSession(old)
+	if err != nil {
+		t.Fatalf("failed to delete session: %v", err)
+	}
+
+	if time.Now().UTC().Truncate(time.Hour) != bucket {
+		t.Skip("the hour changed during the test")
+	}
+
+	got := activeUsers()
+	if got != before+2 {
+		t.Fatalf("active users: got %d, want %d", got, before+2)
+	}
+
+	// Recomputing the bucket gives the same result
+	got = activeUsers()
+	if got != before+2 {
+		t.Fatalf("recomputed active users: got %d, want %d", got, before+2)
+	}
+}
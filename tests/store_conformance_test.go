@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	ormpkg "github.com/stormhead-org/backend/internal/orm"
+	"github.com/stormhead-org/backend/internal/orm/ormtest"
+	migrationpkg "github.com/stormhead-org/backend/migration"
+)
+
+// storeTables are emptied before every conformance test. CASCADE also
+// empties the tables referencing them, so the platform settings row is
+// inserted again afterwards.
+const storeTables = `"user", "user_slug_redirect", "session", "community", "community_user", ` +
+	`"post", "post_like", "comment", "comment_like", "bookmark", "follower", ` +
+	`"roles", "user_roles", "reputation_event", "one_time_token", "auth_throttle", ` +
+	`"login_lockout", "invite_code"`
+
+func TestPostgresStoreConformance(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := ormpkg.NewPostgresClientWithDSN(pgConnStr)
+	if err != nil {
+		t.Fatalf("failed to connect to database: %v", err)
+	}
+
+	migrations, err := ormpkg.LoadMigrations(migrationpkg.FS)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+
+	_, err = client.MigrateUp(ctx, migrations)
+	if err != nil {
+		t.Fatalf("failed to apply migrations: %v", err)
+	}
+
+	database, err := gorm.Open(postgres.Open(pgConnStr), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect to database: %v", err)
+	}
+
+	ormtest.RunStoreConformance(t, func(t *testing.T) ormpkg.Store {
+		err := database.Exec("TRUNCATE " + storeTables + " CASCADE").Error
+		if err != nil {
+			t.Fatalf("failed to empty tables: %v", err)
+		}
+
+		err = database.Exec("INSERT INTO platform_settings (id) VALUES (1) ON CONFLICT (id) DO NOTHING").Error
+		if err != nil {
+			t.Fatalf("failed to insert platform settings: %v", err)
+		}
+
+		return client
+	})
+}